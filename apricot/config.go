@@ -43,6 +43,8 @@ func setDefaults() error {
 	viper.SetDefault("backendUri", "consul://127.0.0.1:8500")
 	viper.SetDefault("runtimeBasePath", "o2/runtime")
 	viper.SetDefault("workingDir", "/var/lib/o2/apricot")
+	viper.SetDefault("runNumberRange", "")
+	viper.SetDefault("runNumberPrefix", "")
 	viper.SetDefault("verbose", false)
 	return nil
 }
@@ -50,7 +52,9 @@ func setDefaults() error {
 func setFlags() error {
 	pflag.Int("listenPort", viper.GetInt("listenPort"), "Port of apricot server")
 	pflag.Int("httpListenPort", viper.GetInt("listenPort"), "Port of apricot http server")
-	pflag.String("backendUri", viper.GetString("backendUri"), "URI of the Consul server or YAML configuration file")
+	pflag.String("backendUri", viper.GetString("backendUri"), "URI of the Consul server (`consul://`), etcd cluster (`etcd://`), Git working copy (`git://`) or YAML configuration file")
+	pflag.String("runNumberRange", viper.GetString("runNumberRange"), "Range of run numbers issued with the YAML backend (`first-last`)")
+	pflag.String("runNumberPrefix", viper.GetString("runNumberPrefix"), "Digits prepended to run numbers issued with the YAML backend")
 	pflag.Bool("verbose", viper.GetBool("verbose"), "Verbose logging")
 
 	pflag.Parse()
@@ -49,6 +49,10 @@ func newService(configUri string) (configuration.Service, error) {
 	switch parsedUri.Scheme {
 	case "consul":
 		fallthrough
+	case "etcd":
+		fallthrough
+	case "git":
+		fallthrough
 	case "file":
 		if viper.GetString("component") == "apricot" {
 			log.WithField("configUri", configUri).
@@ -0,0 +1,493 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2021 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+
+package local
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/AliceO2Group/Control/common/system"
+	"github.com/AliceO2Group/Control/configuration"
+	"github.com/AliceO2Group/Control/configuration/cfgbackend"
+)
+
+// The inventory is laid out as follows:
+//   o2/hardware/flps/<hostname>/                   one key per host
+//   o2/hardware/flps/<hostname>/cards              JSON map of CRU card endpoints
+//   o2/hardware/detectors/<detector>/flps/<hostname>/
+// A host should be assigned to at most one detector.
+
+// inventoryTxnAttempts bounds how many times a change is retried when it
+// loses a race with another change to the same host.
+const inventoryTxnAttempts = 3
+
+func (s *Service) inventorySource() (cfgbackend.KVSource, error) {
+	if cSrc, ok := s.src.(cfgbackend.KVSource); ok {
+		return cSrc, nil
+	}
+	return nil, errors.New("inventory changes are only supported with the Consul and etcd backends")
+}
+
+func hostKey(hostname string) string {
+	return inventoryKeyPrefix + "flps/" + hostname
+}
+
+func hostCardsKey(hostname string) string {
+	return hostKey(hostname) + "/cards"
+}
+
+func detectorHostKey(detector string, hostname string) string {
+	return inventoryKeyPrefix + "detectors/" + detector + "/flps/" + hostname
+}
+
+func validateHostname(hostname string) error {
+	if len(hostname) == 0 || strings.ContainsAny(hostname, "/@ \t\n") {
+		return &configuration.InventoryError{Hostname: hostname, Reason: "bad hostname"}
+	}
+	return nil
+}
+
+// normalizeDetector returns the canonical code of detector, which must be
+// one of the detector system IDs.
+func normalizeDetector(detector string) (string, error) {
+	detector = strings.ToUpper(strings.TrimSpace(detector))
+	id, err := system.IDString(detector)
+	if err != nil || id <= 0 || id == system.NIL {
+		return "", &configuration.InventoryError{Reason: fmt.Sprintf("unknown detector %s", detector)}
+	}
+	return id.String(), nil
+}
+
+// inventoryHosts returns the hosts in o2/hardware/flps.
+func inventoryHosts(cSrc cfgbackend.KVSource) (hosts map[string]bool, err error) {
+	keyPrefix := inventoryKeyPrefix + "flps/"
+	var keys []string
+	keys, err = cSrc.GetKeysByPrefix(keyPrefix)
+	if err != nil {
+		return
+	}
+	hosts = make(map[string]bool)
+	for _, key := range keys {
+		hostname := strings.Split(strings.TrimPrefix(key, keyPrefix), "/")[0]
+		if len(hostname) > 0 {
+			hosts[hostname] = true
+		}
+	}
+	return
+}
+
+// inventoryDetectors returns the hosts assigned to each detector in
+// o2/hardware/detectors, detectors without hosts included.
+func inventoryDetectors(cSrc cfgbackend.KVSource) (detectors map[string]map[string]bool, err error) {
+	keyPrefix := inventoryKeyPrefix + "detectors/"
+	var keys []string
+	keys, err = cSrc.GetKeysByPrefix(keyPrefix)
+	if err != nil {
+		return
+	}
+	detectors = make(map[string]map[string]bool)
+	for _, key := range keys {
+		// key example: TST/flps/some-hostname/
+		splitKey := strings.Split(strings.TrimPrefix(key, keyPrefix), "/")
+		if len(splitKey[0]) == 0 {
+			continue
+		}
+		if _, ok := detectors[splitKey[0]]; !ok {
+			detectors[splitKey[0]] = make(map[string]bool)
+		}
+		if len(splitKey) > 2 && splitKey[1] == "flps" && len(splitKey[2]) > 0 {
+			detectors[splitKey[0]][splitKey[2]] = true
+		}
+	}
+	return
+}
+
+func detectorsOfHost(detectors map[string]map[string]bool, hostname string) (assigned []string) {
+	assigned = make([]string, 0)
+	for detector, hosts := range detectors {
+		if hosts[hostname] {
+			assigned = append(assigned, detector)
+		}
+	}
+	sort.Strings(assigned)
+	return
+}
+
+func getHostCards(cSrc cfgbackend.KVSource, hostname string) (cards map[string]Cards, err error) {
+	cards = make(map[string]Cards)
+	var exists bool
+	exists, err = cSrc.Exists(hostCardsKey(hostname))
+	if err != nil || !exists {
+		return
+	}
+	var cfgCards string
+	cfgCards, err = cSrc.Get(hostCardsKey(hostname))
+	if err != nil {
+		return
+	}
+	err = json.Unmarshal([]byte(cfgCards), &cards)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse CRU cards of host %s: %w", hostname, err)
+	}
+	return
+}
+
+// updateHostCards applies update to the CRU cards of a host and writes them
+// back, unless they or the host changed in the meantime, in which case it
+// starts over.
+func updateHostCards(cSrc cfgbackend.KVSource, hostname string, update func(cards map[string]Cards) error) (err error) {
+	for attempt := 0; attempt < inventoryTxnAttempts; attempt++ {
+		var hostIndex, cardsIndex uint64
+		hostIndex, err = cSrc.GetIndex(hostKey(hostname) + "/")
+		if err != nil {
+			return
+		}
+		cardsIndex, err = cSrc.GetIndex(hostCardsKey(hostname))
+		if err != nil {
+			return
+		}
+		var cards map[string]Cards
+		cards, err = getHostCards(cSrc, hostname)
+		if err != nil {
+			return
+		}
+		if err = update(cards); err != nil {
+			return
+		}
+		var cardsJson []byte
+		cardsJson, err = json.Marshal(cards)
+		if err != nil {
+			return
+		}
+
+		ops := []cfgbackend.KVTxnOp{
+			{Verb: cfgbackend.KVTxnCheckIndex, Key: hostCardsKey(hostname), Index: cardsIndex},
+			{Verb: cfgbackend.KVTxnSet, Key: hostCardsKey(hostname), Value: string(cardsJson)},
+		}
+		if hostIndex != 0 {
+			ops = append(ops, cfgbackend.KVTxnOp{Verb: cfgbackend.KVTxnCheckIndex, Key: hostKey(hostname) + "/", Index: hostIndex})
+		}
+		var ok bool
+		ok, err = cSrc.Txn(ops)
+		if err != nil || ok {
+			return
+		}
+	}
+	return &configuration.InventoryError{Hostname: hostname, Reason: "modified concurrently, try again"}
+}
+
+// AddHost adds a host to the inventory and, if detector is not empty,
+// assigns it to that detector. The host key is created with a check that it
+// doesn't exist yet, so of two concurrent additions of the same host only
+// one succeeds.
+func (s *Service) AddHost(hostname string, detector string) (err error) {
+	cSrc, err := s.inventorySource()
+	if err != nil {
+		return
+	}
+	if err = validateHostname(hostname); err != nil {
+		return
+	}
+	if len(detector) > 0 {
+		if detector, err = normalizeDetector(detector); err != nil {
+			return
+		}
+	}
+
+	hosts, err := inventoryHosts(cSrc)
+	if err != nil {
+		return
+	}
+	detectors, err := inventoryDetectors(cSrc)
+	if err != nil {
+		return
+	}
+	if hosts[hostname] || len(detectorsOfHost(detectors, hostname)) > 0 {
+		return &configuration.InventoryError{Hostname: hostname, Reason: "already in inventory"}
+	}
+
+	ops := []cfgbackend.KVTxnOp{
+		{Verb: cfgbackend.KVTxnCheckIndex, Key: hostKey(hostname) + "/", Index: 0},
+		{Verb: cfgbackend.KVTxnSet, Key: hostKey(hostname) + "/"},
+	}
+	if len(detector) > 0 {
+		ops = append(ops, cfgbackend.KVTxnOp{Verb: cfgbackend.KVTxnSet, Key: detectorHostKey(detector, hostname) + "/"})
+	}
+	var ok bool
+	ok, err = cSrc.Txn(ops)
+	if err == nil && !ok {
+		err = &configuration.InventoryError{Hostname: hostname, Reason: "already in inventory"}
+	}
+	return
+}
+
+// RemoveHost removes a host, its CRU cards and its detector assignments.
+func (s *Service) RemoveHost(hostname string) (err error) {
+	cSrc, err := s.inventorySource()
+	if err != nil {
+		return
+	}
+	if err = validateHostname(hostname); err != nil {
+		return
+	}
+
+	hosts, err := inventoryHosts(cSrc)
+	if err != nil {
+		return
+	}
+	detectors, err := inventoryDetectors(cSrc)
+	if err != nil {
+		return
+	}
+	assigned := detectorsOfHost(detectors, hostname)
+	if !hosts[hostname] && len(assigned) == 0 {
+		return &configuration.InventoryError{Hostname: hostname, Reason: "not in inventory"}
+	}
+
+	ops := []cfgbackend.KVTxnOp{{Verb: cfgbackend.KVTxnDelete, Key: hostKey(hostname)}}
+	for _, detector := range assigned {
+		ops = append(ops, cfgbackend.KVTxnOp{Verb: cfgbackend.KVTxnDelete, Key: detectorHostKey(detector, hostname)})
+	}
+	_, err = cSrc.Txn(ops)
+	return
+}
+
+// AssignHostToDetector makes detector the only detector of a host. The
+// host key is rewritten in the same transaction, under a check of the index
+// it had when we read the assignments, so concurrent assignments of the same
+// host can't both go through and leave it with two detectors.
+func (s *Service) AssignHostToDetector(hostname string, detector string) (err error) {
+	cSrc, err := s.inventorySource()
+	if err != nil {
+		return
+	}
+	if err = validateHostname(hostname); err != nil {
+		return
+	}
+	if detector, err = normalizeDetector(detector); err != nil {
+		return
+	}
+
+	for attempt := 0; attempt < inventoryTxnAttempts; attempt++ {
+		var index uint64
+		index, err = cSrc.GetIndex(hostKey(hostname) + "/")
+		if err != nil {
+			return
+		}
+		if index == 0 {
+			return &configuration.InventoryError{Hostname: hostname, Reason: "not in inventory"}
+		}
+		var detectors map[string]map[string]bool
+		detectors, err = inventoryDetectors(cSrc)
+		if err != nil {
+			return
+		}
+
+		ops := []cfgbackend.KVTxnOp{
+			{Verb: cfgbackend.KVTxnCheckIndex, Key: hostKey(hostname) + "/", Index: index},
+			{Verb: cfgbackend.KVTxnSet, Key: hostKey(hostname) + "/"},
+			{Verb: cfgbackend.KVTxnSet, Key: detectorHostKey(detector, hostname) + "/"},
+		}
+		for _, previous := range detectorsOfHost(detectors, hostname) {
+			if previous != detector {
+				ops = append(ops, cfgbackend.KVTxnOp{Verb: cfgbackend.KVTxnDelete, Key: detectorHostKey(previous, hostname)})
+			}
+		}
+		var ok bool
+		ok, err = cSrc.Txn(ops)
+		if err != nil || ok {
+			return
+		}
+	}
+	return &configuration.InventoryError{Hostname: hostname, Reason: "modified concurrently, try again"}
+}
+
+// SetCRUCard registers a CRU card endpoint on a host. An entry with the same
+// serial and endpoint is replaced, and so is an entry for the same card
+// which has no endpoint yet. Like the other inventory changes, the cards are
+// written with a check that they weren't modified since they were read.
+func (s *Service) SetCRUCard(hostname string, card *configuration.CRUCard) (err error) {
+	cSrc, err := s.inventorySource()
+	if err != nil {
+		return
+	}
+	if err = validateHostname(hostname); err != nil {
+		return
+	}
+	if card == nil || len(strings.TrimSpace(card.Serial)) == 0 {
+		return &configuration.InventoryError{Hostname: hostname, Reason: "CRU card serial missing"}
+	}
+
+	hosts, err := inventoryHosts(cSrc)
+	if err != nil {
+		return
+	}
+	if !hosts[hostname] {
+		return &configuration.InventoryError{Hostname: hostname, Reason: "not in inventory"}
+	}
+
+	return updateHostCards(cSrc, hostname, func(cards map[string]Cards) error {
+		cardKey := ""
+		for k, existing := range cards {
+			if existing.Serial != card.Serial {
+				continue
+			}
+			if existing.Endpoint == card.Endpoint {
+				cardKey = k
+				break
+			}
+			if len(existing.Endpoint) == 0 {
+				cardKey = k
+			}
+		}
+		if len(cardKey) == 0 {
+			for i := 0; ; i++ {
+				if _, taken := cards[strconv.Itoa(i)]; !taken {
+					cardKey = strconv.Itoa(i)
+					break
+				}
+			}
+		}
+		cards[cardKey] = *card
+		return nil
+	})
+}
+
+// RemoveCRUCard removes one endpoint of a CRU card from a host, or all of
+// them if endpoint is empty.
+func (s *Service) RemoveCRUCard(hostname string, cardSerial string, endpoint string) (err error) {
+	cSrc, err := s.inventorySource()
+	if err != nil {
+		return
+	}
+	if err = validateHostname(hostname); err != nil {
+		return
+	}
+
+	return updateHostCards(cSrc, hostname, func(cards map[string]Cards) error {
+		removed := 0
+		for k, existing := range cards {
+			if existing.Serial == cardSerial && (len(endpoint) == 0 || existing.Endpoint == endpoint) {
+				delete(cards, k)
+				removed++
+			}
+		}
+		if removed == 0 {
+			reason := fmt.Sprintf("no CRU card %s", cardSerial)
+			if len(endpoint) > 0 {
+				reason += " with endpoint " + endpoint
+			}
+			return &configuration.InventoryError{Hostname: hostname, Reason: reason}
+		}
+		return nil
+	})
+}
+
+// CheckInventory reports hosts assigned to several detectors or to unknown
+// detectors, detector assignments of hosts which are not in the inventory,
+// and CRU cards without endpoints.
+func (s *Service) CheckInventory() (issues []configuration.InventoryIssue, err error) {
+	cSrc, err := s.inventorySource()
+	if err != nil {
+		return
+	}
+
+	hosts, err := inventoryHosts(cSrc)
+	if err != nil {
+		return
+	}
+	detectors, err := inventoryDetectors(cSrc)
+	if err != nil {
+		return
+	}
+
+	issues = make([]configuration.InventoryIssue, 0)
+	assignedHosts := make(map[string]bool)
+	for detector, detectorHosts := range detectors {
+		if canonical, detErr := normalizeDetector(detector); detErr != nil || canonical != detector {
+			issues = append(issues, configuration.InventoryIssue{
+				Kind:   configuration.INVENTORY_DETECTOR_UNKNOWN,
+				Detail: detector,
+			})
+		}
+		for hostname := range detectorHosts {
+			assignedHosts[hostname] = true
+		}
+	}
+
+	for hostname := range assignedHosts {
+		if !hosts[hostname] {
+			issues = append(issues, configuration.InventoryIssue{
+				Kind:     configuration.INVENTORY_HOST_UNKNOWN,
+				Hostname: hostname,
+				Detail:   "assigned to " + strings.Join(detectorsOfHost(detectors, hostname), ", "),
+			})
+		}
+		if assigned := detectorsOfHost(detectors, hostname); len(assigned) > 1 {
+			issues = append(issues, configuration.InventoryIssue{
+				Kind:     configuration.INVENTORY_HOST_MULTIPLE_DETECTORS,
+				Hostname: hostname,
+				Detail:   strings.Join(assigned, ", "),
+			})
+		}
+	}
+
+	for hostname := range hosts {
+		cards, cardsErr := getHostCards(cSrc, hostname)
+		if cardsErr != nil {
+			issues = append(issues, configuration.InventoryIssue{
+				Kind:     configuration.INVENTORY_CARDS_UNREADABLE,
+				Hostname: hostname,
+				Detail:   cardsErr.Error(),
+			})
+			continue
+		}
+		for _, card := range cards {
+			if len(card.Endpoint) == 0 {
+				issues = append(issues, configuration.InventoryIssue{
+					Kind:     configuration.INVENTORY_CARD_MISSING_ENDPOINT,
+					Hostname: hostname,
+					Detail:   "card " + card.Serial,
+				})
+			}
+		}
+	}
+
+	sort.Slice(issues, func(i, j int) bool {
+		if issues[i].Hostname != issues[j].Hostname {
+			return issues[i].Hostname < issues[j].Hostname
+		}
+		if issues[i].Kind != issues[j].Kind {
+			return issues[i].Kind < issues[j].Kind
+		}
+		return issues[i].Detail < issues[j].Detail
+	})
+	return
+}
@@ -0,0 +1,315 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2021 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+
+package local
+
+import (
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/AliceO2Group/Control/configuration"
+	"github.com/AliceO2Group/Control/configuration/cfgbackend"
+)
+
+func isInventoryError(err error) bool {
+	var inventoryErr *configuration.InventoryError
+	return errors.As(err, &inventoryErr)
+}
+
+// assignedDetectors returns the detectors the inventory assigns hostname to.
+func assignedDetectors(t *testing.T, svc *Service, hostname string) []string {
+	t.Helper()
+	detectors, err := inventoryDetectors(svc.src.(cfgbackend.KVSource))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return detectorsOfHost(detectors, hostname)
+}
+
+func TestAddHost(t *testing.T) {
+	svc := newConsulTestService(t)
+
+	if err := svc.AddHost("flp001", "tpc"); err != nil {
+		t.Fatal(err)
+	}
+	if assigned := assignedDetectors(t, svc, "flp001"); !reflect.DeepEqual(assigned, []string{"TPC"}) {
+		t.Errorf("expected flp001 assigned to TPC, got %v", assigned)
+	}
+	if err := svc.AddHost("flp002", ""); err != nil {
+		t.Fatal(err)
+	}
+	if assigned := assignedDetectors(t, svc, "flp002"); len(assigned) != 0 {
+		t.Errorf("expected flp002 unassigned, got %v", assigned)
+	}
+
+	for _, c := range []struct {
+		name     string
+		hostname string
+		detector string
+	}{
+		{"duplicate host", "flp001", ""},
+		{"duplicate host with detector", "flp002", "ITS"},
+		{"bad hostname", "flp/003", ""},
+		{"empty hostname", "", ""},
+		{"unknown detector", "flp003", "XYZ"},
+	} {
+		if err := svc.AddHost(c.hostname, c.detector); !isInventoryError(err) {
+			t.Errorf("%s: expected an InventoryError, got %v", c.name, err)
+		}
+	}
+}
+
+func TestAddHostConcurrent(t *testing.T) {
+	svc := newConsulTestService(t)
+
+	const workers = 8
+	errs := make(chan error, workers)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- svc.AddHost("flp001", "")
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	added := 0
+	for err := range errs {
+		if err == nil {
+			added++
+		} else if !isInventoryError(err) {
+			t.Errorf("unexpected error %s", err)
+		}
+	}
+	if added != 1 {
+		t.Errorf("expected exactly one addition to succeed, got %d", added)
+	}
+}
+
+func TestAssignHostToDetector(t *testing.T) {
+	svc := newConsulTestService(t)
+
+	if err := svc.AssignHostToDetector("flp001", "TPC"); !isInventoryError(err) {
+		t.Errorf("expected an InventoryError for a host not in inventory, got %v", err)
+	}
+	if err := svc.AddHost("flp001", "TPC"); err != nil {
+		t.Fatal(err)
+	}
+	if err := svc.AssignHostToDetector("flp001", "XYZ"); !isInventoryError(err) {
+		t.Errorf("expected an InventoryError for an unknown detector, got %v", err)
+	}
+
+	if err := svc.AssignHostToDetector("flp001", "its"); err != nil {
+		t.Fatal(err)
+	}
+	if assigned := assignedDetectors(t, svc, "flp001"); !reflect.DeepEqual(assigned, []string{"ITS"}) {
+		t.Errorf("expected flp001 moved to ITS, got %v", assigned)
+	}
+	// assigning to the same detector again is a no-op
+	if err := svc.AssignHostToDetector("flp001", "ITS"); err != nil {
+		t.Fatal(err)
+	}
+	if assigned := assignedDetectors(t, svc, "flp001"); !reflect.DeepEqual(assigned, []string{"ITS"}) {
+		t.Errorf("expected flp001 to stay in ITS, got %v", assigned)
+	}
+}
+
+func TestAssignHostToDetectorConcurrent(t *testing.T) {
+	svc := newConsulTestService(t)
+	if err := svc.AddHost("flp001", ""); err != nil {
+		t.Fatal(err)
+	}
+
+	detectors := []string{"TPC", "ITS", "TOF", "MCH", "MID", "FT0", "TRD", "HMP"}
+	var wg sync.WaitGroup
+	for _, detector := range detectors {
+		wg.Add(1)
+		go func(detector string) {
+			defer wg.Done()
+			if err := svc.AssignHostToDetector("flp001", detector); err != nil && !isInventoryError(err) {
+				t.Errorf("unexpected error %s", err)
+			}
+		}(detector)
+	}
+	wg.Wait()
+
+	if assigned := assignedDetectors(t, svc, "flp001"); len(assigned) != 1 {
+		t.Errorf("expected flp001 assigned to exactly one detector, got %v", assigned)
+	}
+}
+
+func TestCRUCardsConcurrent(t *testing.T) {
+	svc := newConsulTestService(t)
+	if err := svc.AddHost("flp001", ""); err != nil {
+		t.Fatal(err)
+	}
+	removedSerials := []string{"1000", "1001", "1002", "1003"}
+	for _, serial := range removedSerials {
+		if err := svc.SetCRUCard("flp001", &configuration.CRUCard{Serial: serial, Endpoint: "0"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	addedSerials := []string{"2000", "2001", "2002", "2003"}
+
+	// Each change either lands or fails with an inventory error, but none
+	// may undo another one
+	var (
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		succeeded = make(map[string]bool)
+	)
+	change := func(serial string, do func() error) {
+		defer wg.Done()
+		err := do()
+		if err != nil {
+			if !isInventoryError(err) {
+				t.Errorf("unexpected error %s", err)
+			}
+			return
+		}
+		mu.Lock()
+		succeeded[serial] = true
+		mu.Unlock()
+	}
+	for i := range removedSerials {
+		removed, added := removedSerials[i], addedSerials[i]
+		wg.Add(2)
+		go change(removed, func() error { return svc.RemoveCRUCard("flp001", removed, "") })
+		go change(added, func() error {
+			return svc.SetCRUCard("flp001", &configuration.CRUCard{Serial: added, Endpoint: "0"})
+		})
+	}
+	wg.Wait()
+
+	cards, err := getHostCards(svc.src.(cfgbackend.KVSource), "flp001")
+	if err != nil {
+		t.Fatal(err)
+	}
+	present := make(map[string]bool)
+	for _, card := range cards {
+		present[card.Serial] = true
+	}
+	for _, serial := range removedSerials {
+		if succeeded[serial] && present[serial] {
+			t.Errorf("CRU card %s is back after being removed", serial)
+		}
+		if !succeeded[serial] && !present[serial] {
+			t.Errorf("CRU card %s is gone although its removal failed", serial)
+		}
+	}
+	for _, serial := range addedSerials {
+		if succeeded[serial] != present[serial] {
+			t.Errorf("CRU card %s: set succeeded %v, but present %v", serial, succeeded[serial], present[serial])
+		}
+	}
+}
+
+func TestRemoveHost(t *testing.T) {
+	svc := newConsulTestService(t)
+	if err := svc.AddHost("flp001", "TPC"); err != nil {
+		t.Fatal(err)
+	}
+	if err := svc.SetCRUCard("flp001", &configuration.CRUCard{Serial: "1234", Endpoint: "0"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := svc.RemoveHost("flp001"); err != nil {
+		t.Fatal(err)
+	}
+	hosts, err := inventoryHosts(svc.src.(cfgbackend.KVSource))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hosts["flp001"] || len(assignedDetectors(t, svc, "flp001")) != 0 {
+		t.Error("expected flp001 and its assignments to be gone")
+	}
+	if err = svc.RemoveHost("flp001"); !isInventoryError(err) {
+		t.Errorf("expected an InventoryError for a host not in inventory, got %v", err)
+	}
+}
+
+func TestCheckInventory(t *testing.T) {
+	svc := newConsulTestService(t)
+
+	if issues, err := svc.CheckInventory(); err != nil || len(issues) != 0 {
+		t.Fatalf("expected no issues in an empty inventory, got %v, %v", issues, err)
+	}
+
+	if err := svc.AddHost("flp001", "TPC"); err != nil {
+		t.Fatal(err)
+	}
+	if err := svc.AddHost("flp002", "ITS"); err != nil {
+		t.Fatal(err)
+	}
+	if err := svc.AddHost("flp003", ""); err != nil {
+		t.Fatal(err)
+	}
+	if err := svc.SetCRUCard("flp001", &configuration.CRUCard{Serial: "1234", Endpoint: "0"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := svc.SetCRUCard("flp001", &configuration.CRUCard{Serial: "5678"}); err != nil {
+		t.Fatal(err)
+	}
+
+	// inconsistencies which the inventory calls refuse to create
+	kv := svc.src.(cfgbackend.KVSource)
+	for key, value := range map[string]string{
+		detectorHostKey("TOF", "flp002") + "/": "",
+		detectorHostKey("XYZ", "flp003") + "/": "",
+		detectorHostKey("TPC", "flp009") + "/": "",
+		hostCardsKey("flp003"):                 "not json",
+	} {
+		if err := kv.Put(key, value); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	issues, err := svc.CheckInventory()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []configuration.InventoryIssue{
+		{Kind: configuration.INVENTORY_DETECTOR_UNKNOWN, Detail: "XYZ"},
+		{Kind: configuration.INVENTORY_CARD_MISSING_ENDPOINT, Hostname: "flp001", Detail: "card 5678"},
+		{Kind: configuration.INVENTORY_HOST_MULTIPLE_DETECTORS, Hostname: "flp002", Detail: "ITS, TOF"},
+		{Kind: configuration.INVENTORY_CARDS_UNREADABLE, Hostname: "flp003"},
+		{Kind: configuration.INVENTORY_HOST_UNKNOWN, Hostname: "flp009", Detail: "assigned to TPC"},
+	}
+	if len(issues) != len(want) {
+		t.Fatalf("expected %d issues, got %+v", len(want), issues)
+	}
+	for i := range want {
+		if issues[i].Kind != want[i].Kind || issues[i].Hostname != want[i].Hostname {
+			t.Errorf("issue %d: expected %+v, got %+v", i, want[i], issues[i])
+		}
+		if len(want[i].Detail) > 0 && issues[i].Detail != want[i].Detail {
+			t.Errorf("issue %d: expected detail %q, got %q", i, want[i].Detail, issues[i].Detail)
+		}
+	}
+}
@@ -24,12 +24,6 @@
 
 package local
 
-type Cards struct {
-	Type             string `json:"type"`
-	PciAddress       string `json:"pciAddress"`
-	Serial           string `json:"serial"`
-	Endpoint         string `json:"endpoint"`
-	Numa             string `json:"numa"`
-	Firware          string `json:"firmware"`
-	UserLogicVersion string `json:"userLogicVersion"`
-}
+import "github.com/AliceO2Group/Control/configuration"
+
+type Cards = configuration.CRUCard
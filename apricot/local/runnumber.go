@@ -0,0 +1,297 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2021 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+
+package local
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/AliceO2Group/Control/configuration"
+	"github.com/spf13/viper"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	runCounterFile       = "runcounter.txt"
+	runCounterLockFile   = "runcounter.lock"
+	runNumberHistoryFile = "runnumbers.log"
+	runNumberHistoryKey  = "run_number_history" // under getConsulRuntimePrefix()
+)
+
+// runNumberSpace is the set of run numbers this instance may issue. The
+// counter runs from first to last, and if prefix is set, each run number is
+// made of the prefix digits followed by the counter, zero-padded to as many
+// digits as last has, e.g. prefix 7 and range 1-99999 yield 700001...799999.
+type runNumberSpace struct {
+	first     uint64
+	last      uint64
+	prefix    uint64
+	width     int
+	hasPrefix bool
+}
+
+func newRunNumberSpace(rangeSpec string, prefixSpec string) (space runNumberSpace, err error) {
+	space = runNumberSpace{first: 1, last: math.MaxUint32}
+	if rangeSpec = strings.TrimSpace(rangeSpec); len(rangeSpec) > 0 {
+		bounds := strings.Split(rangeSpec, "-")
+		if len(bounds) != 2 {
+			return space, fmt.Errorf("bad run number range %s, expected <first>-<last>", rangeSpec)
+		}
+		space.first, err = strconv.ParseUint(strings.TrimSpace(bounds[0]), 10, 32)
+		if err != nil {
+			return space, fmt.Errorf("bad run number range %s: %w", rangeSpec, err)
+		}
+		space.last, err = strconv.ParseUint(strings.TrimSpace(bounds[1]), 10, 32)
+		if err != nil {
+			return space, fmt.Errorf("bad run number range %s: %w", rangeSpec, err)
+		}
+		if space.first == 0 || space.first > space.last {
+			return space, fmt.Errorf("bad run number range %s", rangeSpec)
+		}
+	}
+	if prefixSpec = strings.TrimSpace(prefixSpec); len(prefixSpec) > 0 {
+		space.prefix, err = strconv.ParseUint(prefixSpec, 10, 32)
+		if err != nil {
+			return space, fmt.Errorf("bad run number prefix %s: %w", prefixSpec, err)
+		}
+		space.hasPrefix = true
+		space.width = len(strconv.FormatUint(space.last, 10))
+		if _, err = space.runNumber(space.last); err != nil {
+			return space, fmt.Errorf("run number prefix %s is too long for range %d-%d", prefixSpec, space.first, space.last)
+		}
+	}
+	return
+}
+
+func (space runNumberSpace) runNumber(counter uint64) (uint32, error) {
+	if !space.hasPrefix {
+		return uint32(counter), nil
+	}
+	composed, err := strconv.ParseUint(strconv.FormatUint(space.prefix, 10) + fmt.Sprintf("%0*d", space.width, counter), 10, 32)
+	if err != nil {
+		return 0, err
+	}
+	return uint32(composed), nil
+}
+
+// The Git and YAML file backends have no runtime KV, so the counter and the
+// history live in the working directory of whoever embeds apricot.
+func runNumberDir() string {
+	if coreWorkingDir := viper.GetString("coreWorkingDir"); len(coreWorkingDir) > 0 {
+		return coreWorkingDir
+	}
+	return viper.GetString("workingDir")
+}
+
+// newFileRunNumber allocates a run number under an exclusive lock on the
+// counter, so that concurrent requests and other processes sharing the
+// working directory never get the same number. The counter is replaced
+// atomically, a crash leaves either the old or the new value.
+func newFileRunNumber(environmentId string) (runNumber uint32, err error) {
+	var space runNumberSpace
+	space, err = newRunNumberSpace(viper.GetString("runNumberRange"), viper.GetString("runNumberPrefix"))
+	if err != nil {
+		return
+	}
+
+	dir := runNumberDir()
+	var unlock func()
+	unlock, err = lockFile(filepath.Join(dir, runCounterLockFile))
+	if err != nil {
+		return
+	}
+	defer unlock()
+
+	counterPath := filepath.Join(dir, runCounterFile)
+	var counter uint64
+	raw, err := ioutil.ReadFile(counterPath)
+	if err == nil {
+		counter, err = strconv.ParseUint(strings.TrimSpace(string(raw)), 10, 32)
+		if err != nil {
+			return 0, fmt.Errorf("run counter %s is corrupted: %w", counterPath, err)
+		}
+	} else if !os.IsNotExist(err) {
+		return
+	}
+
+	counter++
+	if counter < space.first {
+		counter = space.first
+	}
+	if counter > space.last {
+		return 0, fmt.Errorf("run number range %d-%d exhausted", space.first, space.last)
+	}
+	runNumber, err = space.runNumber(counter)
+	if err != nil {
+		return
+	}
+
+	err = writeFileAtomic(counterPath, []byte(strconv.FormatUint(counter, 10)))
+	if err != nil {
+		return 0, fmt.Errorf("cannot write run counter %s: %w", counterPath, err)
+	}
+
+	// The counter is written first: if we crash now, a run number may be
+	// missing from the history, but it will never be issued twice.
+	record := configuration.RunNumberRecord{
+		RunNumber:     runNumber,
+		EnvironmentId: environmentId,
+		Timestamp:     time.Now().UnixNano() / 1e6,
+	}
+	histErr := appendRunNumberHistory(filepath.Join(dir, runNumberHistoryFile), record)
+	if histErr != nil {
+		log.WithError(histErr).
+			WithField("runNumber", runNumber).
+			Warn("cannot record run number in history")
+	}
+	return
+}
+
+func listFileRunNumbers() (records []configuration.RunNumberRecord, err error) {
+	records = make([]configuration.RunNumberRecord, 0)
+	var file *os.File
+	file, err = os.Open(filepath.Join(runNumberDir(), runNumberHistoryFile))
+	if os.IsNotExist(err) {
+		return records, nil
+	}
+	if err != nil {
+		return
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if len(line) == 0 {
+			continue
+		}
+		var record configuration.RunNumberRecord
+		if json.Unmarshal([]byte(line), &record) != nil {
+			// A torn last line after a crash, nothing else can be malformed
+			log.WithField("line", line).Warn("skipping bad run number history record")
+			continue
+		}
+		records = append(records, record)
+	}
+	err = scanner.Err()
+	return
+}
+
+func lockFile(path string) (unlock func(), err error) {
+	var file *os.File
+	file, err = os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("cannot open lock file %s: %w", path, err)
+	}
+	err = unix.Flock(int(file.Fd()), unix.LOCK_EX)
+	if err != nil {
+		_ = file.Close()
+		return nil, fmt.Errorf("cannot lock %s: %w", path, err)
+	}
+	return func() {
+		_ = unix.Flock(int(file.Fd()), unix.LOCK_UN)
+		_ = file.Close()
+	}, nil
+}
+
+// writeFileAtomic replaces path with data by writing to a temporary file in
+// the same directory, syncing it and renaming it over path.
+func writeFileAtomic(path string, data []byte) (err error) {
+	dir := filepath.Dir(path)
+	var tmp *os.File
+	tmp, err = ioutil.TempFile(dir, "." + filepath.Base(path) + ".*")
+	if err != nil {
+		return
+	}
+	defer func() {
+		if err != nil {
+			_ = os.Remove(tmp.Name())
+		}
+	}()
+
+	if _, err = tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return
+	}
+	if err = tmp.Sync(); err != nil {
+		_ = tmp.Close()
+		return
+	}
+	if err = tmp.Close(); err != nil {
+		return
+	}
+	if err = os.Chmod(tmp.Name(), 0644); err != nil {
+		return
+	}
+	if err = os.Rename(tmp.Name(), path); err != nil {
+		return
+	}
+	return syncDir(dir)
+}
+
+func appendRunNumberHistory(path string, record configuration.RunNumberRecord) (err error) {
+	var raw []byte
+	raw, err = json.Marshal(record)
+	if err != nil {
+		return
+	}
+	var file *os.File
+	file, err = os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+	if err != nil {
+		return
+	}
+	if _, err = file.Write(append(raw, '\n')); err != nil {
+		_ = file.Close()
+		return
+	}
+	if err = file.Sync(); err != nil {
+		_ = file.Close()
+		return
+	}
+	return file.Close()
+}
+
+// syncDir makes a rename in dir durable.
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	if err = d.Sync(); err != nil && !errors.Is(err, os.ErrInvalid) {
+		return err
+	}
+	return nil
+}
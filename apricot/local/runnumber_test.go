@@ -0,0 +1,273 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2021 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+
+package local
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/spf13/viper"
+)
+
+const runNumberHelperDirEnv = "O2_TEST_RUN_NUMBER_DIR"
+
+// useRunNumberDir points the file run number backend at a fresh directory,
+// with the given range and prefix settings.
+func useRunNumberDir(t *testing.T, rangeSpec string, prefixSpec string) string {
+	t.Helper()
+	dir := t.TempDir()
+	viper.Set("coreWorkingDir", dir)
+	viper.Set("runNumberRange", rangeSpec)
+	viper.Set("runNumberPrefix", prefixSpec)
+	t.Cleanup(func() {
+		viper.Set("coreWorkingDir", "")
+		viper.Set("runNumberRange", "")
+		viper.Set("runNumberPrefix", "")
+	})
+	return dir
+}
+
+func TestNewRunNumberSpace(t *testing.T) {
+	cases := []struct {
+		rangeSpec  string
+		prefixSpec string
+		counter    uint64
+		want       uint32
+		wantErr    bool
+	}{
+		{"", "", 5, 5, false},
+		{"100-200", "", 150, 150, false},
+		{" 1 - 99999 ", "7", 1, 700001, false},
+		{"1-99999", "7", 99999, 799999, false},
+		{"1-999", "42", 12, 42012, false},
+		{"200-100", "", 0, 0, true},
+		{"0-100", "", 0, 0, true},
+		{"1-", "", 0, 0, true},
+		{"1-2-3", "", 0, 0, true},
+		{"a-b", "", 0, 0, true},
+		{"1-99999", "x", 0, 0, true},
+		{"1-999999", "99999", 0, 0, true}, // prefixed numbers don't fit in 32 bits
+	}
+	for _, c := range cases {
+		space, err := newRunNumberSpace(c.rangeSpec, c.prefixSpec)
+		if c.wantErr {
+			if err == nil {
+				t.Errorf("range %q prefix %q: expected error", c.rangeSpec, c.prefixSpec)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("range %q prefix %q: unexpected error %s", c.rangeSpec, c.prefixSpec, err)
+			continue
+		}
+		got, err := space.runNumber(c.counter)
+		if err != nil || got != c.want {
+			t.Errorf("range %q prefix %q counter %d: expected %d, got %d (%v)", c.rangeSpec, c.prefixSpec, c.counter, c.want, got, err)
+		}
+	}
+}
+
+func TestNewFileRunNumberRangeAndPrefix(t *testing.T) {
+	useRunNumberDir(t, "10-12", "3")
+
+	want := []uint32{310, 311, 312}
+	for _, w := range want {
+		runNumber, err := newFileRunNumber("env")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if runNumber != w {
+			t.Errorf("expected run number %d, got %d", w, runNumber)
+		}
+	}
+	if _, err := newFileRunNumber("env"); err == nil || !strings.Contains(err.Error(), "exhausted") {
+		t.Errorf("expected exhausted range error, got %v", err)
+	}
+}
+
+func TestNewFileRunNumberCorruptedCounter(t *testing.T) {
+	dir := useRunNumberDir(t, "", "")
+	if err := ioutil.WriteFile(filepath.Join(dir, runCounterFile), []byte("garbage"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := newFileRunNumber("env"); err == nil {
+		t.Error("expected error on corrupted run counter")
+	}
+}
+
+func checkUniqueIncreasing(t *testing.T, sequences [][]uint32, total int) {
+	t.Helper()
+	seen := make(map[uint32]bool)
+	for _, sequence := range sequences {
+		for i, runNumber := range sequence {
+			if seen[runNumber] {
+				t.Fatalf("run number %d issued twice", runNumber)
+			}
+			seen[runNumber] = true
+			if i > 0 && runNumber <= sequence[i-1] {
+				t.Fatalf("run number %d issued after %d", runNumber, sequence[i-1])
+			}
+		}
+	}
+	if len(seen) != total {
+		t.Fatalf("expected %d run numbers, got %d", total, len(seen))
+	}
+	for runNumber := uint32(1); runNumber <= uint32(total); runNumber++ {
+		if !seen[runNumber] {
+			t.Fatalf("run number %d was skipped", runNumber)
+		}
+	}
+}
+
+func TestNewFileRunNumberConcurrentGoroutines(t *testing.T) {
+	useRunNumberDir(t, "", "")
+	svc := &Service{}
+
+	const workers, perWorker = 8, 10
+	sequences := make([][]uint32, workers)
+	errs := make(chan error, workers)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < perWorker; i++ {
+				runNumber, err := svc.NewRunNumber(fmt.Sprintf("env-%d", w))
+				if err != nil {
+					errs <- err
+					return
+				}
+				sequences[w] = append(sequences[w], runNumber)
+			}
+		}(w)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Fatal(err)
+	}
+	checkUniqueIncreasing(t, sequences, workers*perWorker)
+
+	records, err := svc.ListRunNumbers()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != workers*perWorker {
+		t.Fatalf("expected %d history records, got %d", workers*perWorker, len(records))
+	}
+	for i := 1; i < len(records); i++ {
+		if records[i].RunNumber <= records[i-1].RunNumber {
+			t.Fatalf("history not in issue order: %d after %d", records[i].RunNumber, records[i-1].RunNumber)
+		}
+	}
+}
+
+// TestRunNumberHelperProcess is not a test, it allocates run numbers on
+// behalf of TestNewFileRunNumberConcurrentProcesses and prints them.
+func TestRunNumberHelperProcess(t *testing.T) {
+	dir := os.Getenv(runNumberHelperDirEnv)
+	if dir == "" {
+		t.Skip("only run as a helper process")
+	}
+	viper.Set("coreWorkingDir", dir)
+	for i := 0; i < 10; i++ {
+		runNumber, err := newFileRunNumber("helper")
+		if err != nil {
+			t.Fatal(err)
+		}
+		fmt.Println(runNumber)
+	}
+}
+
+func TestNewFileRunNumberConcurrentProcesses(t *testing.T) {
+	dir := useRunNumberDir(t, "", "")
+
+	const processes = 4
+	outputs := make([][]byte, processes)
+	errs := make([]error, processes)
+	var wg sync.WaitGroup
+	for p := 0; p < processes; p++ {
+		wg.Add(1)
+		go func(p int) {
+			defer wg.Done()
+			cmd := exec.Command(os.Args[0], "-test.run=^TestRunNumberHelperProcess$")
+			cmd.Env = append(os.Environ(), runNumberHelperDirEnv + "=" + dir)
+			outputs[p], errs[p] = cmd.Output()
+		}(p)
+	}
+	wg.Wait()
+
+	sequences := make([][]uint32, processes)
+	for p := 0; p < processes; p++ {
+		if errs[p] != nil {
+			t.Fatalf("helper process failed: %s: %s", errs[p], outputs[p])
+		}
+		for _, line := range strings.Split(string(outputs[p]), "\n") {
+			runNumber, err := strconv.ParseUint(strings.TrimSpace(line), 10, 32)
+			if err != nil {
+				continue // test framework output
+			}
+			sequences[p] = append(sequences[p], uint32(runNumber))
+		}
+	}
+	checkUniqueIncreasing(t, sequences, processes*10)
+}
+
+func TestListFileRunNumbers(t *testing.T) {
+	dir := useRunNumberDir(t, "", "")
+
+	records, err := listFileRunNumbers()
+	if err != nil || len(records) != 0 {
+		t.Fatalf("expected empty history without a history file, got %v, %v", records, err)
+	}
+
+	history := `{"runNumber":1,"environmentId":"envA","timestamp":1600000000000}
+
+{"runNumber":2,"environmentId":"envB","timestamp":1600000001000}
+{"runNumber":3,"environmentId":"en`
+	if err = ioutil.WriteFile(filepath.Join(dir, runNumberHistoryFile), []byte(history), 0644); err != nil {
+		t.Fatal(err)
+	}
+	records, err = listFileRunNumbers()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 2 {
+		t.Fatalf("expected 2 records, the torn one skipped, got %d", len(records))
+	}
+	sort.Slice(records, func(i, j int) bool { return records[i].RunNumber < records[j].RunNumber })
+	if records[0].EnvironmentId != "envA" || records[1].EnvironmentId != "envB" || records[1].Timestamp != 1600000001000 {
+		t.Errorf("unexpected records %+v", records)
+	}
+}
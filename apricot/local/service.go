@@ -25,10 +25,10 @@
 package local
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
-	"io/ioutil"
 	"net/url"
 	"os"
 	"path/filepath"
@@ -38,6 +38,7 @@ import (
 	"time"
 
 	"github.com/AliceO2Group/Control/common/logger"
+	"github.com/AliceO2Group/Control/configuration"
 	"github.com/AliceO2Group/Control/configuration/cfgbackend"
 	"github.com/AliceO2Group/Control/configuration/componentcfg"
 	"github.com/AliceO2Group/Control/configuration/template"
@@ -49,6 +50,8 @@ import (
 var log = logger.New(logrus.StandardLogger(), "confsys")
 const inventoryKeyPrefix = "o2/hardware/"
 
+var errRuntimeKVNotSupported = errors.New("runtime KV not supported with this configuration backend, use Consul or etcd")
+
 type Service struct {
 	src cfgbackend.Source
 }
@@ -61,34 +64,55 @@ func NewService(uri string) (svc *Service, err error) {
 	}, err
 }
 
-func (s *Service) NewRunNumber() (runNumber uint32, err error) {
-	if cSrc, ok := s.src.(*cfgbackend.ConsulSource); ok {
-		return cSrc.GetNextUInt32(filepath.Join(getConsulRuntimePrefix(), "run_number"))
-	} else {
-		// Unsafe check-and-set, only for file backend
-		var rnf string
-		rnf = filepath.Join(viper.GetString("coreWorkingDir"), "runcounter.txt")
-		if _, err = os.Stat(rnf); os.IsNotExist(err) {
-			err = ioutil.WriteFile(rnf, []byte("0"), 0644)
-			if err != nil {
-				return
-			}
-		}
-		var raw []byte
-		raw, err = ioutil.ReadFile(rnf)
+func (s *Service) NewRunNumber(environmentId string) (runNumber uint32, err error) {
+	if cSrc, ok := s.src.(cfgbackend.KVSource); ok {
+		runNumber, err = cSrc.GetNextUInt32(filepath.Join(getConsulRuntimePrefix(), "run_number"))
 		if err != nil {
 			return
 		}
-		var rn64 uint64
-		rn64, err = strconv.ParseUint(string(raw[:]), 10, 32)
+		// The run number is ours at this point, a missing history record is
+		// not worth failing the run over
+		record := configuration.RunNumberRecord{
+			RunNumber:     runNumber,
+			EnvironmentId: environmentId,
+			Timestamp:     time.Now().UnixNano() / 1e6,
+		}
+		raw, _ := json.Marshal(record)
+		histErr := cSrc.Put(filepath.Join(getConsulRuntimePrefix(), runNumberHistoryKey, strconv.FormatUint(uint64(runNumber), 10)), string(raw))
+		if histErr != nil {
+			log.WithError(histErr).
+				WithField("runNumber", runNumber).
+				Warn("cannot record run number in history")
+		}
+		return
+	} else {
+		return newFileRunNumber(environmentId)
+	}
+}
+
+func (s *Service) ListRunNumbers() (records []configuration.RunNumberRecord, err error) {
+	if cSrc, ok := s.src.(cfgbackend.KVSource); ok {
+		historyKey := filepath.Join(getConsulRuntimePrefix(), runNumberHistoryKey)
+		records = make([]configuration.RunNumberRecord, 0)
+		if exists, _ := cSrc.Exists(historyKey); !exists {
+			return
+		}
+		var history cfgbackend.Item
+		history, err = cSrc.GetRecursive(historyKey)
 		if err != nil {
 			return
 		}
-		runNumber = uint32(rn64)
-		runNumber++
-		raw = []byte(strconv.FormatUint(uint64(runNumber), 10))
-		err = ioutil.WriteFile(rnf, raw, 0)
+		for _, item := range history.Map() {
+			var record configuration.RunNumberRecord
+			if err = json.Unmarshal([]byte(item.Value()), &record); err != nil {
+				return nil, fmt.Errorf("bad run number history record: %w", err)
+			}
+			records = append(records, record)
+		}
+		sort.Slice(records, func(i, j int) bool { return records[i].RunNumber < records[j].RunNumber })
 		return
+	} else {
+		return listFileRunNumbers()
 	}
 }
 
@@ -187,12 +211,17 @@ func (s *Service) GenerateWorkflowDescriptor(wfPath string, vars map[string]stri
 //}
 
 func (s *Service) GetComponentConfiguration(query *componentcfg.Query) (payload string, err error) {
+	payload, _, err = s.getComponentConfigurationWithTimestamp(query)
+	return
+}
+
+// getComponentConfigurationWithTimestamp also returns the timestamp of the
+// version which was read, or an empty string for a timestampless entry.
+func (s *Service) getComponentConfigurationWithTimestamp(query *componentcfg.Query) (payload string, timestamp string, err error) {
 	if query == nil {
 		return
 	}
 
-	var timestamp string
-
 	if len(query.Timestamp) == 0 {
 		keyPrefix := query.AbsoluteWithoutTimestamp()
 		if s.src.IsDir(keyPrefix) {
@@ -214,6 +243,7 @@ func (s *Service) GetComponentConfiguration(query *componentcfg.Query) (payload
 		payload, err = s.src.Get(absKey)
 	} else {
 		// falling back to timestampless configuration
+		timestamp = ""
 		absKey = query.AbsoluteWithoutTimestamp()
 		payload, err = s.src.Get(absKey)
 	}
@@ -221,6 +251,43 @@ func (s *Service) GetComponentConfiguration(query *componentcfg.Query) (payload
 }
 
 func (s *Service) GetAndProcessComponentConfiguration(query *componentcfg.Query, varStack map[string]string) (payload string, err error) {
+	return processComponentConfiguration(s, query, varStack)
+}
+
+// ResolveComponentConfiguration is like GetComponentConfiguration or
+// GetAndProcessComponentConfiguration, but it also returns every entry read
+// in the process, including templates pulled in via include or extends,
+// each with the timestamp of the version that was used.
+func (s *Service) ResolveComponentConfiguration(query *componentcfg.Query, varStack map[string]string, processTemplate bool) (payload string, entries []*componentcfg.Query, err error) {
+	recorder := &entryRecorder{Service: s, entries: make([]*componentcfg.Query, 0)}
+	if processTemplate {
+		payload, err = processComponentConfiguration(recorder, query, varStack)
+	} else {
+		payload, err = recorder.GetComponentConfiguration(query)
+	}
+	entries = recorder.entries
+	return
+}
+
+// entryRecorder reads component configuration entries from the wrapped
+// Service and keeps track of which versions were read.
+type entryRecorder struct {
+	*Service
+	entries []*componentcfg.Query
+}
+
+func (r *entryRecorder) GetComponentConfiguration(query *componentcfg.Query) (payload string, err error) {
+	var timestamp string
+	payload, timestamp, err = r.getComponentConfigurationWithTimestamp(query)
+	if err == nil && query != nil {
+		resolved := *query
+		resolved.Timestamp = timestamp
+		r.entries = append(r.entries, &resolved)
+	}
+	return
+}
+
+func processComponentConfiguration(confSvc template.ConfigurationService, query *componentcfg.Query, varStack map[string]string) (payload string, err error) {
 	path := query.Path()
 
 	// We need to decompose the requested GetConfig path into prefix and suffix,
@@ -236,18 +303,26 @@ func (s *Service) GetAndProcessComponentConfiguration(query *componentcfg.Query,
 		shortPath = path[indexOfLastSeparator+1:]
 	}
 
-	// We declare a TemplateSet, with a custom TemplateLoader.
-	// Our ConsulTemplateLoader takes control of the FromFile code path
-	// in pongo2, effectively adding support for Consul as file-like
-	// backend.
-	tplSet := pongo2.NewSet("", template.NewConsulTemplateLoader(s, basePath))
 	var tpl *pongo2.Template
-	tpl, err = tplSet.FromFile(shortPath)
+	tpl, err = newTemplateSet(confSvc, basePath).FromFile(shortPath)
 
 	if err != nil {
 		return fmt.Sprintf("{\"error\":\"%s\"}", err.Error()), err
 	}
 
+	payload, err = tpl.Execute(templateBindings(varStack))
+	return
+}
+
+// We declare a TemplateSet, with a custom TemplateLoader.
+// Our ConsulTemplateLoader takes control of the FromFile code path
+// in pongo2, effectively adding support for Consul as file-like
+// backend.
+func newTemplateSet(confSvc template.ConfigurationService, basePath string) *pongo2.TemplateSet {
+	return pongo2.NewSet("", template.NewConsulTemplateLoader(confSvc, basePath))
+}
+
+func templateBindings(varStack map[string]string) map[string]interface{} {
 	bindings := make(map[string]interface{})
 	for k, v := range varStack {
 		bindings[k] = v
@@ -258,9 +333,7 @@ func (s *Service) GetAndProcessComponentConfiguration(query *componentcfg.Query,
 	for k, v := range funcMap {
 		bindings[k] = v
 	}
-
-	payload, err = tpl.Execute(bindings)
-	return
+	return bindings
 }
 
 func (s *Service) RawGetRecursive(path string) (string, error) {
@@ -277,8 +350,12 @@ func (s *Service) RawGetRecursive(path string) (string, error) {
 	return string(cfgBytes[:]), nil
 }
 
+func (s *Service) Watch(ctx context.Context, path string) (<-chan cfgbackend.Change, error) {
+	return s.src.Watch(ctx, path)
+}
+
 func (s *Service) GetDetectorForHost(hostname string) (string, error) {
-	if cSrc, ok := s.src.(*cfgbackend.ConsulSource); ok {
+	if cSrc, ok := s.src.(cfgbackend.KVSource); ok {
 		keys, err := cSrc.GetKeysByPrefix(filepath.Join("o2/hardware", "detectors"))
 		if err != nil {
 			return "", err
@@ -294,12 +371,12 @@ func (s *Service) GetDetectorForHost(hostname string) (string, error) {
 		}
 		return "", fmt.Errorf("detector not found for host %s", hostname)
 	} else {
-		return "", errors.New("runtime KV not supported with file backend")
+		return "", errRuntimeKVNotSupported
 	}
 }
 
 func (s *Service) GetCRUCardsForHost(hostname string) (string, error) {
-	if cSrc, ok := s.src.(*cfgbackend.ConsulSource); ok {
+	if cSrc, ok := s.src.(cfgbackend.KVSource); ok {
 		var cards map[string]Cards
 		var serials []string
 		cfgCards, err := cSrc.Get(filepath.Join("o2/hardware", "flps", hostname, "cards"))
@@ -320,12 +397,12 @@ func (s *Service) GetCRUCardsForHost(hostname string) (string, error) {
 		}
 		return string(bytes), nil
 	} else {
-		return "", errors.New("runtime KV not supported with file backend")
+		return "", errRuntimeKVNotSupported
 	}
 }
 
 func (s *Service) GetEndpointsForCRUCard(hostname, cardSerial string) (string, error) {
-	if cSrc, ok := s.src.(*cfgbackend.ConsulSource); ok {
+	if cSrc, ok := s.src.(cfgbackend.KVSource); ok {
 		var cards map[string]Cards
 		var endpoints string
 		cfgCards, err := cSrc.Get(filepath.Join("o2/hardware", "flps", hostname, "cards"))
@@ -340,23 +417,23 @@ func (s *Service) GetEndpointsForCRUCard(hostname, cardSerial string) (string, e
 		}
 		return endpoints, nil
 	} else {
-		return "", errors.New("runtime KV not supported with file backend")
+		return "", errRuntimeKVNotSupported
 	}
 }
 
 func (s *Service) GetRuntimeEntry(component string, key string) (string, error) {
-	if cSrc, ok := s.src.(*cfgbackend.ConsulSource); ok {
+	if cSrc, ok := s.src.(cfgbackend.KVSource); ok {
 		return cSrc.Get(filepath.Join(getConsulRuntimePrefix(), component, key))
 	} else {
-		return "", errors.New("runtime KV not supported with file backend")
+		return "", errRuntimeKVNotSupported
 	}
 }
 
 func (s *Service) SetRuntimeEntry(component string, key string, value string) error {
-	if cSrc, ok := s.src.(*cfgbackend.ConsulSource); ok {
+	if cSrc, ok := s.src.(cfgbackend.KVSource); ok {
 		return cSrc.Put(filepath.Join(getConsulRuntimePrefix(), component, key), value)
 	} else {
-		return errors.New("runtime KV not supported with file backend")
+		return errRuntimeKVNotSupported
 	}
 }
 
@@ -582,7 +659,16 @@ func (s *Service) ImportComponentConfiguration(query *componentcfg.Query, payloa
 		}
 	}
 
+	err = s.ValidateComponentConfiguration(query, payload)
+	if err != nil {
+		return
+	}
+
 	timestamp := time.Now().Unix()
+	// Two imports within the same second must not overwrite each other
+	if latest, parseErr := strconv.ParseInt(latestTimestamp, 10, 64); parseErr == nil && latest >= timestamp {
+		timestamp = latest + 1
+	}
 	fullKey := query.AbsoluteWithoutTimestamp()
 
 	if useVersioning {
@@ -600,6 +686,280 @@ func (s *Service) ImportComponentConfiguration(query *componentcfg.Query, payloa
 	return
 }
 
+// ValidateComponentConfiguration checks a payload against the schema
+// registered for its component and entry, if any. Templated payloads are
+// rendered with the sample vars of the schema first, so that we validate
+// what a task would actually get at CONFIGURE.
+func (s *Service) ValidateComponentConfiguration(query *componentcfg.Query, payload string) (err error) {
+	if query == nil {
+		return
+	}
+
+	var schema *componentcfg.Schema
+	schema, err = s.getComponentSchema(query)
+	if err != nil || schema == nil {
+		return
+	}
+
+	rendered := payload
+	if strings.Contains(payload, "{{") || strings.Contains(payload, "{%") {
+		// Includes are resolved relative to the entry, like in
+		// GetAndProcessComponentConfiguration
+		basePath := query.Component + componentcfg.SEPARATOR +
+			query.RunType.String() + componentcfg.SEPARATOR +
+			query.RoleName
+		var tpl *pongo2.Template
+		tpl, err = newTemplateSet(s, basePath).FromString(payload)
+		if err == nil {
+			rendered, err = tpl.Execute(templateBindings(schema.SampleVars))
+		}
+		if err != nil {
+			return &componentcfg.ValidationError{
+				Entry:    query.WithoutTimestamp(),
+				Problems: []string{"cannot render template with sample vars: " + err.Error()},
+			}
+		}
+	}
+
+	return schema.Validate(query.WithoutTimestamp(), rendered)
+}
+
+// getComponentSchema returns nil if no schema is registered for the entry.
+func (s *Service) getComponentSchema(query *componentcfg.Query) (schema *componentcfg.Schema, err error) {
+	for _, key := range componentcfg.SchemaKeysForQuery(query) {
+		if exists, _ := s.src.Exists(key); !exists {
+			continue
+		}
+		var raw string
+		raw, err = s.src.Get(key)
+		if err != nil {
+			return
+		}
+		schema, err = componentcfg.ParseSchema(raw)
+		if err != nil {
+			err = fmt.Errorf("bad schema at %s: %w", key, err)
+		}
+		return
+	}
+	return
+}
+
+// getVersionedComponentConfiguration is like GetComponentConfiguration, but
+// fails instead of falling back to the unversioned entry if the requested
+// timestamp doesn't exist.
+func (s *Service) getVersionedComponentConfiguration(query *componentcfg.Query) (payload string, err error) {
+	if len(query.Timestamp) > 0 {
+		if exists, _ := s.src.Exists(query.AbsoluteRaw()); !exists {
+			err = fmt.Errorf("configuration version %s not found", query.Path())
+			return
+		}
+	}
+	return s.GetComponentConfiguration(query)
+}
+
+func (s *Service) DiffComponentConfiguration(from *componentcfg.Query, to *componentcfg.Query) (format string, differences []componentcfg.Difference, err error) {
+	if from == nil || to == nil {
+		err = errors.New("two configuration versions are needed for a diff")
+		return
+	}
+
+	var fromPayload, toPayload string
+	fromPayload, err = s.getVersionedComponentConfiguration(from)
+	if err != nil {
+		return
+	}
+	toPayload, err = s.getVersionedComponentConfiguration(to)
+	if err != nil {
+		return
+	}
+
+	format, differences = componentcfg.DiffPayloads(fromPayload, toPayload)
+	return
+}
+
+// RollbackComponentConfiguration re-imports an old version as the newest
+// one. Nothing is deleted, so the history keeps both the versions which are
+// being rolled back and the rollback itself.
+func (s *Service) RollbackComponentConfiguration(query *componentcfg.Query) (newTimestamp int64, err error) {
+	if query == nil {
+		return
+	}
+	if len(query.Timestamp) == 0 {
+		err = errors.New("rollback requires the timestamp of the version to restore")
+		return
+	}
+
+	var payload string
+	payload, err = s.getVersionedComponentConfiguration(query)
+	if err != nil {
+		return
+	}
+
+	var (
+		keys   []string
+		latest string
+	)
+	keys, err = s.src.GetKeysByPrefix(query.AbsoluteWithoutTimestamp())
+	if err != nil {
+		return
+	}
+	latest, err = componentcfg.GetLatestTimestamp(keys, query)
+	if err != nil {
+		return
+	}
+	if latest == query.Timestamp {
+		err = fmt.Errorf("%s is already the latest version", query.Path())
+		return
+	}
+
+	latestQuery := *query
+	latestQuery.Timestamp = ""
+	_, _, newTimestamp, err = s.ImportComponentConfiguration(&latestQuery, payload, false, true)
+	if err != nil {
+		return
+	}
+
+	log.WithField("entry", latestQuery.Path()).
+		WithField("restoredVersion", query.Timestamp).
+		WithField("newVersion", newTimestamp).
+		Info("component configuration rolled back")
+	return
+}
+
+// runSnapshotManifest is what gets stored under <RunSnapshotsPath>/<run>/manifest,
+// the rendered payloads are stored separately, one key per task.
+type runSnapshotManifest struct {
+	RunNumber     uint32   `json:"runNumber"`
+	EnvironmentId string   `json:"environmentId"`
+	Timestamp     int64    `json:"timestamp"`
+	Entries       []string `json:"entries"`
+}
+
+// StoreRunSnapshot persists the configuration manifest of a run. Snapshots
+// are a record of what a run actually used, so an existing one is never
+// overwritten. The payloads of all tasks are written before the manifest.
+func (s *Service) StoreRunSnapshot(snapshot *componentcfg.RunSnapshot) (err error) {
+	if snapshot == nil || snapshot.RunNumber == 0 {
+		return errors.New("cannot store configuration snapshot without a run number")
+	}
+
+	prefix := componentcfg.RunSnapshotPrefix(snapshot.RunNumber)
+	if exists, _ := s.src.Exists(prefix + "/manifest"); exists {
+		return fmt.Errorf("configuration snapshot for run %d already exists", snapshot.RunNumber)
+	}
+
+	// An environment easily has more tasks than fit in a single transaction,
+	// so each payload is written on its own
+	for rolePath, props := range snapshot.Payloads {
+		var raw []byte
+		raw, err = json.Marshal(props)
+		if err != nil {
+			return
+		}
+		err = s.src.Put(prefix + "/payloads/" + rolePath, string(raw))
+		if err != nil {
+			return
+		}
+	}
+
+	// The manifest goes last, so a snapshot without one is incomplete
+	manifest := runSnapshotManifest{
+		RunNumber:     snapshot.RunNumber,
+		EnvironmentId: snapshot.EnvironmentId,
+		Timestamp:     snapshot.Timestamp,
+		Entries:       snapshot.EntryPaths(),
+	}
+	var raw []byte
+	raw, err = json.MarshalIndent(manifest, "", "\t")
+	if err != nil {
+		return
+	}
+	err = s.src.Put(prefix + "/manifest", string(raw))
+	if err != nil {
+		return
+	}
+
+	log.WithField("run", snapshot.RunNumber).
+		WithField("entries", len(manifest.Entries)).
+		WithField("tasks", len(snapshot.Payloads)).
+		Debug("configuration snapshot stored")
+	return
+}
+
+func (s *Service) GetRunSnapshot(runNumber uint32, withPayloads bool) (snapshot *componentcfg.RunSnapshot, err error) {
+	prefix := componentcfg.RunSnapshotPrefix(runNumber)
+	if exists, _ := s.src.Exists(prefix + "/manifest"); !exists {
+		return nil, fmt.Errorf("no configuration snapshot for run %d", runNumber)
+	}
+
+	var raw string
+	raw, err = s.src.Get(prefix + "/manifest")
+	if err != nil {
+		return
+	}
+	var manifest runSnapshotManifest
+	err = json.Unmarshal([]byte(raw), &manifest)
+	if err != nil {
+		return nil, fmt.Errorf("bad configuration snapshot manifest for run %d: %w", runNumber, err)
+	}
+
+	snapshot = &componentcfg.RunSnapshot{
+		RunNumber:     manifest.RunNumber,
+		EnvironmentId: manifest.EnvironmentId,
+		Timestamp:     manifest.Timestamp,
+		Entries:       make([]*componentcfg.Query, 0, len(manifest.Entries)),
+	}
+	for _, path := range manifest.Entries {
+		var query *componentcfg.Query
+		query, err = componentcfg.NewQuery(path)
+		if err != nil {
+			return nil, fmt.Errorf("bad entry %s in configuration snapshot for run %d: %w", path, runNumber, err)
+		}
+		snapshot.Entries = append(snapshot.Entries, query)
+	}
+
+	if !withPayloads {
+		return
+	}
+
+	snapshot.Payloads = make(map[string]map[string]string)
+	payloadsKey := prefix + "/payloads"
+	if exists, _ := s.src.Exists(payloadsKey); !exists {
+		return
+	}
+	var payloads cfgbackend.Item
+	payloads, err = s.src.GetRecursive(payloadsKey)
+	if err != nil {
+		return
+	}
+	if payloads.Type() != cfgbackend.IT_Map {
+		return nil, fmt.Errorf("bad payloads in configuration snapshot for run %d", runNumber)
+	}
+	for rolePath, item := range payloads.Map() {
+		props := make(map[string]string)
+		err = json.Unmarshal([]byte(item.Value()), &props)
+		if err != nil {
+			return nil, fmt.Errorf("bad payload for %s in configuration snapshot for run %d: %w", rolePath, runNumber, err)
+		}
+		snapshot.Payloads[rolePath] = props
+	}
+	return
+}
+
+func (s *Service) DiffRunSnapshots(fromRunNumber uint32, toRunNumber uint32) (differences []componentcfg.Difference, err error) {
+	var from, to *componentcfg.RunSnapshot
+	from, err = s.GetRunSnapshot(fromRunNumber, true)
+	if err != nil {
+		return
+	}
+	to, err = s.GetRunSnapshot(toRunNumber, true)
+	if err != nil {
+		return
+	}
+	differences = from.Diff(to)
+	return
+}
+
 func getConsulRuntimePrefix() string {
 	// FIXME: this should not be hardcoded
 	return "o2/runtime"
@@ -0,0 +1,125 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2021 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+
+package local
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/AliceO2Group/Control/configuration/cfgbackend/cfgbackendtest"
+	"github.com/AliceO2Group/Control/configuration/componentcfg"
+)
+
+// newConsulTestService returns a Service backed by a fresh Consul stand-in.
+func newConsulTestService(t *testing.T) *Service {
+	t.Helper()
+	server := cfgbackendtest.NewConsulStandIn()
+	t.Cleanup(server.Close)
+	svc, err := NewService("consul://" + strings.TrimPrefix(server.URL, "http://"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return svc
+}
+
+func TestRunSnapshotRoundTrip(t *testing.T) {
+	svc := newConsulTestService(t)
+
+	entry, err := componentcfg.NewQuery("readout/PHYSICS/any/config@1600000000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	snapshot := &componentcfg.RunSnapshot{
+		RunNumber:     42,
+		EnvironmentId: "2oDvieFrVTi",
+		Timestamp:     1600000001000,
+		Entries:       []*componentcfg.Query{entry},
+		Payloads: map[string]map[string]string{
+			"readout-dataflow.host-flp1.readout": {
+				"readout_cfg_uri": "consul-ini://o2/components/readout/PHYSICS/any/config",
+				"config":          "[readout]\nrate=10\n",
+			},
+			"readout-dataflow.host-flp2.readout": {
+				"readout_cfg_uri": "consul-ini://o2/components/readout/PHYSICS/any/config",
+			},
+		},
+	}
+	if err = svc.StoreRunSnapshot(snapshot); err != nil {
+		t.Fatal(err)
+	}
+
+	stored, err := svc.GetRunSnapshot(42, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored.RunNumber != 42 || stored.EnvironmentId != snapshot.EnvironmentId || stored.Timestamp != snapshot.Timestamp {
+		t.Errorf("unexpected snapshot header %d %s %d", stored.RunNumber, stored.EnvironmentId, stored.Timestamp)
+	}
+	if !reflect.DeepEqual(stored.EntryPaths(), snapshot.EntryPaths()) {
+		t.Errorf("expected entries %v, got %v", snapshot.EntryPaths(), stored.EntryPaths())
+	}
+	if !reflect.DeepEqual(stored.Payloads, snapshot.Payloads) {
+		t.Errorf("expected payloads %v, got %v", snapshot.Payloads, stored.Payloads)
+	}
+	if diff := snapshot.Diff(stored); len(diff) != 0 {
+		t.Errorf("stored snapshot differs from the original: %+v", diff)
+	}
+
+	withoutPayloads, err := svc.GetRunSnapshot(42, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(withoutPayloads.Payloads) != 0 {
+		t.Errorf("payloads returned when not asked for")
+	}
+
+	// Snapshots are never overwritten
+	if err = svc.StoreRunSnapshot(snapshot); err == nil {
+		t.Errorf("existing snapshot overwritten")
+	}
+	if _, err = svc.GetRunSnapshot(43, false); err == nil {
+		t.Errorf("missing snapshot returned")
+	}
+}
+
+func TestRunSnapshotWithoutPayloads(t *testing.T) {
+	svc := newConsulTestService(t)
+
+	if err := svc.StoreRunSnapshot(&componentcfg.RunSnapshot{}); err == nil {
+		t.Errorf("snapshot without run number stored")
+	}
+	if err := svc.StoreRunSnapshot(&componentcfg.RunSnapshot{RunNumber: 7}); err != nil {
+		t.Fatal(err)
+	}
+	stored, err := svc.GetRunSnapshot(7, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stored.Entries) != 0 || len(stored.Payloads) != 0 {
+		t.Errorf("unexpected snapshot contents %+v", stored)
+	}
+}
@@ -26,15 +26,37 @@ package local
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io/ioutil"
+	"mime"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/AliceO2Group/Control/common/system"
 	"github.com/AliceO2Group/Control/configuration"
+	"github.com/AliceO2Group/Control/configuration/componentcfg"
 	"github.com/gorilla/mux"
 	"github.com/spf13/viper"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	MIME_JSON = "application/json"
+	MIME_YAML = "application/yaml"
+
+	httpMaxRequestBytes = 16 << 20 // component configuration payloads included
+)
+
+// The REST API returns the same status codes as the gRPC API, translated to
+// HTTP, and errors as {"code": "<gRPC code>", "message": "..."}
+var (
+	E_HTTP_CONFIGURATION_BACKEND_UNAVAILABLE = status.Error(codes.Internal, "configuration backend unavailable")
+	E_HTTP_BAD_INPUT = status.Error(codes.InvalidArgument, "bad request received")
 )
 
 type HttpService struct {
@@ -86,17 +108,518 @@ func (httpsvc *HttpService) ApiGetClusterInformation(w http.ResponseWriter, r *h
 	}
 }
 
-func NewHttpService(service configuration.Service) (svr *http.Server) {
-	router := mux.NewRouter()
-	httpsvc := &HttpService{
-		svc: service,
+type apiError struct {
+	Code    string `json:"code" yaml:"code"`
+	Message string `json:"message" yaml:"message"`
+}
+
+// ImportRequest is the body of PUT /components/{component}/{runType}/{role}/{entry}
+type ImportRequest struct {
+	Payload      string `json:"payload" yaml:"payload"`
+	NewComponent bool   `json:"newComponent" yaml:"newComponent"`
+	NoVersioning bool   `json:"noVersioning" yaml:"noVersioning"`
+	ValidateOnly bool   `json:"validateOnly" yaml:"validateOnly"`
+}
+
+type ImportResponse struct {
+	ExistingComponentUpdated bool  `json:"existingComponentUpdated" yaml:"existingComponentUpdated"`
+	ExistingEntryUpdated     bool  `json:"existingEntryUpdated" yaml:"existingEntryUpdated"`
+	NewTimestamp             int64 `json:"newTimestamp" yaml:"newTimestamp"`
+}
+
+type RuntimeEntry struct {
+	Value string `json:"value" yaml:"value"`
+}
+
+type HostDetector struct {
+	Detector string `json:"detector" yaml:"detector"`
+}
+
+func (httpsvc *HttpService) ApiListComponents(w http.ResponseWriter, r *http.Request) {
+	if httpsvc.svc == nil {
+		writeError(w, r, E_HTTP_CONFIGURATION_BACKEND_UNAVAILABLE)
+		return
+	}
+	components, err := httpsvc.svc.ListComponents()
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeResponse(w, r, http.StatusOK, components)
+}
+
+// ApiListComponentEntries lists the entries of a component. With
+// ?timestamp=true each entry comes with its latest timestamp.
+func (httpsvc *HttpService) ApiListComponentEntries(w http.ResponseWriter, r *http.Request) {
+	if httpsvc.svc == nil {
+		writeError(w, r, E_HTTP_CONFIGURATION_BACKEND_UNAVAILABLE)
+		return
+	}
+	component := mux.Vars(r)["component"]
+	if !componentcfg.IsInputSingleValidWord(component) {
+		writeError(w, r, E_HTTP_BAD_INPUT)
+		return
+	}
+	showLatestTimestamp, _ := strconv.ParseBool(r.URL.Query().Get("timestamp"))
+
+	entries, err := httpsvc.svc.ListComponentEntries(&componentcfg.EntriesQuery{Component: component}, showLatestTimestamp)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeResponse(w, r, http.StatusOK, entries)
+}
+
+// ApiGetComponentConfiguration returns the latest version of an entry, or
+// the one given with ?timestamp=. With ?process=true the entry is rendered
+// as a template, and all other query parameters become template variables.
+func (httpsvc *HttpService) ApiGetComponentConfiguration(w http.ResponseWriter, r *http.Request) {
+	if httpsvc.svc == nil {
+		writeError(w, r, E_HTTP_CONFIGURATION_BACKEND_UNAVAILABLE)
+		return
+	}
+	query, err := componentQueryFromRequest(r)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	params := r.URL.Query()
+	var payload string
+	if process, _ := strconv.ParseBool(params.Get("process")); process {
+		varStack := make(map[string]string)
+		for k := range params {
+			if k != "timestamp" && k != "process" {
+				varStack[k] = params.Get(k)
+			}
+		}
+		payload, err = httpsvc.svc.GetAndProcessComponentConfiguration(query, varStack)
+	} else {
+		payload, err = httpsvc.svc.GetComponentConfiguration(query)
+	}
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeResponse(w, r, http.StatusOK, map[string]string{"payload": payload})
+}
+
+func (httpsvc *HttpService) ApiListComponentEntryHistory(w http.ResponseWriter, r *http.Request) {
+	if httpsvc.svc == nil {
+		writeError(w, r, E_HTTP_CONFIGURATION_BACKEND_UNAVAILABLE)
+		return
+	}
+	query, err := componentQueryFromRequest(r)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	entries, err := httpsvc.svc.ListComponentEntryHistory(query)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeResponse(w, r, http.StatusOK, entries)
+}
+
+func (httpsvc *HttpService) ApiImportComponentConfiguration(w http.ResponseWriter, r *http.Request) {
+	if httpsvc.svc == nil {
+		writeError(w, r, E_HTTP_CONFIGURATION_BACKEND_UNAVAILABLE)
+		return
+	}
+	query, err := componentQueryFromRequest(r)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	var request ImportRequest
+	err = readRequest(w, r, &request)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	if request.ValidateOnly {
+		err = httpsvc.svc.ValidateComponentConfiguration(query, request.Payload)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		writeResponse(w, r, http.StatusOK, struct{}{})
+		return
+	}
+
+	var response ImportResponse
+	response.ExistingComponentUpdated, response.ExistingEntryUpdated, response.NewTimestamp, err =
+		httpsvc.svc.ImportComponentConfiguration(query, request.Payload, request.NewComponent, !request.NoVersioning)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeResponse(w, r, http.StatusOK, response)
+}
+
+func (httpsvc *HttpService) ApiGetRuntimeEntry(w http.ResponseWriter, r *http.Request) {
+	if httpsvc.svc == nil {
+		writeError(w, r, E_HTTP_CONFIGURATION_BACKEND_UNAVAILABLE)
+		return
+	}
+	vars := mux.Vars(r)
+	value, err := httpsvc.svc.GetRuntimeEntry(vars["component"], vars["key"])
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeResponse(w, r, http.StatusOK, RuntimeEntry{Value: value})
+}
+
+func (httpsvc *HttpService) ApiSetRuntimeEntry(w http.ResponseWriter, r *http.Request) {
+	if httpsvc.svc == nil {
+		writeError(w, r, E_HTTP_CONFIGURATION_BACKEND_UNAVAILABLE)
+		return
+	}
+	vars := mux.Vars(r)
+	var request RuntimeEntry
+	err := readRequest(w, r, &request)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	err = httpsvc.svc.SetRuntimeEntry(vars["component"], vars["key"], request.Value)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeResponse(w, r, http.StatusOK, struct{}{})
+}
+
+func (httpsvc *HttpService) ApiGetDetectorForHost(w http.ResponseWriter, r *http.Request) {
+	if httpsvc.svc == nil {
+		writeError(w, r, E_HTTP_CONFIGURATION_BACKEND_UNAVAILABLE)
+		return
+	}
+	detector, err := httpsvc.svc.GetDetectorForHost(mux.Vars(r)["hostname"])
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeResponse(w, r, http.StatusOK, map[string]string{"detector": detector})
+}
+
+func (httpsvc *HttpService) ApiGetCRUCardsForHost(w http.ResponseWriter, r *http.Request) {
+	if httpsvc.svc == nil {
+		writeError(w, r, E_HTTP_CONFIGURATION_BACKEND_UNAVAILABLE)
+		return
+	}
+	cards, err := httpsvc.svc.GetCRUCardsForHost(mux.Vars(r)["hostname"])
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	// The service returns the serials as a JSON array
+	serials := make([]string, 0)
+	err = json.Unmarshal([]byte(cards), &serials)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeResponse(w, r, http.StatusOK, serials)
+}
+
+func (httpsvc *HttpService) ApiGetEndpointsForCRUCard(w http.ResponseWriter, r *http.Request) {
+	if httpsvc.svc == nil {
+		writeError(w, r, E_HTTP_CONFIGURATION_BACKEND_UNAVAILABLE)
+		return
+	}
+	vars := mux.Vars(r)
+	endpoints, err := httpsvc.svc.GetEndpointsForCRUCard(vars["hostname"], vars["cardSerial"])
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeResponse(w, r, http.StatusOK, map[string]string{"endpoints": endpoints})
+}
+
+func (httpsvc *HttpService) ApiAddHost(w http.ResponseWriter, r *http.Request) {
+	if httpsvc.svc == nil {
+		writeError(w, r, E_HTTP_CONFIGURATION_BACKEND_UNAVAILABLE)
+		return
+	}
+	var request HostDetector
+	err := readRequest(w, r, &request)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	err = httpsvc.svc.AddHost(mux.Vars(r)["hostname"], request.Detector)
+	if err != nil {
+		writeError(w, r, err)
+		return
 	}
+	writeResponse(w, r, http.StatusCreated, struct{}{})
+}
+
+func (httpsvc *HttpService) ApiRemoveHost(w http.ResponseWriter, r *http.Request) {
+	if httpsvc.svc == nil {
+		writeError(w, r, E_HTTP_CONFIGURATION_BACKEND_UNAVAILABLE)
+		return
+	}
+	err := httpsvc.svc.RemoveHost(mux.Vars(r)["hostname"])
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeResponse(w, r, http.StatusOK, struct{}{})
+}
+
+func (httpsvc *HttpService) ApiAssignHostToDetector(w http.ResponseWriter, r *http.Request) {
+	if httpsvc.svc == nil {
+		writeError(w, r, E_HTTP_CONFIGURATION_BACKEND_UNAVAILABLE)
+		return
+	}
+	var request HostDetector
+	err := readRequest(w, r, &request)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	err = httpsvc.svc.AssignHostToDetector(mux.Vars(r)["hostname"], request.Detector)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeResponse(w, r, http.StatusOK, struct{}{})
+}
+
+// ApiSetCRUCard registers a CRU card endpoint, the serial in the path takes
+// precedence over the one in the body.
+func (httpsvc *HttpService) ApiSetCRUCard(w http.ResponseWriter, r *http.Request) {
+	if httpsvc.svc == nil {
+		writeError(w, r, E_HTTP_CONFIGURATION_BACKEND_UNAVAILABLE)
+		return
+	}
+	vars := mux.Vars(r)
+	var card configuration.CRUCard
+	err := readRequest(w, r, &card)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	card.Serial = vars["cardSerial"]
+	err = httpsvc.svc.SetCRUCard(vars["hostname"], &card)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeResponse(w, r, http.StatusOK, struct{}{})
+}
+
+// ApiRemoveCRUCard removes a CRU card, or only one of its endpoints if
+// ?endpoint= is given.
+func (httpsvc *HttpService) ApiRemoveCRUCard(w http.ResponseWriter, r *http.Request) {
+	if httpsvc.svc == nil {
+		writeError(w, r, E_HTTP_CONFIGURATION_BACKEND_UNAVAILABLE)
+		return
+	}
+	vars := mux.Vars(r)
+	err := httpsvc.svc.RemoveCRUCard(vars["hostname"], vars["cardSerial"], r.URL.Query().Get("endpoint"))
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeResponse(w, r, http.StatusOK, struct{}{})
+}
+
+func (httpsvc *HttpService) ApiCheckInventory(w http.ResponseWriter, r *http.Request) {
+	if httpsvc.svc == nil {
+		writeError(w, r, E_HTTP_CONFIGURATION_BACKEND_UNAVAILABLE)
+		return
+	}
+	issues, err := httpsvc.svc.CheckInventory()
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeResponse(w, r, http.StatusOK, issues)
+}
+
+// ApiNewRunNumber issues a run number, ?environmentId= is recorded in the
+// run number history.
+func (httpsvc *HttpService) ApiNewRunNumber(w http.ResponseWriter, r *http.Request) {
+	if httpsvc.svc == nil {
+		writeError(w, r, E_HTTP_CONFIGURATION_BACKEND_UNAVAILABLE)
+		return
+	}
+	runNumber, err := httpsvc.svc.NewRunNumber(r.URL.Query().Get("environmentId"))
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeResponse(w, r, http.StatusCreated, map[string]uint32{"runNumber": runNumber})
+}
+
+func (httpsvc *HttpService) ApiListRunNumbers(w http.ResponseWriter, r *http.Request) {
+	if httpsvc.svc == nil {
+		writeError(w, r, E_HTTP_CONFIGURATION_BACKEND_UNAVAILABLE)
+		return
+	}
+	records, err := httpsvc.svc.ListRunNumbers()
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeResponse(w, r, http.StatusOK, records)
+}
+
+func componentQueryFromRequest(r *http.Request) (query *componentcfg.Query, err error) {
+	vars := mux.Vars(r)
+	path := strings.Join([]string{vars["component"], strings.ToUpper(vars["runType"]), vars["role"], vars["entry"]}, componentcfg.SEPARATOR)
+	if timestamp := r.URL.Query().Get("timestamp"); len(timestamp) > 0 {
+		path += "@" + timestamp
+	}
+	query, err = componentcfg.NewQuery(path)
+	if err != nil {
+		return nil, E_HTTP_BAD_INPUT
+	}
+	return
+}
+
+// responseMediaType picks JSON or YAML from the Accept header, JSON being
+// the default.
+func responseMediaType(r *http.Request) string {
+	for _, accepted := range strings.Split(r.Header.Get("Accept"), ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(accepted))
+		if err != nil {
+			continue
+		}
+		switch mediaType {
+		case MIME_JSON:
+			return MIME_JSON
+		case MIME_YAML, "application/x-yaml", "text/yaml", "text/x-yaml":
+			return MIME_YAML
+		}
+	}
+	return MIME_JSON
+}
+
+// readRequest decodes a JSON or YAML request body according to its
+// Content-Type, JSON being the default. Bodies larger than
+// httpMaxRequestBytes are rejected.
+func readRequest(w http.ResponseWriter, r *http.Request, out interface{}) (err error) {
+	var body []byte
+	body, err = ioutil.ReadAll(http.MaxBytesReader(w, r.Body, httpMaxRequestBytes))
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	switch mediaType {
+	case MIME_YAML, "application/x-yaml", "text/yaml", "text/x-yaml":
+		err = yaml.Unmarshal(body, out)
+	case MIME_JSON, "":
+		err = json.Unmarshal(body, out)
+	default:
+		return status.Errorf(codes.InvalidArgument, "unsupported content type %s", mediaType)
+	}
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "cannot decode request: %s", err.Error())
+	}
+	return nil
+}
+
+func writeResponse(w http.ResponseWriter, r *http.Request, statusCode int, response interface{}) {
+	mediaType := responseMediaType(r)
+	var (
+		body []byte
+		err error
+	)
+	if mediaType == MIME_YAML {
+		body, err = yaml.Marshal(response)
+	} else {
+		body, err = json.MarshalIndent(response, "", "\t")
+	}
+	if err != nil {
+		log.WithError(err).Warn("cannot marshal HTTP API response")
+		statusCode = http.StatusInternalServerError
+		body = []byte(err.Error())
+		mediaType = "text/plain"
+	}
+	w.Header().Set("Content-Type", mediaType)
+	w.WriteHeader(statusCode)
+	_, _ = w.Write(body)
+}
+
+func writeError(w http.ResponseWriter, r *http.Request, err error) {
+	st := statusFromError(err)
+	writeResponse(w, r, httpStatusFromCode(st.Code()), apiError{
+		Code:    st.Code().String(),
+		Message: st.Message(),
+	})
+}
+
+// statusFromError classifies errors the same way the gRPC server does.
+func statusFromError(err error) *status.Status {
+	if st, ok := status.FromError(err); ok {
+		return st
+	}
+	var validationErr *componentcfg.ValidationError
+	if errors.As(err, &validationErr) {
+		return status.New(codes.InvalidArgument, err.Error())
+	}
+	var inventoryErr *configuration.InventoryError
+	if errors.As(err, &inventoryErr) {
+		return status.New(codes.InvalidArgument, err.Error())
+	}
+	return status.New(codes.Unknown, err.Error())
+}
+
+// httpStatusFromCode follows the mapping of grpc-gateway.
+func httpStatusFromCode(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Canceled:
+		return 499
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	}
+	return http.StatusInternalServerError
+}
+
+func NewHttpService(service configuration.Service) (svr *http.Server) {
 	httpsvr := &http.Server{
-		Handler:      router,
+		Handler:      newHttpRouter(&HttpService{svc: service}),
 		Addr:         ":" + strconv.Itoa(viper.GetInt("httpListenPort")),
 		WriteTimeout: 15 * time.Second,
 		ReadTimeout:  15 * time.Second,
 	}
+
+	// async-start of http Service and capture error
+	go func() {
+		err := httpsvr.ListenAndServe()
+		log.WithError(err).Fatal("Fatal error with Http Service.")
+	}()
+	return httpsvr
+}
+
+func newHttpRouter(httpsvc *HttpService) *mux.Router {
+	router := mux.NewRouter()
 	apiFlps := router.PathPrefix("/inventory/flps").Subrouter()
 	apiFlps.HandleFunc("", httpsvc.ApiGetFlps).Methods(http.MethodGet)
 	apiFlps.HandleFunc("/", httpsvc.ApiGetFlps).Methods(http.MethodGet)
@@ -106,10 +629,26 @@ func NewHttpService(service configuration.Service) (svr *http.Server) {
 	apiDetectorFlps.HandleFunc("/", httpsvc.ApiGetDetectorFlps).Methods(http.MethodGet)
 	apiDetectorFlps.HandleFunc("/{format}", httpsvc.ApiGetDetectorFlps).Methods(http.MethodGet)
 
-	// async-start of http Service and capture error
-	go func() {
-		err := httpsvr.ListenAndServe()
-		log.WithError(err).Fatal("Fatal error with Http Service.")
-	}()
-	return httpsvr
+	router.HandleFunc("/components", httpsvc.ApiListComponents).Methods(http.MethodGet)
+	router.HandleFunc("/components/{component}", httpsvc.ApiListComponentEntries).Methods(http.MethodGet)
+	apiEntry := router.PathPrefix("/components/{component}/{runType}/{role}/{entry}").Subrouter()
+	apiEntry.HandleFunc("", httpsvc.ApiGetComponentConfiguration).Methods(http.MethodGet)
+	apiEntry.HandleFunc("", httpsvc.ApiImportComponentConfiguration).Methods(http.MethodPut)
+	apiEntry.HandleFunc("/history", httpsvc.ApiListComponentEntryHistory).Methods(http.MethodGet)
+	apiRuntime := router.PathPrefix("/runtime/{component}/{key}").Subrouter()
+	apiRuntime.HandleFunc("", httpsvc.ApiGetRuntimeEntry).Methods(http.MethodGet)
+	apiRuntime.HandleFunc("", httpsvc.ApiSetRuntimeEntry).Methods(http.MethodPut)
+	apiHosts := router.PathPrefix("/hosts/{hostname}").Subrouter()
+	apiHosts.HandleFunc("", httpsvc.ApiAddHost).Methods(http.MethodPut)
+	apiHosts.HandleFunc("", httpsvc.ApiRemoveHost).Methods(http.MethodDelete)
+	apiHosts.HandleFunc("/detector", httpsvc.ApiGetDetectorForHost).Methods(http.MethodGet)
+	apiHosts.HandleFunc("/detector", httpsvc.ApiAssignHostToDetector).Methods(http.MethodPut)
+	apiHosts.HandleFunc("/cards", httpsvc.ApiGetCRUCardsForHost).Methods(http.MethodGet)
+	apiHosts.HandleFunc("/cards/{cardSerial}", httpsvc.ApiSetCRUCard).Methods(http.MethodPut)
+	apiHosts.HandleFunc("/cards/{cardSerial}", httpsvc.ApiRemoveCRUCard).Methods(http.MethodDelete)
+	apiHosts.HandleFunc("/cards/{cardSerial}/endpoints", httpsvc.ApiGetEndpointsForCRUCard).Methods(http.MethodGet)
+	router.HandleFunc("/inventory/check", httpsvc.ApiCheckInventory).Methods(http.MethodGet)
+	router.HandleFunc("/runs/number", httpsvc.ApiNewRunNumber).Methods(http.MethodPost)
+	router.HandleFunc("/runs/numbers", httpsvc.ApiListRunNumbers).Methods(http.MethodGet)
+	return router
 }
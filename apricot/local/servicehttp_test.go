@@ -0,0 +1,219 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2021 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+
+package local
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/AliceO2Group/Control/configuration"
+	"github.com/AliceO2Group/Control/configuration/componentcfg"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// newHttpTestServer serves the REST API of a Service backed by a Consul
+// stand-in, which already holds one component.
+func newHttpTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	svc := newConsulTestService(t)
+	if err := svc.src.Put(componentcfg.ConfigComponentsPath + "qc/ANY/any/config", "{}"); err != nil {
+		t.Fatal(err)
+	}
+	server := httptest.NewServer(newHttpRouter(&HttpService{svc: svc}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func doHttpRequest(t *testing.T, method string, url string, contentType string, body string) (statusCode int, header http.Header, responseBody []byte) {
+	t.Helper()
+	request, err := http.NewRequest(method, url, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(contentType) > 0 {
+		request.Header.Set("Content-Type", contentType)
+	}
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer response.Body.Close()
+	responseBody, err = ioutil.ReadAll(response.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return response.StatusCode, response.Header, responseBody
+}
+
+func TestHttpRuntimeEntry(t *testing.T) {
+	server := newHttpTestServer(t)
+	url := server.URL + "/runtime/aliecs/test_key"
+
+	code, _, body := doHttpRequest(t, http.MethodPut, url, MIME_JSON, `{"value": "test_value"}`)
+	if code != http.StatusOK {
+		t.Fatalf("PUT runtime entry: expected 200, got %d: %s", code, body)
+	}
+	code, _, body = doHttpRequest(t, http.MethodPut, server.URL + "/runtime/aliecs/other_key", MIME_YAML, "value: yaml_value\n")
+	if code != http.StatusOK {
+		t.Fatalf("PUT runtime entry as YAML: expected 200, got %d: %s", code, body)
+	}
+
+	code, header, body := doHttpRequest(t, http.MethodGet, url, "", "")
+	if code != http.StatusOK {
+		t.Fatalf("GET runtime entry: expected 200, got %d: %s", code, body)
+	}
+	if header.Get("Content-Type") != MIME_JSON {
+		t.Errorf("expected JSON response, got %s", header.Get("Content-Type"))
+	}
+	var entry RuntimeEntry
+	if err := json.Unmarshal(body, &entry); err != nil || entry.Value != "test_value" {
+		t.Errorf("unexpected runtime entry %s (%v)", body, err)
+	}
+}
+
+func TestHttpResponseMediaType(t *testing.T) {
+	server := newHttpTestServer(t)
+
+	request, _ := http.NewRequest(http.MethodGet, server.URL + "/components", nil)
+	request.Header.Set("Accept", "text/html, application/yaml;q=0.9")
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = response.Body.Close()
+	if response.StatusCode != http.StatusOK || response.Header.Get("Content-Type") != MIME_YAML {
+		t.Errorf("expected 200 with YAML, got %d with %s", response.StatusCode, response.Header.Get("Content-Type"))
+	}
+}
+
+func TestHttpComponentConfiguration(t *testing.T) {
+	server := newHttpTestServer(t)
+	url := server.URL + "/components/readout/PHYSICS/any/config"
+
+	request, _ := json.Marshal(ImportRequest{Payload: "[readout]\nrate=10\n", NewComponent: true, NoVersioning: true})
+	code, _, body := doHttpRequest(t, http.MethodPut, url, MIME_JSON, string(request))
+	if code != http.StatusOK {
+		t.Fatalf("PUT component configuration: expected 200, got %d: %s", code, body)
+	}
+
+	code, _, body = doHttpRequest(t, http.MethodGet, url, "", "")
+	if code != http.StatusOK {
+		t.Fatalf("GET component configuration: expected 200, got %d: %s", code, body)
+	}
+	var payload map[string]string
+	if err := json.Unmarshal(body, &payload); err != nil || payload["payload"] != "[readout]\nrate=10\n" {
+		t.Errorf("unexpected component configuration %s (%v)", body, err)
+	}
+
+}
+
+func TestHttpRunNumber(t *testing.T) {
+	server := newHttpTestServer(t)
+
+	code, _, body := doHttpRequest(t, http.MethodPost, server.URL + "/runs/number?environmentId=2oDvieFrVTi", "", "")
+	if code != http.StatusCreated {
+		t.Fatalf("POST run number: expected 201, got %d: %s", code, body)
+	}
+	code, _, body = doHttpRequest(t, http.MethodGet, server.URL + "/runs/numbers", "", "")
+	if code != http.StatusOK || !strings.Contains(string(body), "2oDvieFrVTi") {
+		t.Errorf("GET run numbers: expected 200 with the environment, got %d: %s", code, body)
+	}
+}
+
+func TestHttpErrors(t *testing.T) {
+	server := newHttpTestServer(t)
+
+	cases := []struct {
+		name        string
+		method      string
+		path        string
+		contentType string
+		body        string
+		code        int
+		grpcCode    codes.Code
+	}{
+		{"unknown route", http.MethodGet, "/nothing/here", "", "", http.StatusNotFound, codes.OK},
+		{"wrong method", http.MethodPost, "/components", "", "", http.StatusMethodNotAllowed, codes.OK},
+		{"bad component name", http.MethodGet, "/components/bad@name", "", "", http.StatusBadRequest, codes.InvalidArgument},
+		{"bad json", http.MethodPut, "/runtime/aliecs/key", MIME_JSON, `{"value": `, http.StatusBadRequest, codes.InvalidArgument},
+		{"bad yaml", http.MethodPut, "/runtime/aliecs/key", MIME_YAML, "value: [", http.StatusBadRequest, codes.InvalidArgument},
+		{"unsupported content type", http.MethodPut, "/runtime/aliecs/key", "text/plain", "value", http.StatusBadRequest, codes.InvalidArgument},
+		{"oversized body", http.MethodPut, "/runtime/aliecs/key", MIME_JSON, `{"value": "` + strings.Repeat("x", httpMaxRequestBytes) + `"}`, http.StatusBadRequest, codes.InvalidArgument},
+		{"missing runtime entry", http.MethodGet, "/runtime/aliecs/missing", "", "", http.StatusInternalServerError, codes.Unknown},
+		{"missing component configuration", http.MethodGet, "/components/readout/PHYSICS/any/missing", "", "", http.StatusInternalServerError, codes.Unknown},
+	}
+	for _, c := range cases {
+		code, _, body := doHttpRequest(t, c.method, server.URL + c.path, c.contentType, c.body)
+		if code != c.code {
+			t.Errorf("%s: expected %d, got %d: %s", c.name, c.code, code, body)
+			continue
+		}
+		if c.grpcCode == codes.OK {
+			continue
+		}
+		var apiErr apiError
+		if err := json.Unmarshal(body, &apiErr); err != nil {
+			t.Errorf("%s: bad error body %s: %s", c.name, body, err)
+			continue
+		}
+		if apiErr.Code != c.grpcCode.String() || len(apiErr.Message) == 0 {
+			t.Errorf("%s: unexpected error %+v", c.name, apiErr)
+		}
+	}
+}
+
+func TestHttpErrorMapping(t *testing.T) {
+	cases := []struct {
+		err  error
+		code int
+	}{
+		{status.Error(codes.NotFound, "no such entry"), http.StatusNotFound},
+		{status.Error(codes.InvalidArgument, "bad"), http.StatusBadRequest},
+		{status.Error(codes.AlreadyExists, "exists"), http.StatusConflict},
+		{status.Error(codes.Unavailable, "down"), http.StatusServiceUnavailable},
+		{&configuration.InventoryError{}, http.StatusBadRequest},
+		{errors.New("something broke"), http.StatusInternalServerError},
+		{E_HTTP_CONFIGURATION_BACKEND_UNAVAILABLE, http.StatusInternalServerError},
+	}
+	for _, c := range cases {
+		recorder := httptest.NewRecorder()
+		writeError(recorder, httptest.NewRequest(http.MethodGet, "/", nil), c.err)
+		if recorder.Code != c.code {
+			t.Errorf("%v: expected %d, got %d", c.err, c.code, recorder.Code)
+		}
+		var apiErr apiError
+		if err := json.Unmarshal(bytes.TrimSpace(recorder.Body.Bytes()), &apiErr); err != nil || len(apiErr.Code) == 0 {
+			t.Errorf("%v: bad error body %s (%v)", c.err, recorder.Body.String(), err)
+		}
+	}
+}
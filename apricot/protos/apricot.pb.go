@@ -372,6 +372,63 @@ func (x *ComponentResponse) GetPayload() string {
 	return ""
 }
 
+// Same as ComponentResponse, plus every entry which was read to produce the
+// payload, with its resolved timestamp
+type ResolvedComponentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payload string            `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Entries []*ComponentQuery `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *ResolvedComponentResponse) Reset() {
+	*x = ResolvedComponentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_apricot_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResolvedComponentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolvedComponentResponse) ProtoMessage() {}
+
+func (x *ResolvedComponentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_apricot_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolvedComponentResponse.ProtoReflect.Descriptor instead.
+func (*ResolvedComponentResponse) Descriptor() ([]byte, []int) {
+	return file_protos_apricot_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ResolvedComponentResponse) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *ResolvedComponentResponse) GetEntries() []*ComponentQuery {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
 type HostRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -383,7 +440,7 @@ type HostRequest struct {
 func (x *HostRequest) Reset() {
 	*x = HostRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_apricot_proto_msgTypes[4]
+		mi := &file_protos_apricot_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -396,7 +453,7 @@ func (x *HostRequest) String() string {
 func (*HostRequest) ProtoMessage() {}
 
 func (x *HostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_apricot_proto_msgTypes[4]
+	mi := &file_protos_apricot_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -409,7 +466,7 @@ func (x *HostRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HostRequest.ProtoReflect.Descriptor instead.
 func (*HostRequest) Descriptor() ([]byte, []int) {
-	return file_protos_apricot_proto_rawDescGZIP(), []int{4}
+	return file_protos_apricot_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *HostRequest) GetHostname() string {
@@ -430,7 +487,7 @@ type DetectorResponse struct {
 func (x *DetectorResponse) Reset() {
 	*x = DetectorResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_apricot_proto_msgTypes[5]
+		mi := &file_protos_apricot_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -443,7 +500,7 @@ func (x *DetectorResponse) String() string {
 func (*DetectorResponse) ProtoMessage() {}
 
 func (x *DetectorResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_apricot_proto_msgTypes[5]
+	mi := &file_protos_apricot_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -456,7 +513,7 @@ func (x *DetectorResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DetectorResponse.ProtoReflect.Descriptor instead.
 func (*DetectorResponse) Descriptor() ([]byte, []int) {
-	return file_protos_apricot_proto_rawDescGZIP(), []int{5}
+	return file_protos_apricot_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *DetectorResponse) GetPayload() string {
@@ -466,6 +523,53 @@ func (x *DetectorResponse) GetPayload() string {
 	return ""
 }
 
+type NewRunNumberRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EnvironmentId string `protobuf:"bytes,1,opt,name=environmentId,proto3" json:"environmentId,omitempty"`
+}
+
+func (x *NewRunNumberRequest) Reset() {
+	*x = NewRunNumberRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_apricot_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NewRunNumberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewRunNumberRequest) ProtoMessage() {}
+
+func (x *NewRunNumberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_apricot_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewRunNumberRequest.ProtoReflect.Descriptor instead.
+func (*NewRunNumberRequest) Descriptor() ([]byte, []int) {
+	return file_protos_apricot_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *NewRunNumberRequest) GetEnvironmentId() string {
+	if x != nil {
+		return x.EnvironmentId
+	}
+	return ""
+}
+
 type RunNumberResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -477,7 +581,7 @@ type RunNumberResponse struct {
 func (x *RunNumberResponse) Reset() {
 	*x = RunNumberResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_apricot_proto_msgTypes[6]
+		mi := &file_protos_apricot_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -490,7 +594,7 @@ func (x *RunNumberResponse) String() string {
 func (*RunNumberResponse) ProtoMessage() {}
 
 func (x *RunNumberResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_apricot_proto_msgTypes[6]
+	mi := &file_protos_apricot_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -503,7 +607,7 @@ func (x *RunNumberResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RunNumberResponse.ProtoReflect.Descriptor instead.
 func (*RunNumberResponse) Descriptor() ([]byte, []int) {
-	return file_protos_apricot_proto_rawDescGZIP(), []int{6}
+	return file_protos_apricot_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *RunNumberResponse) GetRunNumber() uint32 {
@@ -513,6 +617,116 @@ func (x *RunNumberResponse) GetRunNumber() uint32 {
 	return 0
 }
 
+type RunNumberRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RunNumber     uint32 `protobuf:"varint,1,opt,name=runNumber,proto3" json:"runNumber,omitempty"`
+	EnvironmentId string `protobuf:"bytes,2,opt,name=environmentId,proto3" json:"environmentId,omitempty"`
+	Timestamp     int64  `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // ms since epoch
+}
+
+func (x *RunNumberRecord) Reset() {
+	*x = RunNumberRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_apricot_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RunNumberRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunNumberRecord) ProtoMessage() {}
+
+func (x *RunNumberRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_apricot_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunNumberRecord.ProtoReflect.Descriptor instead.
+func (*RunNumberRecord) Descriptor() ([]byte, []int) {
+	return file_protos_apricot_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RunNumberRecord) GetRunNumber() uint32 {
+	if x != nil {
+		return x.RunNumber
+	}
+	return 0
+}
+
+func (x *RunNumberRecord) GetEnvironmentId() string {
+	if x != nil {
+		return x.EnvironmentId
+	}
+	return ""
+}
+
+func (x *RunNumberRecord) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type RunNumbersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Records []*RunNumberRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+}
+
+func (x *RunNumbersResponse) Reset() {
+	*x = RunNumbersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_apricot_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RunNumbersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunNumbersResponse) ProtoMessage() {}
+
+func (x *RunNumbersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_apricot_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunNumbersResponse.ProtoReflect.Descriptor instead.
+func (*RunNumbersResponse) Descriptor() ([]byte, []int) {
+	return file_protos_apricot_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RunNumbersResponse) GetRecords() []*RunNumberRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
 type StringMap struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -524,7 +738,7 @@ type StringMap struct {
 func (x *StringMap) Reset() {
 	*x = StringMap{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_apricot_proto_msgTypes[7]
+		mi := &file_protos_apricot_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -537,7 +751,7 @@ func (x *StringMap) String() string {
 func (*StringMap) ProtoMessage() {}
 
 func (x *StringMap) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_apricot_proto_msgTypes[7]
+	mi := &file_protos_apricot_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -550,7 +764,7 @@ func (x *StringMap) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StringMap.ProtoReflect.Descriptor instead.
 func (*StringMap) Descriptor() ([]byte, []int) {
-	return file_protos_apricot_proto_rawDescGZIP(), []int{7}
+	return file_protos_apricot_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *StringMap) GetStringMap() map[string]string {
@@ -571,7 +785,7 @@ type RawGetRecursiveRequest struct {
 func (x *RawGetRecursiveRequest) Reset() {
 	*x = RawGetRecursiveRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_apricot_proto_msgTypes[8]
+		mi := &file_protos_apricot_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -584,7 +798,7 @@ func (x *RawGetRecursiveRequest) String() string {
 func (*RawGetRecursiveRequest) ProtoMessage() {}
 
 func (x *RawGetRecursiveRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_apricot_proto_msgTypes[8]
+	mi := &file_protos_apricot_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -597,7 +811,7 @@ func (x *RawGetRecursiveRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RawGetRecursiveRequest.ProtoReflect.Descriptor instead.
 func (*RawGetRecursiveRequest) Descriptor() ([]byte, []int) {
-	return file_protos_apricot_proto_rawDescGZIP(), []int{8}
+	return file_protos_apricot_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *RawGetRecursiveRequest) GetRawPath() string {
@@ -619,7 +833,7 @@ type GetRuntimeEntryRequest struct {
 func (x *GetRuntimeEntryRequest) Reset() {
 	*x = GetRuntimeEntryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_apricot_proto_msgTypes[9]
+		mi := &file_protos_apricot_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -632,7 +846,7 @@ func (x *GetRuntimeEntryRequest) String() string {
 func (*GetRuntimeEntryRequest) ProtoMessage() {}
 
 func (x *GetRuntimeEntryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_apricot_proto_msgTypes[9]
+	mi := &file_protos_apricot_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -645,7 +859,7 @@ func (x *GetRuntimeEntryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRuntimeEntryRequest.ProtoReflect.Descriptor instead.
 func (*GetRuntimeEntryRequest) Descriptor() ([]byte, []int) {
-	return file_protos_apricot_proto_rawDescGZIP(), []int{9}
+	return file_protos_apricot_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GetRuntimeEntryRequest) GetComponent() string {
@@ -675,7 +889,7 @@ type SetRuntimeEntryRequest struct {
 func (x *SetRuntimeEntryRequest) Reset() {
 	*x = SetRuntimeEntryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_apricot_proto_msgTypes[10]
+		mi := &file_protos_apricot_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -688,7 +902,7 @@ func (x *SetRuntimeEntryRequest) String() string {
 func (*SetRuntimeEntryRequest) ProtoMessage() {}
 
 func (x *SetRuntimeEntryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_apricot_proto_msgTypes[10]
+	mi := &file_protos_apricot_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -701,7 +915,7 @@ func (x *SetRuntimeEntryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetRuntimeEntryRequest.ProtoReflect.Descriptor instead.
 func (*SetRuntimeEntryRequest) Descriptor() ([]byte, []int) {
-	return file_protos_apricot_proto_rawDescGZIP(), []int{10}
+	return file_protos_apricot_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *SetRuntimeEntryRequest) GetComponent() string {
@@ -738,7 +952,7 @@ type ComponentEntriesQuery struct {
 func (x *ComponentEntriesQuery) Reset() {
 	*x = ComponentEntriesQuery{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_apricot_proto_msgTypes[11]
+		mi := &file_protos_apricot_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -751,7 +965,7 @@ func (x *ComponentEntriesQuery) String() string {
 func (*ComponentEntriesQuery) ProtoMessage() {}
 
 func (x *ComponentEntriesQuery) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_apricot_proto_msgTypes[11]
+	mi := &file_protos_apricot_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -764,7 +978,7 @@ func (x *ComponentEntriesQuery) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ComponentEntriesQuery.ProtoReflect.Descriptor instead.
 func (*ComponentEntriesQuery) Descriptor() ([]byte, []int) {
-	return file_protos_apricot_proto_rawDescGZIP(), []int{11}
+	return file_protos_apricot_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ComponentEntriesQuery) GetComponent() string {
@@ -803,7 +1017,7 @@ type ListComponentEntriesRequest struct {
 func (x *ListComponentEntriesRequest) Reset() {
 	*x = ListComponentEntriesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_apricot_proto_msgTypes[12]
+		mi := &file_protos_apricot_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -816,7 +1030,7 @@ func (x *ListComponentEntriesRequest) String() string {
 func (*ListComponentEntriesRequest) ProtoMessage() {}
 
 func (x *ListComponentEntriesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_apricot_proto_msgTypes[12]
+	mi := &file_protos_apricot_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -829,7 +1043,7 @@ func (x *ListComponentEntriesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListComponentEntriesRequest.ProtoReflect.Descriptor instead.
 func (*ListComponentEntriesRequest) Descriptor() ([]byte, []int) {
-	return file_protos_apricot_proto_rawDescGZIP(), []int{12}
+	return file_protos_apricot_proto_rawDescGZIP(), []int{16}
 }
 
 func (m *ListComponentEntriesRequest) GetQueryPath() isListComponentEntriesRequest_QueryPath {
@@ -887,7 +1101,7 @@ type ComponentEntriesResponse struct {
 func (x *ComponentEntriesResponse) Reset() {
 	*x = ComponentEntriesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_apricot_proto_msgTypes[13]
+		mi := &file_protos_apricot_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -900,7 +1114,7 @@ func (x *ComponentEntriesResponse) String() string {
 func (*ComponentEntriesResponse) ProtoMessage() {}
 
 func (x *ComponentEntriesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_apricot_proto_msgTypes[13]
+	mi := &file_protos_apricot_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -913,7 +1127,7 @@ func (x *ComponentEntriesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ComponentEntriesResponse.ProtoReflect.Descriptor instead.
 func (*ComponentEntriesResponse) Descriptor() ([]byte, []int) {
-	return file_protos_apricot_proto_rawDescGZIP(), []int{13}
+	return file_protos_apricot_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ComponentEntriesResponse) GetPayload() []string {
@@ -934,7 +1148,7 @@ type HostGetRequest struct {
 func (x *HostGetRequest) Reset() {
 	*x = HostGetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_apricot_proto_msgTypes[14]
+		mi := &file_protos_apricot_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -947,7 +1161,7 @@ func (x *HostGetRequest) String() string {
 func (*HostGetRequest) ProtoMessage() {}
 
 func (x *HostGetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_apricot_proto_msgTypes[14]
+	mi := &file_protos_apricot_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -960,7 +1174,7 @@ func (x *HostGetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HostGetRequest.ProtoReflect.Descriptor instead.
 func (*HostGetRequest) Descriptor() ([]byte, []int) {
-	return file_protos_apricot_proto_rawDescGZIP(), []int{14}
+	return file_protos_apricot_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *HostGetRequest) GetDetector() string {
@@ -981,7 +1195,7 @@ type HostEntriesResponse struct {
 func (x *HostEntriesResponse) Reset() {
 	*x = HostEntriesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_apricot_proto_msgTypes[15]
+		mi := &file_protos_apricot_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -994,7 +1208,7 @@ func (x *HostEntriesResponse) String() string {
 func (*HostEntriesResponse) ProtoMessage() {}
 
 func (x *HostEntriesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_apricot_proto_msgTypes[15]
+	mi := &file_protos_apricot_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1007,7 +1221,7 @@ func (x *HostEntriesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HostEntriesResponse.ProtoReflect.Descriptor instead.
 func (*HostEntriesResponse) Descriptor() ([]byte, []int) {
-	return file_protos_apricot_proto_rawDescGZIP(), []int{15}
+	return file_protos_apricot_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *HostEntriesResponse) GetHosts() []string {
@@ -1031,7 +1245,7 @@ type ImportComponentConfigurationRequest struct {
 func (x *ImportComponentConfigurationRequest) Reset() {
 	*x = ImportComponentConfigurationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_apricot_proto_msgTypes[16]
+		mi := &file_protos_apricot_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1044,7 +1258,7 @@ func (x *ImportComponentConfigurationRequest) String() string {
 func (*ImportComponentConfigurationRequest) ProtoMessage() {}
 
 func (x *ImportComponentConfigurationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_apricot_proto_msgTypes[16]
+	mi := &file_protos_apricot_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1057,7 +1271,7 @@ func (x *ImportComponentConfigurationRequest) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use ImportComponentConfigurationRequest.ProtoReflect.Descriptor instead.
 func (*ImportComponentConfigurationRequest) Descriptor() ([]byte, []int) {
-	return file_protos_apricot_proto_rawDescGZIP(), []int{16}
+	return file_protos_apricot_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *ImportComponentConfigurationRequest) GetQuery() *ComponentQuery {
@@ -1101,7 +1315,7 @@ type ImportComponentConfigurationResponse struct {
 func (x *ImportComponentConfigurationResponse) Reset() {
 	*x = ImportComponentConfigurationResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_apricot_proto_msgTypes[17]
+		mi := &file_protos_apricot_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1114,7 +1328,7 @@ func (x *ImportComponentConfigurationResponse) String() string {
 func (*ImportComponentConfigurationResponse) ProtoMessage() {}
 
 func (x *ImportComponentConfigurationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_apricot_proto_msgTypes[17]
+	mi := &file_protos_apricot_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1127,7 +1341,7 @@ func (x *ImportComponentConfigurationResponse) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use ImportComponentConfigurationResponse.ProtoReflect.Descriptor instead.
 func (*ImportComponentConfigurationResponse) Descriptor() ([]byte, []int) {
-	return file_protos_apricot_proto_rawDescGZIP(), []int{17}
+	return file_protos_apricot_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *ImportComponentConfigurationResponse) GetExistingComponentUpdated() bool {
@@ -1151,31 +1365,32 @@ func (x *ImportComponentConfigurationResponse) GetNewTimestamp() int64 {
 	return 0
 }
 
-type CRUCardsResponse struct {
+type ValidateComponentConfigurationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Cards string `protobuf:"bytes,1,opt,name=cards,proto3" json:"cards,omitempty"`
+	Query   *ComponentQuery `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Payload string          `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
 }
 
-func (x *CRUCardsResponse) Reset() {
-	*x = CRUCardsResponse{}
+func (x *ValidateComponentConfigurationRequest) Reset() {
+	*x = ValidateComponentConfigurationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_apricot_proto_msgTypes[18]
+		mi := &file_protos_apricot_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CRUCardsResponse) String() string {
+func (x *ValidateComponentConfigurationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CRUCardsResponse) ProtoMessage() {}
+func (*ValidateComponentConfigurationRequest) ProtoMessage() {}
 
-func (x *CRUCardsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_apricot_proto_msgTypes[18]
+func (x *ValidateComponentConfigurationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_apricot_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1186,12 +1401,247 @@ func (x *CRUCardsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CRUCardsResponse.ProtoReflect.Descriptor instead.
-func (*CRUCardsResponse) Descriptor() ([]byte, []int) {
-	return file_protos_apricot_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use ValidateComponentConfigurationRequest.ProtoReflect.Descriptor instead.
+func (*ValidateComponentConfigurationRequest) Descriptor() ([]byte, []int) {
+	return file_protos_apricot_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *CRUCardsResponse) GetCards() string {
+func (x *ValidateComponentConfigurationRequest) GetQuery() *ComponentQuery {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+func (x *ValidateComponentConfigurationRequest) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+type DiffComponentConfigurationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From *ComponentQuery `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To   *ComponentQuery `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (x *DiffComponentConfigurationRequest) Reset() {
+	*x = DiffComponentConfigurationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_apricot_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiffComponentConfigurationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffComponentConfigurationRequest) ProtoMessage() {}
+
+func (x *DiffComponentConfigurationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_apricot_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffComponentConfigurationRequest.ProtoReflect.Descriptor instead.
+func (*DiffComponentConfigurationRequest) Descriptor() ([]byte, []int) {
+	return file_protos_apricot_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *DiffComponentConfigurationRequest) GetFrom() *ComponentQuery {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *DiffComponentConfigurationRequest) GetTo() *ComponentQuery {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type ConfigurationDifference struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path     string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Kind     string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"` // added, removed or changed
+	OldValue string `protobuf:"bytes,3,opt,name=oldValue,proto3" json:"oldValue,omitempty"`
+	NewValue string `protobuf:"bytes,4,opt,name=newValue,proto3" json:"newValue,omitempty"`
+}
+
+func (x *ConfigurationDifference) Reset() {
+	*x = ConfigurationDifference{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_apricot_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigurationDifference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigurationDifference) ProtoMessage() {}
+
+func (x *ConfigurationDifference) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_apricot_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigurationDifference.ProtoReflect.Descriptor instead.
+func (*ConfigurationDifference) Descriptor() ([]byte, []int) {
+	return file_protos_apricot_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ConfigurationDifference) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ConfigurationDifference) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ConfigurationDifference) GetOldValue() string {
+	if x != nil {
+		return x.OldValue
+	}
+	return ""
+}
+
+func (x *ConfigurationDifference) GetNewValue() string {
+	if x != nil {
+		return x.NewValue
+	}
+	return ""
+}
+
+type DiffComponentConfigurationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Format      string                     `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"` // json, yaml, ini or text
+	Differences []*ConfigurationDifference `protobuf:"bytes,2,rep,name=differences,proto3" json:"differences,omitempty"`
+}
+
+func (x *DiffComponentConfigurationResponse) Reset() {
+	*x = DiffComponentConfigurationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_apricot_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiffComponentConfigurationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffComponentConfigurationResponse) ProtoMessage() {}
+
+func (x *DiffComponentConfigurationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_apricot_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffComponentConfigurationResponse.ProtoReflect.Descriptor instead.
+func (*DiffComponentConfigurationResponse) Descriptor() ([]byte, []int) {
+	return file_protos_apricot_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DiffComponentConfigurationResponse) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *DiffComponentConfigurationResponse) GetDifferences() []*ConfigurationDifference {
+	if x != nil {
+		return x.Differences
+	}
+	return nil
+}
+
+type CRUCardsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cards string `protobuf:"bytes,1,opt,name=cards,proto3" json:"cards,omitempty"`
+}
+
+func (x *CRUCardsResponse) Reset() {
+	*x = CRUCardsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_apricot_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CRUCardsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CRUCardsResponse) ProtoMessage() {}
+
+func (x *CRUCardsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_apricot_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CRUCardsResponse.ProtoReflect.Descriptor instead.
+func (*CRUCardsResponse) Descriptor() ([]byte, []int) {
+	return file_protos_apricot_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CRUCardsResponse) GetCards() string {
 	if x != nil {
 		return x.Cards
 	}
@@ -1210,7 +1660,7 @@ type CardRequest struct {
 func (x *CardRequest) Reset() {
 	*x = CardRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_apricot_proto_msgTypes[19]
+		mi := &file_protos_apricot_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1223,7 +1673,7 @@ func (x *CardRequest) String() string {
 func (*CardRequest) ProtoMessage() {}
 
 func (x *CardRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_apricot_proto_msgTypes[19]
+	mi := &file_protos_apricot_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1236,7 +1686,7 @@ func (x *CardRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CardRequest.ProtoReflect.Descriptor instead.
 func (*CardRequest) Descriptor() ([]byte, []int) {
-	return file_protos_apricot_proto_rawDescGZIP(), []int{19}
+	return file_protos_apricot_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *CardRequest) GetHostname() string {
@@ -1264,20 +1714,744 @@ type CRUCardEndpointResponse struct {
 func (x *CRUCardEndpointResponse) Reset() {
 	*x = CRUCardEndpointResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_apricot_proto_msgTypes[20]
+		mi := &file_protos_apricot_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CRUCardEndpointResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CRUCardEndpointResponse) ProtoMessage() {}
+
+func (x *CRUCardEndpointResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_apricot_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CRUCardEndpointResponse.ProtoReflect.Descriptor instead.
+func (*CRUCardEndpointResponse) Descriptor() ([]byte, []int) {
+	return file_protos_apricot_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CRUCardEndpointResponse) GetEndpoints() string {
+	if x != nil {
+		return x.Endpoints
+	}
+	return ""
+}
+
+type HostInventoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hostname string `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	Detector string `protobuf:"bytes,2,opt,name=detector,proto3" json:"detector,omitempty"`
+}
+
+func (x *HostInventoryRequest) Reset() {
+	*x = HostInventoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_apricot_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HostInventoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HostInventoryRequest) ProtoMessage() {}
+
+func (x *HostInventoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_apricot_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HostInventoryRequest.ProtoReflect.Descriptor instead.
+func (*HostInventoryRequest) Descriptor() ([]byte, []int) {
+	return file_protos_apricot_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *HostInventoryRequest) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *HostInventoryRequest) GetDetector() string {
+	if x != nil {
+		return x.Detector
+	}
+	return ""
+}
+
+type CRUCard struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type             string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	PciAddress       string `protobuf:"bytes,2,opt,name=pciAddress,proto3" json:"pciAddress,omitempty"`
+	Serial           string `protobuf:"bytes,3,opt,name=serial,proto3" json:"serial,omitempty"`
+	Endpoint         string `protobuf:"bytes,4,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	Numa             string `protobuf:"bytes,5,opt,name=numa,proto3" json:"numa,omitempty"`
+	Firmware         string `protobuf:"bytes,6,opt,name=firmware,proto3" json:"firmware,omitempty"`
+	UserLogicVersion string `protobuf:"bytes,7,opt,name=userLogicVersion,proto3" json:"userLogicVersion,omitempty"`
+}
+
+func (x *CRUCard) Reset() {
+	*x = CRUCard{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_apricot_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CRUCard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CRUCard) ProtoMessage() {}
+
+func (x *CRUCard) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_apricot_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CRUCard.ProtoReflect.Descriptor instead.
+func (*CRUCard) Descriptor() ([]byte, []int) {
+	return file_protos_apricot_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CRUCard) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CRUCard) GetPciAddress() string {
+	if x != nil {
+		return x.PciAddress
+	}
+	return ""
+}
+
+func (x *CRUCard) GetSerial() string {
+	if x != nil {
+		return x.Serial
+	}
+	return ""
+}
+
+func (x *CRUCard) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *CRUCard) GetNuma() string {
+	if x != nil {
+		return x.Numa
+	}
+	return ""
+}
+
+func (x *CRUCard) GetFirmware() string {
+	if x != nil {
+		return x.Firmware
+	}
+	return ""
+}
+
+func (x *CRUCard) GetUserLogicVersion() string {
+	if x != nil {
+		return x.UserLogicVersion
+	}
+	return ""
+}
+
+type SetCRUCardRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hostname string   `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	Card     *CRUCard `protobuf:"bytes,2,opt,name=card,proto3" json:"card,omitempty"`
+}
+
+func (x *SetCRUCardRequest) Reset() {
+	*x = SetCRUCardRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_apricot_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetCRUCardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCRUCardRequest) ProtoMessage() {}
+
+func (x *SetCRUCardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_apricot_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCRUCardRequest.ProtoReflect.Descriptor instead.
+func (*SetCRUCardRequest) Descriptor() ([]byte, []int) {
+	return file_protos_apricot_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SetCRUCardRequest) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *SetCRUCardRequest) GetCard() *CRUCard {
+	if x != nil {
+		return x.Card
+	}
+	return nil
+}
+
+type RemoveCRUCardRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hostname   string `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	CardSerial string `protobuf:"bytes,2,opt,name=cardSerial,proto3" json:"cardSerial,omitempty"`
+	Endpoint   string `protobuf:"bytes,3,opt,name=endpoint,proto3" json:"endpoint,omitempty"` // all endpoints if empty
+}
+
+func (x *RemoveCRUCardRequest) Reset() {
+	*x = RemoveCRUCardRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_apricot_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveCRUCardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCRUCardRequest) ProtoMessage() {}
+
+func (x *RemoveCRUCardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_apricot_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCRUCardRequest.ProtoReflect.Descriptor instead.
+func (*RemoveCRUCardRequest) Descriptor() ([]byte, []int) {
+	return file_protos_apricot_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RemoveCRUCardRequest) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *RemoveCRUCardRequest) GetCardSerial() string {
+	if x != nil {
+		return x.CardSerial
+	}
+	return ""
+}
+
+func (x *RemoveCRUCardRequest) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+type InventoryIssue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind     string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Hostname string `protobuf:"bytes,2,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	Detail   string `protobuf:"bytes,3,opt,name=detail,proto3" json:"detail,omitempty"`
+}
+
+func (x *InventoryIssue) Reset() {
+	*x = InventoryIssue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_apricot_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InventoryIssue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InventoryIssue) ProtoMessage() {}
+
+func (x *InventoryIssue) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_apricot_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InventoryIssue.ProtoReflect.Descriptor instead.
+func (*InventoryIssue) Descriptor() ([]byte, []int) {
+	return file_protos_apricot_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *InventoryIssue) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *InventoryIssue) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *InventoryIssue) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+type InventoryCheckResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Issues []*InventoryIssue `protobuf:"bytes,1,rep,name=issues,proto3" json:"issues,omitempty"`
+}
+
+func (x *InventoryCheckResponse) Reset() {
+	*x = InventoryCheckResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_apricot_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InventoryCheckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InventoryCheckResponse) ProtoMessage() {}
+
+func (x *InventoryCheckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_apricot_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InventoryCheckResponse.ProtoReflect.Descriptor instead.
+func (*InventoryCheckResponse) Descriptor() ([]byte, []int) {
+	return file_protos_apricot_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *InventoryCheckResponse) GetIssues() []*InventoryIssue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+type WatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RawPath string `protobuf:"bytes,1,opt,name=rawPath,proto3" json:"rawPath,omitempty"`
+}
+
+func (x *WatchRequest) Reset() {
+	*x = WatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_apricot_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchRequest) ProtoMessage() {}
+
+func (x *WatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_apricot_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchRequest.ProtoReflect.Descriptor instead.
+func (*WatchRequest) Descriptor() ([]byte, []int) {
+	return file_protos_apricot_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *WatchRequest) GetRawPath() string {
+	if x != nil {
+		return x.RawPath
+	}
+	return ""
+}
+
+type ConfigurationChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Prefix    string   `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Keys      []string `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+	Timestamp int64    `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // ms since epoch
+}
+
+func (x *ConfigurationChange) Reset() {
+	*x = ConfigurationChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_apricot_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigurationChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigurationChange) ProtoMessage() {}
+
+func (x *ConfigurationChange) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_apricot_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigurationChange.ProtoReflect.Descriptor instead.
+func (*ConfigurationChange) Descriptor() ([]byte, []int) {
+	return file_protos_apricot_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ConfigurationChange) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *ConfigurationChange) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *ConfigurationChange) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type RunSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RunNumber     uint32                `protobuf:"varint,1,opt,name=runNumber,proto3" json:"runNumber,omitempty"`
+	EnvironmentId string                `protobuf:"bytes,2,opt,name=environmentId,proto3" json:"environmentId,omitempty"`
+	Timestamp     int64                 `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // ms since epoch
+	Entries       []*ComponentQuery     `protobuf:"bytes,4,rep,name=entries,proto3" json:"entries,omitempty"`
+	Payloads      map[string]*StringMap `protobuf:"bytes,5,rep,name=payloads,proto3" json:"payloads,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // role path -> rendered task properties
+}
+
+func (x *RunSnapshot) Reset() {
+	*x = RunSnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_apricot_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RunSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunSnapshot) ProtoMessage() {}
+
+func (x *RunSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_apricot_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunSnapshot.ProtoReflect.Descriptor instead.
+func (*RunSnapshot) Descriptor() ([]byte, []int) {
+	return file_protos_apricot_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *RunSnapshot) GetRunNumber() uint32 {
+	if x != nil {
+		return x.RunNumber
+	}
+	return 0
+}
+
+func (x *RunSnapshot) GetEnvironmentId() string {
+	if x != nil {
+		return x.EnvironmentId
+	}
+	return ""
+}
+
+func (x *RunSnapshot) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *RunSnapshot) GetEntries() []*ComponentQuery {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *RunSnapshot) GetPayloads() map[string]*StringMap {
+	if x != nil {
+		return x.Payloads
+	}
+	return nil
+}
+
+type GetRunSnapshotRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RunNumber    uint32 `protobuf:"varint,1,opt,name=runNumber,proto3" json:"runNumber,omitempty"`
+	WithPayloads bool   `protobuf:"varint,2,opt,name=withPayloads,proto3" json:"withPayloads,omitempty"`
+}
+
+func (x *GetRunSnapshotRequest) Reset() {
+	*x = GetRunSnapshotRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_apricot_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRunSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRunSnapshotRequest) ProtoMessage() {}
+
+func (x *GetRunSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_apricot_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRunSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*GetRunSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_protos_apricot_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetRunSnapshotRequest) GetRunNumber() uint32 {
+	if x != nil {
+		return x.RunNumber
+	}
+	return 0
+}
+
+func (x *GetRunSnapshotRequest) GetWithPayloads() bool {
+	if x != nil {
+		return x.WithPayloads
+	}
+	return false
+}
+
+type DiffRunSnapshotsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromRunNumber uint32 `protobuf:"varint,1,opt,name=fromRunNumber,proto3" json:"fromRunNumber,omitempty"`
+	ToRunNumber   uint32 `protobuf:"varint,2,opt,name=toRunNumber,proto3" json:"toRunNumber,omitempty"`
+}
+
+func (x *DiffRunSnapshotsRequest) Reset() {
+	*x = DiffRunSnapshotsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_apricot_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiffRunSnapshotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffRunSnapshotsRequest) ProtoMessage() {}
+
+func (x *DiffRunSnapshotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_apricot_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffRunSnapshotsRequest.ProtoReflect.Descriptor instead.
+func (*DiffRunSnapshotsRequest) Descriptor() ([]byte, []int) {
+	return file_protos_apricot_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *DiffRunSnapshotsRequest) GetFromRunNumber() uint32 {
+	if x != nil {
+		return x.FromRunNumber
+	}
+	return 0
+}
+
+func (x *DiffRunSnapshotsRequest) GetToRunNumber() uint32 {
+	if x != nil {
+		return x.ToRunNumber
+	}
+	return 0
+}
+
+type DiffRunSnapshotsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Differences []*ConfigurationDifference `protobuf:"bytes,1,rep,name=differences,proto3" json:"differences,omitempty"`
+}
+
+func (x *DiffRunSnapshotsResponse) Reset() {
+	*x = DiffRunSnapshotsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_apricot_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CRUCardEndpointResponse) String() string {
+func (x *DiffRunSnapshotsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CRUCardEndpointResponse) ProtoMessage() {}
+func (*DiffRunSnapshotsResponse) ProtoMessage() {}
 
-func (x *CRUCardEndpointResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_apricot_proto_msgTypes[20]
+func (x *DiffRunSnapshotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_apricot_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1288,16 +2462,16 @@ func (x *CRUCardEndpointResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CRUCardEndpointResponse.ProtoReflect.Descriptor instead.
-func (*CRUCardEndpointResponse) Descriptor() ([]byte, []int) {
-	return file_protos_apricot_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use DiffRunSnapshotsResponse.ProtoReflect.Descriptor instead.
+func (*DiffRunSnapshotsResponse) Descriptor() ([]byte, []int) {
+	return file_protos_apricot_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *CRUCardEndpointResponse) GetEndpoints() string {
+func (x *DiffRunSnapshotsResponse) GetDifferences() []*ConfigurationDifference {
 	if x != nil {
-		return x.Endpoints
+		return x.Differences
 	}
-	return ""
+	return nil
 }
 
 var File_protos_apricot_proto protoreflect.FileDescriptor
@@ -1337,186 +2511,400 @@ var file_protos_apricot_proto_rawDesc = []byte{
 	0x22, 0x2d, 0x0a, 0x11, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
 	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22,
-	0x29, 0x0a, 0x0b, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
-	0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x2c, 0x0a, 0x10, 0x44, 0x65,
-	0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
-	0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x31, 0x0a, 0x11, 0x52, 0x75, 0x6e, 0x4e,
-	0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a,
-	0x09, 0x72, 0x75, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x09, 0x72, 0x75, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x8a, 0x01, 0x0a, 0x09,
-	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x70, 0x12, 0x3f, 0x0a, 0x09, 0x73, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x4d, 0x61, 0x70, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61,
-	0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x70,
-	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x09, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x70, 0x1a, 0x3c, 0x0a, 0x0e, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x32, 0x0a, 0x16, 0x52, 0x61, 0x77, 0x47,
-	0x65, 0x74, 0x52, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x61, 0x77, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x61, 0x77, 0x50, 0x61, 0x74, 0x68, 0x22, 0x48, 0x0a, 0x16,
-	0x47, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x68, 0x0a, 0x19, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x31, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f,
+	0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x29, 0x0a, 0x0b, 0x48, 0x6f, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74,
+	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x2c, 0x0a, 0x10, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x22, 0x3b, 0x0a, 0x13, 0x4e, 0x65, 0x77, 0x52, 0x75, 0x6e, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x65, 0x6e, 0x76,
+	0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22,
+	0x31, 0x0a, 0x11, 0x52, 0x75, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x75, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x75, 0x6e, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x22, 0x73, 0x0a, 0x0f, 0x52, 0x75, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x75, 0x6e, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x75, 0x6e, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x6e, 0x76, 0x69,
+	0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x48, 0x0a, 0x12, 0x52, 0x75, 0x6e, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a,
+	0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x52, 0x75, 0x6e, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x73, 0x22, 0x8a, 0x01, 0x0a, 0x09, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x70, 0x12,
+	0x3f, 0x0a, 0x09, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x70, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x4d, 0x61, 0x70, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x70,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x70,
+	0x1a, 0x3c, 0x0a, 0x0e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x32,
+	0x0a, 0x16, 0x52, 0x61, 0x77, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x61, 0x77, 0x50,
+	0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x61, 0x77, 0x50, 0x61,
+	0x74, 0x68, 0x22, 0x48, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x5e, 0x0a, 0x16,
+	0x53, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
 	0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
 	0x6e, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x5e, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x52, 0x75, 0x6e,
-	0x74, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x83, 0x01, 0x0a, 0x15, 0x43, 0x6f, 0x6d, 0x70, 0x6f,
-	0x6e, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x2a,
-	0x0a, 0x07, 0x72, 0x75, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x10, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x52, 0x75, 0x6e, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x07, 0x72, 0x75, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x61,
-	0x63, 0x68, 0x69, 0x6e, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x22, 0xa6, 0x01, 0x0a,
-	0x1b, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x45, 0x6e,
-	0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x04,
-	0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x70, 0x61,
-	0x74, 0x68, 0x12, 0x36, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x70,
-	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x48, 0x00, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x2c, 0x0a, 0x11, 0x69, 0x6e,
-	0x63, 0x6c, 0x75, 0x64, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x42, 0x0b, 0x0a, 0x09, 0x71, 0x75, 0x65, 0x72,
-	0x79, 0x50, 0x61, 0x74, 0x68, 0x22, 0x34, 0x0a, 0x18, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
-	0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x2c, 0x0a, 0x0e, 0x48,
-	0x6f, 0x73, 0x74, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
-	0x08, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x2b, 0x0a, 0x13, 0x48, 0x6f, 0x73,
-	0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x14, 0x0a, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x22, 0xb8, 0x01, 0x0a, 0x23, 0x49, 0x6d, 0x70, 0x6f, 0x72,
-	0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d,
-	0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
-	0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
-	0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x18, 0x0a,
-	0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x6e, 0x65, 0x77, 0x43, 0x6f,
-	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6e,
-	0x65, 0x77, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x75,
-	0x73, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0d, 0x75, 0x73, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e,
-	0x67, 0x22, 0xba, 0x01, 0x0a, 0x24, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6d, 0x70,
-	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x18, 0x65, 0x78,
-	0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x18, 0x65, 0x78,
-	0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x14, 0x65, 0x78, 0x69, 0x73, 0x74, 0x69,
-	0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x65, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x6e, 0x65,
-	0x77, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0c, 0x6e, 0x65, 0x77, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x28,
-	0x0a, 0x10, 0x43, 0x52, 0x55, 0x43, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x63, 0x61, 0x72, 0x64, 0x73, 0x22, 0x49, 0x0a, 0x0b, 0x43, 0x61, 0x72, 0x64,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x72, 0x64, 0x53, 0x65, 0x72, 0x69, 0x61,
-	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x72, 0x64, 0x53, 0x65, 0x72,
-	0x69, 0x61, 0x6c, 0x22, 0x37, 0x0a, 0x17, 0x43, 0x52, 0x55, 0x43, 0x61, 0x72, 0x64, 0x45, 0x6e,
-	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c,
-	0x0a, 0x09, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x2a, 0x77, 0x0a, 0x07,
-	0x52, 0x75, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x55, 0x4c, 0x4c, 0x10,
-	0x00, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x4e, 0x59, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x48,
-	0x59, 0x53, 0x49, 0x43, 0x53, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x54, 0x45, 0x43, 0x48, 0x4e,
-	0x49, 0x43, 0x41, 0x4c, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x4f, 0x53, 0x4d, 0x49, 0x43,
-	0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08, 0x50, 0x45, 0x44, 0x45, 0x53, 0x54, 0x41, 0x4c, 0x10, 0x05,
-	0x12, 0x12, 0x0a, 0x0e, 0x54, 0x48, 0x52, 0x45, 0x53, 0x48, 0x4f, 0x4c, 0x44, 0x5f, 0x53, 0x43,
-	0x41, 0x4e, 0x10, 0x06, 0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x41, 0x4c, 0x49, 0x42, 0x52, 0x41, 0x54,
-	0x49, 0x4f, 0x4e, 0x10, 0x07, 0x32, 0xa4, 0x09, 0x0a, 0x07, 0x41, 0x70, 0x72, 0x69, 0x63, 0x6f,
-	0x74, 0x12, 0x3c, 0x0a, 0x0c, 0x4e, 0x65, 0x77, 0x52, 0x75, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65,
-	0x72, 0x12, 0x0e, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x52, 0x75, 0x6e, 0x4e,
-	0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x33, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x0e,
-	0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x12,
-	0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d,
-	0x61, 0x70, 0x22, 0x00, 0x12, 0x2f, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x56, 0x61, 0x72, 0x73, 0x12,
-	0x0e, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
-	0x12, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x4d, 0x61, 0x70, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0f, 0x52, 0x61, 0x77, 0x47, 0x65, 0x74, 0x52,
-	0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63,
-	0x6f, 0x74, 0x2e, 0x52, 0x61, 0x77, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69,
-	0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x72, 0x69,
-	0x63, 0x6f, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x44, 0x65,
-	0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x46, 0x6f, 0x72, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x14, 0x2e,
-	0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x44, 0x65,
-	0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x47, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x52, 0x55, 0x43, 0x61, 0x72, 0x64, 0x73, 0x46,
-	0x6f, 0x72, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x14, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74,
-	0x2e, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x61,
-	0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x43, 0x52, 0x55, 0x43, 0x61, 0x72, 0x64, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x16, 0x47, 0x65, 0x74,
-	0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x43, 0x52, 0x55, 0x43,
-	0x61, 0x72, 0x64, 0x12, 0x14, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x43, 0x61,
-	0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x70, 0x72, 0x69,
-	0x63, 0x6f, 0x74, 0x2e, 0x43, 0x52, 0x55, 0x43, 0x61, 0x72, 0x64, 0x45, 0x6e, 0x64, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a,
-	0x0f, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x1f, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x75,
-	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x70,
-	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x44, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x53, 0x65, 0x74,
-	0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74,
-	0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x17, 0x2e, 0x61, 0x70, 0x72, 0x69,
-	0x63, 0x6f, 0x74, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x48, 0x6f, 0x73,
-	0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x45, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
-	0x65, 0x6e, 0x74, 0x73, 0x12, 0x0e, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x21, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x43,
-	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x14, 0x4c, 0x69, 0x73,
-	0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65,
-	0x73, 0x12, 0x24, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x83, 0x01, 0x0a,
+	0x15, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
+	0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x07, 0x72, 0x75, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e,
+	0x52, 0x75, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x72, 0x75, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x52, 0x6f,
+	0x6c, 0x65, 0x22, 0xa6, 0x01, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x14, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x36, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f,
 	0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69,
-	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x19,
-	0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x17, 0x2e, 0x61, 0x70, 0x72, 0x69,
-	0x63, 0x6f, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x1a, 0x21, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x43, 0x6f, 0x6d,
-	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x43, 0x6f,
-	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x43,
-	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1a, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7d, 0x0a,
-	0x1c, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x2e,
-	0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f,
+	0x65, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x48, 0x00, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x12, 0x2c, 0x0a, 0x11, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x69, 0x6e, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x42, 0x0b,
+	0x0a, 0x09, 0x71, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x74, 0x68, 0x22, 0x34, 0x0a, 0x18, 0x43,
+	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x22, 0x2c, 0x0a, 0x0e, 0x48, 0x6f, 0x73, 0x74, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x22,
+	0x2b, 0x0a, 0x13, 0x48, 0x6f, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x22, 0xb8, 0x01, 0x0a,
+	0x23, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x43, 0x6f,
+	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x05, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x22, 0x0a,
+	0x0c, 0x6e, 0x65, 0x77, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0c, 0x6e, 0x65, 0x77, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
+	0x74, 0x12, 0x24, 0x0a, 0x0d, 0x75, 0x73, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x69,
+	0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x75, 0x73, 0x65, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x22, 0xba, 0x01, 0x0a, 0x24, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3a, 0x0a, 0x18, 0x65, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6d, 0x70,
+	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x18, 0x65, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6d, 0x70,
+	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x14,
+	0x65, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x65, 0x78, 0x69, 0x73,
+	0x74, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x12, 0x22, 0x0a, 0x0c, 0x6e, 0x65, 0x77, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6e, 0x65, 0x77, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x22, 0x70, 0x0a, 0x25, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a,
+	0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61,
+	0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07,
+	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x79, 0x0a, 0x21, 0x44, 0x69, 0x66, 0x66, 0x43, 0x6f,
 	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x61, 0x70,
-	0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6d, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x04, 0x66,
+	0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x72, 0x69,
+	0x63, 0x6f, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x27, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x43,
+	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x02, 0x74,
+	0x6f, 0x22, 0x79, 0x0a, 0x17, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x44, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6b, 0x69, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x65, 0x77, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x80, 0x01, 0x0a,
+	0x22, 0x44, 0x69, 0x66, 0x66, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x42, 0x0a, 0x0b, 0x64,
+	0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x52, 0x0b, 0x64, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x22,
+	0x28, 0x0a, 0x10, 0x43, 0x52, 0x55, 0x43, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x63, 0x61, 0x72, 0x64, 0x73, 0x22, 0x49, 0x0a, 0x0b, 0x43, 0x61, 0x72,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x72, 0x64, 0x53, 0x65, 0x72, 0x69,
+	0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x72, 0x64, 0x53, 0x65,
+	0x72, 0x69, 0x61, 0x6c, 0x22, 0x37, 0x0a, 0x17, 0x43, 0x52, 0x55, 0x43, 0x61, 0x72, 0x64, 0x45,
+	0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x4e, 0x0a,
+	0x14, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x22, 0xcd, 0x01,
+	0x0a, 0x07, 0x43, 0x52, 0x55, 0x43, 0x61, 0x72, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a,
+	0x0a, 0x70, 0x63, 0x69, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x70, 0x63, 0x69, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x65, 0x72, 0x69, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x75, 0x6d, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x75, 0x6d, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72,
+	0x65, 0x12, 0x2a, 0x0a, 0x10, 0x75, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x63, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x75, 0x73, 0x65,
+	0x72, 0x4c, 0x6f, 0x67, 0x69, 0x63, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x55, 0x0a,
+	0x11, 0x53, 0x65, 0x74, 0x43, 0x52, 0x55, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x24,
+	0x0a, 0x04, 0x63, 0x61, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61,
+	0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x43, 0x52, 0x55, 0x43, 0x61, 0x72, 0x64, 0x52, 0x04,
+	0x63, 0x61, 0x72, 0x64, 0x22, 0x6e, 0x0a, 0x14, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x52,
+	0x55, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x72, 0x64,
+	0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61,
+	0x72, 0x64, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x22, 0x58, 0x0a, 0x0e, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72,
+	0x79, 0x49, 0x73, 0x73, 0x75, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f,
+	0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f,
+	0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x22, 0x49,
+	0x0a, 0x16, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63,
+	0x6f, 0x74, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x73, 0x73, 0x75,
+	0x65, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x22, 0x28, 0x0a, 0x0c, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x61, 0x77,
+	0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x61, 0x77, 0x50,
+	0x61, 0x74, 0x68, 0x22, 0x5f, 0x0a, 0x13, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66,
+	0x69, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x22, 0xb3, 0x02, 0x0a, 0x0b, 0x52, 0x75, 0x6e, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x75, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x75, 0x6e, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x6e, 0x76, 0x69, 0x72,
+	0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x31, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f,
+	0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x3e, 0x0a, 0x08, 0x70, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x70,
+	0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x52, 0x75, 0x6e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x2e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x08, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x1a, 0x4f, 0x0a, 0x0d, 0x50, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x28, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x70,
+	0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x70, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x59, 0x0a, 0x15, 0x47, 0x65,
+	0x74, 0x52, 0x75, 0x6e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x75, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x75, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x22, 0x0a, 0x0c, 0x77, 0x69, 0x74, 0x68, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x77, 0x69, 0x74, 0x68, 0x50, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x73, 0x22, 0x61, 0x0a, 0x17, 0x44, 0x69, 0x66, 0x66, 0x52, 0x75, 0x6e,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x24, 0x0a, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x52, 0x75, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x52, 0x75, 0x6e,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x6f, 0x52, 0x75, 0x6e, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x74, 0x6f, 0x52,
+	0x75, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x5e, 0x0a, 0x18, 0x44, 0x69, 0x66, 0x66,
+	0x52, 0x75, 0x6e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x0b, 0x64, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x70, 0x72, 0x69,
+	0x63, 0x6f, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x44, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0b, 0x64, 0x69, 0x66,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x2a, 0x77, 0x0a, 0x07, 0x52, 0x75, 0x6e, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x55, 0x4c, 0x4c, 0x10, 0x00, 0x12, 0x07, 0x0a,
+	0x03, 0x41, 0x4e, 0x59, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x48, 0x59, 0x53, 0x49, 0x43,
+	0x53, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x54, 0x45, 0x43, 0x48, 0x4e, 0x49, 0x43, 0x41, 0x4c,
+	0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x4f, 0x53, 0x4d, 0x49, 0x43, 0x10, 0x04, 0x12, 0x0c,
+	0x0a, 0x08, 0x50, 0x45, 0x44, 0x45, 0x53, 0x54, 0x41, 0x4c, 0x10, 0x05, 0x12, 0x12, 0x0a, 0x0e,
+	0x54, 0x48, 0x52, 0x45, 0x53, 0x48, 0x4f, 0x4c, 0x44, 0x5f, 0x53, 0x43, 0x41, 0x4e, 0x10, 0x06,
+	0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x41, 0x4c, 0x49, 0x42, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10,
+	0x07, 0x32, 0xbf, 0x12, 0x0a, 0x07, 0x41, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x12, 0x4a, 0x0a,
+	0x0c, 0x4e, 0x65, 0x77, 0x52, 0x75, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1c, 0x2e,
+	0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x4e, 0x65, 0x77, 0x52, 0x75, 0x6e, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70,
+	0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x52, 0x75, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0e, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x75, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x0e, 0x2e, 0x61, 0x70,
+	0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1b, 0x2e, 0x61, 0x70,
+	0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x52, 0x75, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x0b, 0x47, 0x65,
+	0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x0e, 0x2e, 0x61, 0x70, 0x72, 0x69,
+	0x63, 0x6f, 0x74, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x12, 0x2e, 0x61, 0x70, 0x72, 0x69,
+	0x63, 0x6f, 0x74, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x70, 0x22, 0x00, 0x12,
+	0x2f, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x56, 0x61, 0x72, 0x73, 0x12, 0x0e, 0x2e, 0x61, 0x70, 0x72,
+	0x69, 0x63, 0x6f, 0x74, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x12, 0x2e, 0x61, 0x70, 0x72,
+	0x69, 0x63, 0x6f, 0x74, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x70, 0x22, 0x00,
+	0x12, 0x50, 0x0a, 0x0f, 0x52, 0x61, 0x77, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x75, 0x72, 0x73,
+	0x69, 0x76, 0x65, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x52, 0x61,
+	0x77, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x43,
+	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x47, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x46, 0x6f, 0x72, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x14, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63,
+	0x6f, 0x74, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19,
+	0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x12, 0x47,
+	0x65, 0x74, 0x43, 0x52, 0x55, 0x43, 0x61, 0x72, 0x64, 0x73, 0x46, 0x6f, 0x72, 0x48, 0x6f, 0x73,
+	0x74, 0x12, 0x14, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x48, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f,
+	0x74, 0x2e, 0x43, 0x52, 0x55, 0x43, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x64, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x43, 0x52, 0x55, 0x43, 0x61, 0x72, 0x64, 0x12, 0x14,
+	0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x43,
+	0x52, 0x55, 0x43, 0x61, 0x72, 0x64, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x48,
+	0x6f, 0x73, 0x74, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x48, 0x6f,
+	0x73, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x0a, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x48, 0x6f,
+	0x73, 0x74, 0x12, 0x14, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x48, 0x6f, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63,
+	0x6f, 0x74, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x14, 0x41, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x48, 0x6f, 0x73, 0x74, 0x54, 0x6f, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x48, 0x6f, 0x73,
+	0x74, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x0e, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0a, 0x53, 0x65, 0x74, 0x43, 0x52, 0x55, 0x43, 0x61, 0x72,
+	0x64, 0x12, 0x1a, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x53, 0x65, 0x74, 0x43,
+	0x52, 0x55, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e,
+	0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12,
+	0x40, 0x0a, 0x0d, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x52, 0x55, 0x43, 0x61, 0x72, 0x64,
+	0x12, 0x1d, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x43, 0x52, 0x55, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x0e, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x00, 0x12, 0x43, 0x0a, 0x0e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74,
+	0x6f, 0x72, 0x79, 0x12, 0x0e, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x1a, 0x1f, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x49, 0x6e,
+	0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6e,
+	0x74, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x72, 0x69,
+	0x63, 0x6f, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x72,
+	0x69, 0x63, 0x6f, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x52,
+	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x1f, 0x2e, 0x61, 0x70,
+	0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x53, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x61,
+	0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4b,
+	0x0a, 0x10, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f,
+	0x72, 0x79, 0x12, 0x17, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x48, 0x6f, 0x73,
+	0x74, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x70,
+	0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0e, 0x4c,
+	0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x0e, 0x2e,
+	0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x21, 0x2e,
+	0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
+	0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x61, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
+	0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x24, 0x2e, 0x61, 0x70, 0x72,
+	0x69, 0x63, 0x6f, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
+	0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x21, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x12, 0x17, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x43, 0x6f, 0x6d,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x1a, 0x21, 0x2e, 0x61, 0x70,
+	0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x45,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x54, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x2e,
+	0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63,
+	0x6f, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x1d, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76,
+	0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f,
+	0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x52, 0x65, 0x73,
+	0x6f, 0x6c, 0x76, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7d, 0x0a, 0x1c, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63,
+	0x6f, 0x74, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
+	0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74,
+	0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x1e, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x2e, 0x61, 0x70, 0x72, 0x69,
+	0x63, 0x6f, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x70,
 	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x36, 0x0a, 0x22,
-	0x63, 0x68, 0x2e, 0x63, 0x65, 0x72, 0x6e, 0x2e, 0x61, 0x6c, 0x69, 0x63, 0x65, 0x2e, 0x6f, 0x32,
-	0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x72, 0x70, 0x63, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x5a, 0x10, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x3b, 0x61, 0x70, 0x72, 0x69, 0x63,
-	0x6f, 0x74, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x61, 0x70, 0x72, 0x69,
+	0x63, 0x6f, 0x74, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x77, 0x0a, 0x1a, 0x44,
+	0x69, 0x66, 0x66, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x2e, 0x61, 0x70, 0x72, 0x69,
+	0x63, 0x6f, 0x74, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
+	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e,
+	0x44, 0x69, 0x66, 0x66, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x6a, 0x0a, 0x1e, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b,
+	0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74,
+	0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x1a,
+	0x2d, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x3a, 0x0a, 0x10, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x75, 0x6e, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x12, 0x14, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x52,
+	0x75, 0x6e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x1a, 0x0e, 0x2e, 0x61, 0x70, 0x72,
+	0x69, 0x63, 0x6f, 0x74, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0e,
+	0x47, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x1e,
+	0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14,
+	0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x52, 0x75, 0x6e, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x10, 0x44, 0x69, 0x66, 0x66, 0x52, 0x75,
+	0x6e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x72,
+	0x69, 0x63, 0x6f, 0x74, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x52, 0x75, 0x6e, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61,
+	0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x52, 0x75, 0x6e, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x40, 0x0a, 0x05, 0x57, 0x61, 0x74, 0x63, 0x68, 0x12, 0x15, 0x2e, 0x61, 0x70, 0x72,
+	0x69, 0x63, 0x6f, 0x74, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x22,
+	0x00, 0x30, 0x01, 0x42, 0x36, 0x0a, 0x22, 0x63, 0x68, 0x2e, 0x63, 0x65, 0x72, 0x6e, 0x2e, 0x61,
+	0x6c, 0x69, 0x63, 0x65, 0x2e, 0x6f, 0x32, 0x2e, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x2e,
+	0x72, 0x70, 0x63, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5a, 0x10, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x73, 0x3b, 0x61, 0x70, 0x72, 0x69, 0x63, 0x6f, 0x74, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1532,76 +2920,139 @@ func file_protos_apricot_proto_rawDescGZIP() []byte {
 }
 
 var file_protos_apricot_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_protos_apricot_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
+var file_protos_apricot_proto_msgTypes = make([]protoimpl.MessageInfo, 44)
 var file_protos_apricot_proto_goTypes = []interface{}{
-	(RunType)(0),                                 // 0: apricot.RunType
-	(*Empty)(nil),                                // 1: apricot.Empty
-	(*ComponentQuery)(nil),                       // 2: apricot.ComponentQuery
-	(*ComponentRequest)(nil),                     // 3: apricot.ComponentRequest
-	(*ComponentResponse)(nil),                    // 4: apricot.ComponentResponse
-	(*HostRequest)(nil),                          // 5: apricot.HostRequest
-	(*DetectorResponse)(nil),                     // 6: apricot.DetectorResponse
-	(*RunNumberResponse)(nil),                    // 7: apricot.RunNumberResponse
-	(*StringMap)(nil),                            // 8: apricot.StringMap
-	(*RawGetRecursiveRequest)(nil),               // 9: apricot.RawGetRecursiveRequest
-	(*GetRuntimeEntryRequest)(nil),               // 10: apricot.GetRuntimeEntryRequest
-	(*SetRuntimeEntryRequest)(nil),               // 11: apricot.SetRuntimeEntryRequest
-	(*ComponentEntriesQuery)(nil),                // 12: apricot.ComponentEntriesQuery
-	(*ListComponentEntriesRequest)(nil),          // 13: apricot.ListComponentEntriesRequest
-	(*ComponentEntriesResponse)(nil),             // 14: apricot.ComponentEntriesResponse
-	(*HostGetRequest)(nil),                       // 15: apricot.HostGetRequest
-	(*HostEntriesResponse)(nil),                  // 16: apricot.HostEntriesResponse
-	(*ImportComponentConfigurationRequest)(nil),  // 17: apricot.ImportComponentConfigurationRequest
-	(*ImportComponentConfigurationResponse)(nil), // 18: apricot.ImportComponentConfigurationResponse
-	(*CRUCardsResponse)(nil),                     // 19: apricot.CRUCardsResponse
-	(*CardRequest)(nil),                          // 20: apricot.CardRequest
-	(*CRUCardEndpointResponse)(nil),              // 21: apricot.CRUCardEndpointResponse
-	nil,                                          // 22: apricot.ComponentRequest.VarStackEntry
-	nil,                                          // 23: apricot.StringMap.StringMapEntry
+	(RunType)(0),                                  // 0: apricot.RunType
+	(*Empty)(nil),                                 // 1: apricot.Empty
+	(*ComponentQuery)(nil),                        // 2: apricot.ComponentQuery
+	(*ComponentRequest)(nil),                      // 3: apricot.ComponentRequest
+	(*ComponentResponse)(nil),                     // 4: apricot.ComponentResponse
+	(*ResolvedComponentResponse)(nil),             // 5: apricot.ResolvedComponentResponse
+	(*HostRequest)(nil),                           // 6: apricot.HostRequest
+	(*DetectorResponse)(nil),                      // 7: apricot.DetectorResponse
+	(*NewRunNumberRequest)(nil),                   // 8: apricot.NewRunNumberRequest
+	(*RunNumberResponse)(nil),                     // 9: apricot.RunNumberResponse
+	(*RunNumberRecord)(nil),                       // 10: apricot.RunNumberRecord
+	(*RunNumbersResponse)(nil),                    // 11: apricot.RunNumbersResponse
+	(*StringMap)(nil),                             // 12: apricot.StringMap
+	(*RawGetRecursiveRequest)(nil),                // 13: apricot.RawGetRecursiveRequest
+	(*GetRuntimeEntryRequest)(nil),                // 14: apricot.GetRuntimeEntryRequest
+	(*SetRuntimeEntryRequest)(nil),                // 15: apricot.SetRuntimeEntryRequest
+	(*ComponentEntriesQuery)(nil),                 // 16: apricot.ComponentEntriesQuery
+	(*ListComponentEntriesRequest)(nil),           // 17: apricot.ListComponentEntriesRequest
+	(*ComponentEntriesResponse)(nil),              // 18: apricot.ComponentEntriesResponse
+	(*HostGetRequest)(nil),                        // 19: apricot.HostGetRequest
+	(*HostEntriesResponse)(nil),                   // 20: apricot.HostEntriesResponse
+	(*ImportComponentConfigurationRequest)(nil),   // 21: apricot.ImportComponentConfigurationRequest
+	(*ImportComponentConfigurationResponse)(nil),  // 22: apricot.ImportComponentConfigurationResponse
+	(*ValidateComponentConfigurationRequest)(nil), // 23: apricot.ValidateComponentConfigurationRequest
+	(*DiffComponentConfigurationRequest)(nil),     // 24: apricot.DiffComponentConfigurationRequest
+	(*ConfigurationDifference)(nil),               // 25: apricot.ConfigurationDifference
+	(*DiffComponentConfigurationResponse)(nil),    // 26: apricot.DiffComponentConfigurationResponse
+	(*CRUCardsResponse)(nil),                      // 27: apricot.CRUCardsResponse
+	(*CardRequest)(nil),                           // 28: apricot.CardRequest
+	(*CRUCardEndpointResponse)(nil),               // 29: apricot.CRUCardEndpointResponse
+	(*HostInventoryRequest)(nil),                  // 30: apricot.HostInventoryRequest
+	(*CRUCard)(nil),                               // 31: apricot.CRUCard
+	(*SetCRUCardRequest)(nil),                     // 32: apricot.SetCRUCardRequest
+	(*RemoveCRUCardRequest)(nil),                  // 33: apricot.RemoveCRUCardRequest
+	(*InventoryIssue)(nil),                        // 34: apricot.InventoryIssue
+	(*InventoryCheckResponse)(nil),                // 35: apricot.InventoryCheckResponse
+	(*WatchRequest)(nil),                          // 36: apricot.WatchRequest
+	(*ConfigurationChange)(nil),                   // 37: apricot.ConfigurationChange
+	(*RunSnapshot)(nil),                           // 38: apricot.RunSnapshot
+	(*GetRunSnapshotRequest)(nil),                 // 39: apricot.GetRunSnapshotRequest
+	(*DiffRunSnapshotsRequest)(nil),               // 40: apricot.DiffRunSnapshotsRequest
+	(*DiffRunSnapshotsResponse)(nil),              // 41: apricot.DiffRunSnapshotsResponse
+	nil,                                           // 42: apricot.ComponentRequest.VarStackEntry
+	nil,                                           // 43: apricot.StringMap.StringMapEntry
+	nil,                                           // 44: apricot.RunSnapshot.PayloadsEntry
 }
 var file_protos_apricot_proto_depIdxs = []int32{
 	0,  // 0: apricot.ComponentQuery.runType:type_name -> apricot.RunType
 	2,  // 1: apricot.ComponentRequest.query:type_name -> apricot.ComponentQuery
-	22, // 2: apricot.ComponentRequest.varStack:type_name -> apricot.ComponentRequest.VarStackEntry
-	23, // 3: apricot.StringMap.stringMap:type_name -> apricot.StringMap.StringMapEntry
-	0,  // 4: apricot.ComponentEntriesQuery.runType:type_name -> apricot.RunType
-	12, // 5: apricot.ListComponentEntriesRequest.query:type_name -> apricot.ComponentEntriesQuery
-	2,  // 6: apricot.ImportComponentConfigurationRequest.query:type_name -> apricot.ComponentQuery
-	1,  // 7: apricot.Apricot.NewRunNumber:input_type -> apricot.Empty
-	1,  // 8: apricot.Apricot.GetDefaults:input_type -> apricot.Empty
-	1,  // 9: apricot.Apricot.GetVars:input_type -> apricot.Empty
-	9,  // 10: apricot.Apricot.RawGetRecursive:input_type -> apricot.RawGetRecursiveRequest
-	5,  // 11: apricot.Apricot.GetDetectorForHost:input_type -> apricot.HostRequest
-	5,  // 12: apricot.Apricot.GetCRUCardsForHost:input_type -> apricot.HostRequest
-	20, // 13: apricot.Apricot.GetEndpointsForCRUCard:input_type -> apricot.CardRequest
-	10, // 14: apricot.Apricot.GetRuntimeEntry:input_type -> apricot.GetRuntimeEntryRequest
-	11, // 15: apricot.Apricot.SetRuntimeEntry:input_type -> apricot.SetRuntimeEntryRequest
-	15, // 16: apricot.Apricot.GetHostInventory:input_type -> apricot.HostGetRequest
-	1,  // 17: apricot.Apricot.ListComponents:input_type -> apricot.Empty
-	13, // 18: apricot.Apricot.ListComponentEntries:input_type -> apricot.ListComponentEntriesRequest
-	2,  // 19: apricot.Apricot.ListComponentEntryHistory:input_type -> apricot.ComponentQuery
-	3,  // 20: apricot.Apricot.GetComponentConfiguration:input_type -> apricot.ComponentRequest
-	17, // 21: apricot.Apricot.ImportComponentConfiguration:input_type -> apricot.ImportComponentConfigurationRequest
-	7,  // 22: apricot.Apricot.NewRunNumber:output_type -> apricot.RunNumberResponse
-	8,  // 23: apricot.Apricot.GetDefaults:output_type -> apricot.StringMap
-	8,  // 24: apricot.Apricot.GetVars:output_type -> apricot.StringMap
-	4,  // 25: apricot.Apricot.RawGetRecursive:output_type -> apricot.ComponentResponse
-	6,  // 26: apricot.Apricot.GetDetectorForHost:output_type -> apricot.DetectorResponse
-	19, // 27: apricot.Apricot.GetCRUCardsForHost:output_type -> apricot.CRUCardsResponse
-	21, // 28: apricot.Apricot.GetEndpointsForCRUCard:output_type -> apricot.CRUCardEndpointResponse
-	4,  // 29: apricot.Apricot.GetRuntimeEntry:output_type -> apricot.ComponentResponse
-	1,  // 30: apricot.Apricot.SetRuntimeEntry:output_type -> apricot.Empty
-	16, // 31: apricot.Apricot.GetHostInventory:output_type -> apricot.HostEntriesResponse
-	14, // 32: apricot.Apricot.ListComponents:output_type -> apricot.ComponentEntriesResponse
-	14, // 33: apricot.Apricot.ListComponentEntries:output_type -> apricot.ComponentEntriesResponse
-	14, // 34: apricot.Apricot.ListComponentEntryHistory:output_type -> apricot.ComponentEntriesResponse
-	4,  // 35: apricot.Apricot.GetComponentConfiguration:output_type -> apricot.ComponentResponse
-	18, // 36: apricot.Apricot.ImportComponentConfiguration:output_type -> apricot.ImportComponentConfigurationResponse
-	22, // [22:37] is the sub-list for method output_type
-	7,  // [7:22] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	42, // 2: apricot.ComponentRequest.varStack:type_name -> apricot.ComponentRequest.VarStackEntry
+	2,  // 3: apricot.ResolvedComponentResponse.entries:type_name -> apricot.ComponentQuery
+	10, // 4: apricot.RunNumbersResponse.records:type_name -> apricot.RunNumberRecord
+	43, // 5: apricot.StringMap.stringMap:type_name -> apricot.StringMap.StringMapEntry
+	0,  // 6: apricot.ComponentEntriesQuery.runType:type_name -> apricot.RunType
+	16, // 7: apricot.ListComponentEntriesRequest.query:type_name -> apricot.ComponentEntriesQuery
+	2,  // 8: apricot.ImportComponentConfigurationRequest.query:type_name -> apricot.ComponentQuery
+	2,  // 9: apricot.ValidateComponentConfigurationRequest.query:type_name -> apricot.ComponentQuery
+	2,  // 10: apricot.DiffComponentConfigurationRequest.from:type_name -> apricot.ComponentQuery
+	2,  // 11: apricot.DiffComponentConfigurationRequest.to:type_name -> apricot.ComponentQuery
+	25, // 12: apricot.DiffComponentConfigurationResponse.differences:type_name -> apricot.ConfigurationDifference
+	31, // 13: apricot.SetCRUCardRequest.card:type_name -> apricot.CRUCard
+	34, // 14: apricot.InventoryCheckResponse.issues:type_name -> apricot.InventoryIssue
+	2,  // 15: apricot.RunSnapshot.entries:type_name -> apricot.ComponentQuery
+	44, // 16: apricot.RunSnapshot.payloads:type_name -> apricot.RunSnapshot.PayloadsEntry
+	25, // 17: apricot.DiffRunSnapshotsResponse.differences:type_name -> apricot.ConfigurationDifference
+	12, // 18: apricot.RunSnapshot.PayloadsEntry.value:type_name -> apricot.StringMap
+	8,  // 19: apricot.Apricot.NewRunNumber:input_type -> apricot.NewRunNumberRequest
+	1,  // 20: apricot.Apricot.ListRunNumbers:input_type -> apricot.Empty
+	1,  // 21: apricot.Apricot.GetDefaults:input_type -> apricot.Empty
+	1,  // 22: apricot.Apricot.GetVars:input_type -> apricot.Empty
+	13, // 23: apricot.Apricot.RawGetRecursive:input_type -> apricot.RawGetRecursiveRequest
+	6,  // 24: apricot.Apricot.GetDetectorForHost:input_type -> apricot.HostRequest
+	6,  // 25: apricot.Apricot.GetCRUCardsForHost:input_type -> apricot.HostRequest
+	28, // 26: apricot.Apricot.GetEndpointsForCRUCard:input_type -> apricot.CardRequest
+	30, // 27: apricot.Apricot.AddHost:input_type -> apricot.HostInventoryRequest
+	6,  // 28: apricot.Apricot.RemoveHost:input_type -> apricot.HostRequest
+	30, // 29: apricot.Apricot.AssignHostToDetector:input_type -> apricot.HostInventoryRequest
+	32, // 30: apricot.Apricot.SetCRUCard:input_type -> apricot.SetCRUCardRequest
+	33, // 31: apricot.Apricot.RemoveCRUCard:input_type -> apricot.RemoveCRUCardRequest
+	1,  // 32: apricot.Apricot.CheckInventory:input_type -> apricot.Empty
+	14, // 33: apricot.Apricot.GetRuntimeEntry:input_type -> apricot.GetRuntimeEntryRequest
+	15, // 34: apricot.Apricot.SetRuntimeEntry:input_type -> apricot.SetRuntimeEntryRequest
+	19, // 35: apricot.Apricot.GetHostInventory:input_type -> apricot.HostGetRequest
+	1,  // 36: apricot.Apricot.ListComponents:input_type -> apricot.Empty
+	17, // 37: apricot.Apricot.ListComponentEntries:input_type -> apricot.ListComponentEntriesRequest
+	2,  // 38: apricot.Apricot.ListComponentEntryHistory:input_type -> apricot.ComponentQuery
+	3,  // 39: apricot.Apricot.GetComponentConfiguration:input_type -> apricot.ComponentRequest
+	3,  // 40: apricot.Apricot.ResolveComponentConfiguration:input_type -> apricot.ComponentRequest
+	21, // 41: apricot.Apricot.ImportComponentConfiguration:input_type -> apricot.ImportComponentConfigurationRequest
+	23, // 42: apricot.Apricot.ValidateComponentConfiguration:input_type -> apricot.ValidateComponentConfigurationRequest
+	24, // 43: apricot.Apricot.DiffComponentConfiguration:input_type -> apricot.DiffComponentConfigurationRequest
+	2,  // 44: apricot.Apricot.RollbackComponentConfiguration:input_type -> apricot.ComponentQuery
+	38, // 45: apricot.Apricot.StoreRunSnapshot:input_type -> apricot.RunSnapshot
+	39, // 46: apricot.Apricot.GetRunSnapshot:input_type -> apricot.GetRunSnapshotRequest
+	40, // 47: apricot.Apricot.DiffRunSnapshots:input_type -> apricot.DiffRunSnapshotsRequest
+	36, // 48: apricot.Apricot.Watch:input_type -> apricot.WatchRequest
+	9,  // 49: apricot.Apricot.NewRunNumber:output_type -> apricot.RunNumberResponse
+	11, // 50: apricot.Apricot.ListRunNumbers:output_type -> apricot.RunNumbersResponse
+	12, // 51: apricot.Apricot.GetDefaults:output_type -> apricot.StringMap
+	12, // 52: apricot.Apricot.GetVars:output_type -> apricot.StringMap
+	4,  // 53: apricot.Apricot.RawGetRecursive:output_type -> apricot.ComponentResponse
+	7,  // 54: apricot.Apricot.GetDetectorForHost:output_type -> apricot.DetectorResponse
+	27, // 55: apricot.Apricot.GetCRUCardsForHost:output_type -> apricot.CRUCardsResponse
+	29, // 56: apricot.Apricot.GetEndpointsForCRUCard:output_type -> apricot.CRUCardEndpointResponse
+	1,  // 57: apricot.Apricot.AddHost:output_type -> apricot.Empty
+	1,  // 58: apricot.Apricot.RemoveHost:output_type -> apricot.Empty
+	1,  // 59: apricot.Apricot.AssignHostToDetector:output_type -> apricot.Empty
+	1,  // 60: apricot.Apricot.SetCRUCard:output_type -> apricot.Empty
+	1,  // 61: apricot.Apricot.RemoveCRUCard:output_type -> apricot.Empty
+	35, // 62: apricot.Apricot.CheckInventory:output_type -> apricot.InventoryCheckResponse
+	4,  // 63: apricot.Apricot.GetRuntimeEntry:output_type -> apricot.ComponentResponse
+	1,  // 64: apricot.Apricot.SetRuntimeEntry:output_type -> apricot.Empty
+	20, // 65: apricot.Apricot.GetHostInventory:output_type -> apricot.HostEntriesResponse
+	18, // 66: apricot.Apricot.ListComponents:output_type -> apricot.ComponentEntriesResponse
+	18, // 67: apricot.Apricot.ListComponentEntries:output_type -> apricot.ComponentEntriesResponse
+	18, // 68: apricot.Apricot.ListComponentEntryHistory:output_type -> apricot.ComponentEntriesResponse
+	4,  // 69: apricot.Apricot.GetComponentConfiguration:output_type -> apricot.ComponentResponse
+	5,  // 70: apricot.Apricot.ResolveComponentConfiguration:output_type -> apricot.ResolvedComponentResponse
+	22, // 71: apricot.Apricot.ImportComponentConfiguration:output_type -> apricot.ImportComponentConfigurationResponse
+	1,  // 72: apricot.Apricot.ValidateComponentConfiguration:output_type -> apricot.Empty
+	26, // 73: apricot.Apricot.DiffComponentConfiguration:output_type -> apricot.DiffComponentConfigurationResponse
+	22, // 74: apricot.Apricot.RollbackComponentConfiguration:output_type -> apricot.ImportComponentConfigurationResponse
+	1,  // 75: apricot.Apricot.StoreRunSnapshot:output_type -> apricot.Empty
+	38, // 76: apricot.Apricot.GetRunSnapshot:output_type -> apricot.RunSnapshot
+	41, // 77: apricot.Apricot.DiffRunSnapshots:output_type -> apricot.DiffRunSnapshotsResponse
+	37, // 78: apricot.Apricot.Watch:output_type -> apricot.ConfigurationChange
+	49, // [49:79] is the sub-list for method output_type
+	19, // [19:49] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_protos_apricot_proto_init() }
@@ -1659,7 +3110,7 @@ func file_protos_apricot_proto_init() {
 			}
 		}
 		file_protos_apricot_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostRequest); i {
+			switch v := v.(*ResolvedComponentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1671,7 +3122,7 @@ func file_protos_apricot_proto_init() {
 			}
 		}
 		file_protos_apricot_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DetectorResponse); i {
+			switch v := v.(*HostRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1683,7 +3134,7 @@ func file_protos_apricot_proto_init() {
 			}
 		}
 		file_protos_apricot_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RunNumberResponse); i {
+			switch v := v.(*DetectorResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1695,7 +3146,7 @@ func file_protos_apricot_proto_init() {
 			}
 		}
 		file_protos_apricot_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StringMap); i {
+			switch v := v.(*NewRunNumberRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1707,7 +3158,7 @@ func file_protos_apricot_proto_init() {
 			}
 		}
 		file_protos_apricot_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RawGetRecursiveRequest); i {
+			switch v := v.(*RunNumberResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1719,7 +3170,7 @@ func file_protos_apricot_proto_init() {
 			}
 		}
 		file_protos_apricot_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRuntimeEntryRequest); i {
+			switch v := v.(*RunNumberRecord); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1731,7 +3182,7 @@ func file_protos_apricot_proto_init() {
 			}
 		}
 		file_protos_apricot_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetRuntimeEntryRequest); i {
+			switch v := v.(*RunNumbersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1743,7 +3194,7 @@ func file_protos_apricot_proto_init() {
 			}
 		}
 		file_protos_apricot_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ComponentEntriesQuery); i {
+			switch v := v.(*StringMap); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1755,7 +3206,7 @@ func file_protos_apricot_proto_init() {
 			}
 		}
 		file_protos_apricot_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListComponentEntriesRequest); i {
+			switch v := v.(*RawGetRecursiveRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1767,7 +3218,7 @@ func file_protos_apricot_proto_init() {
 			}
 		}
 		file_protos_apricot_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ComponentEntriesResponse); i {
+			switch v := v.(*GetRuntimeEntryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1779,7 +3230,7 @@ func file_protos_apricot_proto_init() {
 			}
 		}
 		file_protos_apricot_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostGetRequest); i {
+			switch v := v.(*SetRuntimeEntryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1791,7 +3242,7 @@ func file_protos_apricot_proto_init() {
 			}
 		}
 		file_protos_apricot_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostEntriesResponse); i {
+			switch v := v.(*ComponentEntriesQuery); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1803,7 +3254,7 @@ func file_protos_apricot_proto_init() {
 			}
 		}
 		file_protos_apricot_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ImportComponentConfigurationRequest); i {
+			switch v := v.(*ListComponentEntriesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1815,7 +3266,7 @@ func file_protos_apricot_proto_init() {
 			}
 		}
 		file_protos_apricot_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ImportComponentConfigurationResponse); i {
+			switch v := v.(*ComponentEntriesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1827,7 +3278,7 @@ func file_protos_apricot_proto_init() {
 			}
 		}
 		file_protos_apricot_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CRUCardsResponse); i {
+			switch v := v.(*HostGetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1839,7 +3290,7 @@ func file_protos_apricot_proto_init() {
 			}
 		}
 		file_protos_apricot_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CardRequest); i {
+			switch v := v.(*HostEntriesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1851,6 +3302,102 @@ func file_protos_apricot_proto_init() {
 			}
 		}
 		file_protos_apricot_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportComponentConfigurationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_apricot_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportComponentConfigurationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_apricot_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateComponentConfigurationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_apricot_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffComponentConfigurationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_apricot_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigurationDifference); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_apricot_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffComponentConfigurationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_apricot_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CRUCardsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_apricot_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CardRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_apricot_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CRUCardEndpointResponse); i {
 			case 0:
 				return &v.state
@@ -1862,12 +3409,156 @@ func file_protos_apricot_proto_init() {
 				return nil
 			}
 		}
+		file_protos_apricot_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostInventoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_apricot_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CRUCard); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_apricot_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetCRUCardRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_apricot_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveCRUCardRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_apricot_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InventoryIssue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_apricot_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InventoryCheckResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_apricot_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_apricot_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigurationChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_apricot_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RunSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_apricot_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRunSnapshotRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_apricot_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffRunSnapshotsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_apricot_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffRunSnapshotsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_protos_apricot_proto_msgTypes[2].OneofWrappers = []interface{}{
 		(*ComponentRequest_Path)(nil),
 		(*ComponentRequest_Query)(nil),
 	}
-	file_protos_apricot_proto_msgTypes[12].OneofWrappers = []interface{}{
+	file_protos_apricot_proto_msgTypes[16].OneofWrappers = []interface{}{
 		(*ListComponentEntriesRequest_Path)(nil),
 		(*ListComponentEntriesRequest_Query)(nil),
 	}
@@ -1877,7 +3568,7 @@ func file_protos_apricot_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_protos_apricot_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   23,
+			NumMessages:   44,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
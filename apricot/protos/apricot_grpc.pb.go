@@ -17,13 +17,20 @@ const _ = grpc.SupportPackageIsVersion7
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type ApricotClient interface {
-	NewRunNumber(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*RunNumberResponse, error)
+	NewRunNumber(ctx context.Context, in *NewRunNumberRequest, opts ...grpc.CallOption) (*RunNumberResponse, error)
+	ListRunNumbers(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*RunNumbersResponse, error)
 	GetDefaults(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*StringMap, error)
 	GetVars(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*StringMap, error)
 	RawGetRecursive(ctx context.Context, in *RawGetRecursiveRequest, opts ...grpc.CallOption) (*ComponentResponse, error)
 	GetDetectorForHost(ctx context.Context, in *HostRequest, opts ...grpc.CallOption) (*DetectorResponse, error)
 	GetCRUCardsForHost(ctx context.Context, in *HostRequest, opts ...grpc.CallOption) (*CRUCardsResponse, error)
 	GetEndpointsForCRUCard(ctx context.Context, in *CardRequest, opts ...grpc.CallOption) (*CRUCardEndpointResponse, error)
+	AddHost(ctx context.Context, in *HostInventoryRequest, opts ...grpc.CallOption) (*Empty, error)
+	RemoveHost(ctx context.Context, in *HostRequest, opts ...grpc.CallOption) (*Empty, error)
+	AssignHostToDetector(ctx context.Context, in *HostInventoryRequest, opts ...grpc.CallOption) (*Empty, error)
+	SetCRUCard(ctx context.Context, in *SetCRUCardRequest, opts ...grpc.CallOption) (*Empty, error)
+	RemoveCRUCard(ctx context.Context, in *RemoveCRUCardRequest, opts ...grpc.CallOption) (*Empty, error)
+	CheckInventory(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*InventoryCheckResponse, error)
 	GetRuntimeEntry(ctx context.Context, in *GetRuntimeEntryRequest, opts ...grpc.CallOption) (*ComponentResponse, error)
 	SetRuntimeEntry(ctx context.Context, in *SetRuntimeEntryRequest, opts ...grpc.CallOption) (*Empty, error)
 	GetHostInventory(ctx context.Context, in *HostGetRequest, opts ...grpc.CallOption) (*HostEntriesResponse, error)
@@ -31,7 +38,15 @@ type ApricotClient interface {
 	ListComponentEntries(ctx context.Context, in *ListComponentEntriesRequest, opts ...grpc.CallOption) (*ComponentEntriesResponse, error)
 	ListComponentEntryHistory(ctx context.Context, in *ComponentQuery, opts ...grpc.CallOption) (*ComponentEntriesResponse, error)
 	GetComponentConfiguration(ctx context.Context, in *ComponentRequest, opts ...grpc.CallOption) (*ComponentResponse, error)
+	ResolveComponentConfiguration(ctx context.Context, in *ComponentRequest, opts ...grpc.CallOption) (*ResolvedComponentResponse, error)
 	ImportComponentConfiguration(ctx context.Context, in *ImportComponentConfigurationRequest, opts ...grpc.CallOption) (*ImportComponentConfigurationResponse, error)
+	ValidateComponentConfiguration(ctx context.Context, in *ValidateComponentConfigurationRequest, opts ...grpc.CallOption) (*Empty, error)
+	DiffComponentConfiguration(ctx context.Context, in *DiffComponentConfigurationRequest, opts ...grpc.CallOption) (*DiffComponentConfigurationResponse, error)
+	RollbackComponentConfiguration(ctx context.Context, in *ComponentQuery, opts ...grpc.CallOption) (*ImportComponentConfigurationResponse, error)
+	StoreRunSnapshot(ctx context.Context, in *RunSnapshot, opts ...grpc.CallOption) (*Empty, error)
+	GetRunSnapshot(ctx context.Context, in *GetRunSnapshotRequest, opts ...grpc.CallOption) (*RunSnapshot, error)
+	DiffRunSnapshots(ctx context.Context, in *DiffRunSnapshotsRequest, opts ...grpc.CallOption) (*DiffRunSnapshotsResponse, error)
+	Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (Apricot_WatchClient, error)
 }
 
 type apricotClient struct {
@@ -42,7 +57,7 @@ func NewApricotClient(cc grpc.ClientConnInterface) ApricotClient {
 	return &apricotClient{cc}
 }
 
-func (c *apricotClient) NewRunNumber(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*RunNumberResponse, error) {
+func (c *apricotClient) NewRunNumber(ctx context.Context, in *NewRunNumberRequest, opts ...grpc.CallOption) (*RunNumberResponse, error) {
 	out := new(RunNumberResponse)
 	err := c.cc.Invoke(ctx, "/apricot.Apricot/NewRunNumber", in, out, opts...)
 	if err != nil {
@@ -51,6 +66,15 @@ func (c *apricotClient) NewRunNumber(ctx context.Context, in *Empty, opts ...grp
 	return out, nil
 }
 
+func (c *apricotClient) ListRunNumbers(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*RunNumbersResponse, error) {
+	out := new(RunNumbersResponse)
+	err := c.cc.Invoke(ctx, "/apricot.Apricot/ListRunNumbers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *apricotClient) GetDefaults(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*StringMap, error) {
 	out := new(StringMap)
 	err := c.cc.Invoke(ctx, "/apricot.Apricot/GetDefaults", in, out, opts...)
@@ -105,6 +129,60 @@ func (c *apricotClient) GetEndpointsForCRUCard(ctx context.Context, in *CardRequ
 	return out, nil
 }
 
+func (c *apricotClient) AddHost(ctx context.Context, in *HostInventoryRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/apricot.Apricot/AddHost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apricotClient) RemoveHost(ctx context.Context, in *HostRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/apricot.Apricot/RemoveHost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apricotClient) AssignHostToDetector(ctx context.Context, in *HostInventoryRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/apricot.Apricot/AssignHostToDetector", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apricotClient) SetCRUCard(ctx context.Context, in *SetCRUCardRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/apricot.Apricot/SetCRUCard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apricotClient) RemoveCRUCard(ctx context.Context, in *RemoveCRUCardRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/apricot.Apricot/RemoveCRUCard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apricotClient) CheckInventory(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*InventoryCheckResponse, error) {
+	out := new(InventoryCheckResponse)
+	err := c.cc.Invoke(ctx, "/apricot.Apricot/CheckInventory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *apricotClient) GetRuntimeEntry(ctx context.Context, in *GetRuntimeEntryRequest, opts ...grpc.CallOption) (*ComponentResponse, error) {
 	out := new(ComponentResponse)
 	err := c.cc.Invoke(ctx, "/apricot.Apricot/GetRuntimeEntry", in, out, opts...)
@@ -168,6 +246,15 @@ func (c *apricotClient) GetComponentConfiguration(ctx context.Context, in *Compo
 	return out, nil
 }
 
+func (c *apricotClient) ResolveComponentConfiguration(ctx context.Context, in *ComponentRequest, opts ...grpc.CallOption) (*ResolvedComponentResponse, error) {
+	out := new(ResolvedComponentResponse)
+	err := c.cc.Invoke(ctx, "/apricot.Apricot/ResolveComponentConfiguration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *apricotClient) ImportComponentConfiguration(ctx context.Context, in *ImportComponentConfigurationRequest, opts ...grpc.CallOption) (*ImportComponentConfigurationResponse, error) {
 	out := new(ImportComponentConfigurationResponse)
 	err := c.cc.Invoke(ctx, "/apricot.Apricot/ImportComponentConfiguration", in, out, opts...)
@@ -177,17 +264,110 @@ func (c *apricotClient) ImportComponentConfiguration(ctx context.Context, in *Im
 	return out, nil
 }
 
+func (c *apricotClient) ValidateComponentConfiguration(ctx context.Context, in *ValidateComponentConfigurationRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/apricot.Apricot/ValidateComponentConfiguration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apricotClient) DiffComponentConfiguration(ctx context.Context, in *DiffComponentConfigurationRequest, opts ...grpc.CallOption) (*DiffComponentConfigurationResponse, error) {
+	out := new(DiffComponentConfigurationResponse)
+	err := c.cc.Invoke(ctx, "/apricot.Apricot/DiffComponentConfiguration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apricotClient) RollbackComponentConfiguration(ctx context.Context, in *ComponentQuery, opts ...grpc.CallOption) (*ImportComponentConfigurationResponse, error) {
+	out := new(ImportComponentConfigurationResponse)
+	err := c.cc.Invoke(ctx, "/apricot.Apricot/RollbackComponentConfiguration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apricotClient) StoreRunSnapshot(ctx context.Context, in *RunSnapshot, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/apricot.Apricot/StoreRunSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apricotClient) GetRunSnapshot(ctx context.Context, in *GetRunSnapshotRequest, opts ...grpc.CallOption) (*RunSnapshot, error) {
+	out := new(RunSnapshot)
+	err := c.cc.Invoke(ctx, "/apricot.Apricot/GetRunSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apricotClient) DiffRunSnapshots(ctx context.Context, in *DiffRunSnapshotsRequest, opts ...grpc.CallOption) (*DiffRunSnapshotsResponse, error) {
+	out := new(DiffRunSnapshotsResponse)
+	err := c.cc.Invoke(ctx, "/apricot.Apricot/DiffRunSnapshots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apricotClient) Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (Apricot_WatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Apricot_serviceDesc.Streams[0], "/apricot.Apricot/Watch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &apricotWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Apricot_WatchClient interface {
+	Recv() (*ConfigurationChange, error)
+	grpc.ClientStream
+}
+
+type apricotWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *apricotWatchClient) Recv() (*ConfigurationChange, error) {
+	m := new(ConfigurationChange)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // ApricotServer is the server API for Apricot service.
 // All implementations should embed UnimplementedApricotServer
 // for forward compatibility
 type ApricotServer interface {
-	NewRunNumber(context.Context, *Empty) (*RunNumberResponse, error)
+	NewRunNumber(context.Context, *NewRunNumberRequest) (*RunNumberResponse, error)
+	ListRunNumbers(context.Context, *Empty) (*RunNumbersResponse, error)
 	GetDefaults(context.Context, *Empty) (*StringMap, error)
 	GetVars(context.Context, *Empty) (*StringMap, error)
 	RawGetRecursive(context.Context, *RawGetRecursiveRequest) (*ComponentResponse, error)
 	GetDetectorForHost(context.Context, *HostRequest) (*DetectorResponse, error)
 	GetCRUCardsForHost(context.Context, *HostRequest) (*CRUCardsResponse, error)
 	GetEndpointsForCRUCard(context.Context, *CardRequest) (*CRUCardEndpointResponse, error)
+	AddHost(context.Context, *HostInventoryRequest) (*Empty, error)
+	RemoveHost(context.Context, *HostRequest) (*Empty, error)
+	AssignHostToDetector(context.Context, *HostInventoryRequest) (*Empty, error)
+	SetCRUCard(context.Context, *SetCRUCardRequest) (*Empty, error)
+	RemoveCRUCard(context.Context, *RemoveCRUCardRequest) (*Empty, error)
+	CheckInventory(context.Context, *Empty) (*InventoryCheckResponse, error)
 	GetRuntimeEntry(context.Context, *GetRuntimeEntryRequest) (*ComponentResponse, error)
 	SetRuntimeEntry(context.Context, *SetRuntimeEntryRequest) (*Empty, error)
 	GetHostInventory(context.Context, *HostGetRequest) (*HostEntriesResponse, error)
@@ -195,16 +375,27 @@ type ApricotServer interface {
 	ListComponentEntries(context.Context, *ListComponentEntriesRequest) (*ComponentEntriesResponse, error)
 	ListComponentEntryHistory(context.Context, *ComponentQuery) (*ComponentEntriesResponse, error)
 	GetComponentConfiguration(context.Context, *ComponentRequest) (*ComponentResponse, error)
+	ResolveComponentConfiguration(context.Context, *ComponentRequest) (*ResolvedComponentResponse, error)
 	ImportComponentConfiguration(context.Context, *ImportComponentConfigurationRequest) (*ImportComponentConfigurationResponse, error)
+	ValidateComponentConfiguration(context.Context, *ValidateComponentConfigurationRequest) (*Empty, error)
+	DiffComponentConfiguration(context.Context, *DiffComponentConfigurationRequest) (*DiffComponentConfigurationResponse, error)
+	RollbackComponentConfiguration(context.Context, *ComponentQuery) (*ImportComponentConfigurationResponse, error)
+	StoreRunSnapshot(context.Context, *RunSnapshot) (*Empty, error)
+	GetRunSnapshot(context.Context, *GetRunSnapshotRequest) (*RunSnapshot, error)
+	DiffRunSnapshots(context.Context, *DiffRunSnapshotsRequest) (*DiffRunSnapshotsResponse, error)
+	Watch(*WatchRequest, Apricot_WatchServer) error
 }
 
 // UnimplementedApricotServer should be embedded to have forward compatible implementations.
 type UnimplementedApricotServer struct {
 }
 
-func (UnimplementedApricotServer) NewRunNumber(context.Context, *Empty) (*RunNumberResponse, error) {
+func (UnimplementedApricotServer) NewRunNumber(context.Context, *NewRunNumberRequest) (*RunNumberResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NewRunNumber not implemented")
 }
+func (UnimplementedApricotServer) ListRunNumbers(context.Context, *Empty) (*RunNumbersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRunNumbers not implemented")
+}
 func (UnimplementedApricotServer) GetDefaults(context.Context, *Empty) (*StringMap, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDefaults not implemented")
 }
@@ -223,6 +414,24 @@ func (UnimplementedApricotServer) GetCRUCardsForHost(context.Context, *HostReque
 func (UnimplementedApricotServer) GetEndpointsForCRUCard(context.Context, *CardRequest) (*CRUCardEndpointResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetEndpointsForCRUCard not implemented")
 }
+func (UnimplementedApricotServer) AddHost(context.Context, *HostInventoryRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddHost not implemented")
+}
+func (UnimplementedApricotServer) RemoveHost(context.Context, *HostRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveHost not implemented")
+}
+func (UnimplementedApricotServer) AssignHostToDetector(context.Context, *HostInventoryRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignHostToDetector not implemented")
+}
+func (UnimplementedApricotServer) SetCRUCard(context.Context, *SetCRUCardRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCRUCard not implemented")
+}
+func (UnimplementedApricotServer) RemoveCRUCard(context.Context, *RemoveCRUCardRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCRUCard not implemented")
+}
+func (UnimplementedApricotServer) CheckInventory(context.Context, *Empty) (*InventoryCheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckInventory not implemented")
+}
 func (UnimplementedApricotServer) GetRuntimeEntry(context.Context, *GetRuntimeEntryRequest) (*ComponentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRuntimeEntry not implemented")
 }
@@ -244,9 +453,33 @@ func (UnimplementedApricotServer) ListComponentEntryHistory(context.Context, *Co
 func (UnimplementedApricotServer) GetComponentConfiguration(context.Context, *ComponentRequest) (*ComponentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetComponentConfiguration not implemented")
 }
+func (UnimplementedApricotServer) ResolveComponentConfiguration(context.Context, *ComponentRequest) (*ResolvedComponentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveComponentConfiguration not implemented")
+}
 func (UnimplementedApricotServer) ImportComponentConfiguration(context.Context, *ImportComponentConfigurationRequest) (*ImportComponentConfigurationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ImportComponentConfiguration not implemented")
 }
+func (UnimplementedApricotServer) ValidateComponentConfiguration(context.Context, *ValidateComponentConfigurationRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateComponentConfiguration not implemented")
+}
+func (UnimplementedApricotServer) DiffComponentConfiguration(context.Context, *DiffComponentConfigurationRequest) (*DiffComponentConfigurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffComponentConfiguration not implemented")
+}
+func (UnimplementedApricotServer) RollbackComponentConfiguration(context.Context, *ComponentQuery) (*ImportComponentConfigurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackComponentConfiguration not implemented")
+}
+func (UnimplementedApricotServer) StoreRunSnapshot(context.Context, *RunSnapshot) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StoreRunSnapshot not implemented")
+}
+func (UnimplementedApricotServer) GetRunSnapshot(context.Context, *GetRunSnapshotRequest) (*RunSnapshot, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRunSnapshot not implemented")
+}
+func (UnimplementedApricotServer) DiffRunSnapshots(context.Context, *DiffRunSnapshotsRequest) (*DiffRunSnapshotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffRunSnapshots not implemented")
+}
+func (UnimplementedApricotServer) Watch(*WatchRequest, Apricot_WatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
 
 // UnsafeApricotServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to ApricotServer will
@@ -260,7 +493,7 @@ func RegisterApricotServer(s grpc.ServiceRegistrar, srv ApricotServer) {
 }
 
 func _Apricot_NewRunNumber_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+	in := new(NewRunNumberRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -272,7 +505,25 @@ func _Apricot_NewRunNumber_Handler(srv interface{}, ctx context.Context, dec fun
 		FullMethod: "/apricot.Apricot/NewRunNumber",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApricotServer).NewRunNumber(ctx, req.(*Empty))
+		return srv.(ApricotServer).NewRunNumber(ctx, req.(*NewRunNumberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Apricot_ListRunNumbers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApricotServer).ListRunNumbers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apricot.Apricot/ListRunNumbers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApricotServer).ListRunNumbers(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -385,6 +636,114 @@ func _Apricot_GetEndpointsForCRUCard_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Apricot_AddHost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HostInventoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApricotServer).AddHost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apricot.Apricot/AddHost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApricotServer).AddHost(ctx, req.(*HostInventoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Apricot_RemoveHost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApricotServer).RemoveHost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apricot.Apricot/RemoveHost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApricotServer).RemoveHost(ctx, req.(*HostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Apricot_AssignHostToDetector_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HostInventoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApricotServer).AssignHostToDetector(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apricot.Apricot/AssignHostToDetector",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApricotServer).AssignHostToDetector(ctx, req.(*HostInventoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Apricot_SetCRUCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCRUCardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApricotServer).SetCRUCard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apricot.Apricot/SetCRUCard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApricotServer).SetCRUCard(ctx, req.(*SetCRUCardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Apricot_RemoveCRUCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCRUCardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApricotServer).RemoveCRUCard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apricot.Apricot/RemoveCRUCard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApricotServer).RemoveCRUCard(ctx, req.(*RemoveCRUCardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Apricot_CheckInventory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApricotServer).CheckInventory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apricot.Apricot/CheckInventory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApricotServer).CheckInventory(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Apricot_GetRuntimeEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetRuntimeEntryRequest)
 	if err := dec(in); err != nil {
@@ -511,6 +870,24 @@ func _Apricot_GetComponentConfiguration_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Apricot_ResolveComponentConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ComponentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApricotServer).ResolveComponentConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apricot.Apricot/ResolveComponentConfiguration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApricotServer).ResolveComponentConfiguration(ctx, req.(*ComponentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Apricot_ImportComponentConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ImportComponentConfigurationRequest)
 	if err := dec(in); err != nil {
@@ -529,6 +906,135 @@ func _Apricot_ImportComponentConfiguration_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Apricot_ValidateComponentConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateComponentConfigurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApricotServer).ValidateComponentConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apricot.Apricot/ValidateComponentConfiguration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApricotServer).ValidateComponentConfiguration(ctx, req.(*ValidateComponentConfigurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Apricot_DiffComponentConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffComponentConfigurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApricotServer).DiffComponentConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apricot.Apricot/DiffComponentConfiguration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApricotServer).DiffComponentConfiguration(ctx, req.(*DiffComponentConfigurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Apricot_RollbackComponentConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ComponentQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApricotServer).RollbackComponentConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apricot.Apricot/RollbackComponentConfiguration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApricotServer).RollbackComponentConfiguration(ctx, req.(*ComponentQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Apricot_StoreRunSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunSnapshot)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApricotServer).StoreRunSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apricot.Apricot/StoreRunSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApricotServer).StoreRunSnapshot(ctx, req.(*RunSnapshot))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Apricot_GetRunSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRunSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApricotServer).GetRunSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apricot.Apricot/GetRunSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApricotServer).GetRunSnapshot(ctx, req.(*GetRunSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Apricot_DiffRunSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffRunSnapshotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApricotServer).DiffRunSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apricot.Apricot/DiffRunSnapshots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApricotServer).DiffRunSnapshots(ctx, req.(*DiffRunSnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Apricot_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApricotServer).Watch(m, &apricotWatchServer{stream})
+}
+
+type Apricot_WatchServer interface {
+	Send(*ConfigurationChange) error
+	grpc.ServerStream
+}
+
+type apricotWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *apricotWatchServer) Send(m *ConfigurationChange) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _Apricot_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "apricot.Apricot",
 	HandlerType: (*ApricotServer)(nil),
@@ -537,6 +1043,10 @@ var _Apricot_serviceDesc = grpc.ServiceDesc{
 			MethodName: "NewRunNumber",
 			Handler:    _Apricot_NewRunNumber_Handler,
 		},
+		{
+			MethodName: "ListRunNumbers",
+			Handler:    _Apricot_ListRunNumbers_Handler,
+		},
 		{
 			MethodName: "GetDefaults",
 			Handler:    _Apricot_GetDefaults_Handler,
@@ -561,6 +1071,30 @@ var _Apricot_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetEndpointsForCRUCard",
 			Handler:    _Apricot_GetEndpointsForCRUCard_Handler,
 		},
+		{
+			MethodName: "AddHost",
+			Handler:    _Apricot_AddHost_Handler,
+		},
+		{
+			MethodName: "RemoveHost",
+			Handler:    _Apricot_RemoveHost_Handler,
+		},
+		{
+			MethodName: "AssignHostToDetector",
+			Handler:    _Apricot_AssignHostToDetector_Handler,
+		},
+		{
+			MethodName: "SetCRUCard",
+			Handler:    _Apricot_SetCRUCard_Handler,
+		},
+		{
+			MethodName: "RemoveCRUCard",
+			Handler:    _Apricot_RemoveCRUCard_Handler,
+		},
+		{
+			MethodName: "CheckInventory",
+			Handler:    _Apricot_CheckInventory_Handler,
+		},
 		{
 			MethodName: "GetRuntimeEntry",
 			Handler:    _Apricot_GetRuntimeEntry_Handler,
@@ -589,11 +1123,45 @@ var _Apricot_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetComponentConfiguration",
 			Handler:    _Apricot_GetComponentConfiguration_Handler,
 		},
+		{
+			MethodName: "ResolveComponentConfiguration",
+			Handler:    _Apricot_ResolveComponentConfiguration_Handler,
+		},
 		{
 			MethodName: "ImportComponentConfiguration",
 			Handler:    _Apricot_ImportComponentConfiguration_Handler,
 		},
+		{
+			MethodName: "ValidateComponentConfiguration",
+			Handler:    _Apricot_ValidateComponentConfiguration_Handler,
+		},
+		{
+			MethodName: "DiffComponentConfiguration",
+			Handler:    _Apricot_DiffComponentConfiguration_Handler,
+		},
+		{
+			MethodName: "RollbackComponentConfiguration",
+			Handler:    _Apricot_RollbackComponentConfiguration_Handler,
+		},
+		{
+			MethodName: "StoreRunSnapshot",
+			Handler:    _Apricot_StoreRunSnapshot_Handler,
+		},
+		{
+			MethodName: "GetRunSnapshot",
+			Handler:    _Apricot_GetRunSnapshot_Handler,
+		},
+		{
+			MethodName: "DiffRunSnapshots",
+			Handler:    _Apricot_DiffRunSnapshots_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Watch",
+			Handler:       _Apricot_Watch_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "protos/apricot.proto",
 }
@@ -0,0 +1,250 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2021 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+
+package remote
+
+import (
+	"container/list"
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/AliceO2Group/Control/configuration/componentcfg"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	cacheMetricsSubsystem = "o2control_apricot_client"
+	cacheWatchRetryInterval = 10*time.Second
+	hardwarePath = "o2/hardware"
+)
+
+var (
+	cacheHitCount = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Subsystem: cacheMetricsSubsystem,
+		Name:      "cache_hit_count",
+		Help:      "The number of configuration requests served from the client cache.",
+	}, []string{"method"})
+	cacheMissCount = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Subsystem: cacheMetricsSubsystem,
+		Name:      "cache_miss_count",
+		Help:      "The number of configuration requests which had to go to apricot.",
+	}, []string{"method"})
+	cacheEvictionCount = prometheus.NewCounter(prometheus.CounterOpts{
+		Subsystem: cacheMetricsSubsystem,
+		Name:      "cache_eviction_count",
+		Help:      "The number of cache entries dropped to stay within the size bound.",
+	})
+	cacheInvalidationCount = prometheus.NewCounter(prometheus.CounterOpts{
+		Subsystem: cacheMetricsSubsystem,
+		Name:      "cache_invalidation_count",
+		Help:      "The number of cache entries dropped because of a configuration change.",
+	})
+
+	registerCacheMetricsOnce sync.Once
+)
+
+type cacheEntry struct {
+	key     string
+	value   interface{}
+	expires time.Time // zero for entries which never expire
+
+	// A change to any backend key under dependsOn invalidates the entry,
+	// empty for entries which never change
+	dependsOn string
+}
+
+// responseCache is a size-bounded LRU cache of apricot responses. Entries
+// expire after ttl, except for those which can never change, i.e. component
+// configuration pinned with an explicit timestamp.
+type responseCache struct {
+	mu         sync.Mutex
+	ttl        time.Duration
+	maxEntries int
+	entries    map[string]*list.Element
+	lru        *list.List
+
+	// Bumped by every invalidation, so that a fetch which was already in
+	// flight when its data changed doesn't put the old value back
+	generation uint64
+
+	// While it returns true, every request goes to apricot, see setBypass
+	bypass func() bool
+}
+
+func newResponseCache(ttl time.Duration, maxEntries int) *responseCache {
+	registerCacheMetricsOnce.Do(func() {
+		prometheus.MustRegister(cacheHitCount, cacheMissCount, cacheEvictionCount, cacheInvalidationCount)
+	})
+	return &responseCache{
+		ttl:        ttl,
+		maxEntries: maxEntries,
+		entries:    make(map[string]*list.Element),
+		lru:        list.New(),
+	}
+}
+
+// setBypass replaces the function which tells whether the cache should be
+// skipped, so that the switch can follow settings which change at runtime.
+func (rc *responseCache) setBypass(bypass func() bool) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	rc.bypass = bypass
+}
+
+// get returns a cached value, or calls fetch and caches its result. The
+// cache is skipped entirely while the bypass is on.
+func (rc *responseCache) get(method string, key string, dependsOn string, fetch func() (interface{}, error)) (value interface{}, err error) {
+	if rc == nil {
+		return fetch()
+	}
+
+	rc.mu.Lock()
+	if rc.bypass != nil && rc.bypass() {
+		rc.mu.Unlock()
+		return fetch()
+	}
+	if elem, ok := rc.entries[key]; ok {
+		entry := elem.Value.(*cacheEntry)
+		if entry.expires.IsZero() || time.Now().Before(entry.expires) {
+			rc.lru.MoveToFront(elem)
+			rc.mu.Unlock()
+			cacheHitCount.WithLabelValues(method).Inc()
+			return entry.value, nil
+		}
+		rc.remove(elem)
+	}
+	generation := rc.generation
+	rc.mu.Unlock()
+
+	cacheMissCount.WithLabelValues(method).Inc()
+	value, err = fetch()
+	if err != nil {
+		return
+	}
+
+	entry := &cacheEntry{
+		key:       key,
+		value:     value,
+		dependsOn: dependsOn,
+	}
+	if len(dependsOn) > 0 {
+		entry.expires = time.Now().Add(rc.ttl)
+	}
+
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	if len(dependsOn) > 0 && rc.generation != generation {
+		// The configuration changed while we were fetching, value may
+		// predate the change
+		return
+	}
+	if elem, ok := rc.entries[key]; ok {
+		rc.remove(elem)
+	}
+	rc.entries[key] = rc.lru.PushFront(entry)
+	for rc.maxEntries > 0 && rc.lru.Len() > rc.maxEntries {
+		rc.remove(rc.lru.Back())
+		cacheEvictionCount.Inc()
+	}
+	return
+}
+
+// invalidate drops every entry which depends on any of keys, or on anything
+// under prefix if no keys are given.
+func (rc *responseCache) invalidate(prefix string, keys []string) {
+	if rc == nil {
+		return
+	}
+	if len(keys) == 0 {
+		keys = []string{prefix}
+	}
+
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	rc.generation++
+	for elem := rc.lru.Front(); elem != nil; {
+		next := elem.Next()
+		entry := elem.Value.(*cacheEntry)
+		if len(entry.dependsOn) > 0 {
+			for _, key := range keys {
+				key = strings.Trim(key, "/")
+				if strings.HasPrefix(key, entry.dependsOn) || strings.HasPrefix(entry.dependsOn, key) {
+					rc.remove(elem)
+					cacheInvalidationCount.Inc()
+					break
+				}
+			}
+		}
+		elem = next
+	}
+}
+
+func (rc *responseCache) remove(elem *list.Element) {
+	entry := rc.lru.Remove(elem).(*cacheEntry)
+	delete(rc.entries, entry.key)
+}
+
+// componentDependency is the backend path whose changes affect the result
+// of a query, empty if the query is pinned to a timestamp.
+func componentDependency(query *componentcfg.Query) string {
+	if len(query.Timestamp) > 0 {
+		return ""
+	}
+	return query.AbsoluteWithoutTimestamp()
+}
+
+// watchForInvalidation keeps the cache consistent with the parts of the
+// configuration tree it holds, for as long as ctx is not done. If apricot
+// cannot watch, entries simply expire after the TTL.
+func (c *RemoteService) watchForInvalidation(ctx context.Context, path string) {
+	for {
+		// Watch itself invalidates the cache for every change it forwards
+		changes, err := c.Watch(ctx, path)
+		if err != nil {
+			log.WithError(err).
+				WithField("path", path).
+				Debug("cannot watch configuration for cache invalidation")
+		} else {
+			for range changes {
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(cacheWatchRetryInterval):
+		}
+		// Changes may have been missed while the watch was down
+		c.cache.invalidate(path, nil)
+	}
+}
+
+func (c *RemoteService) startCacheInvalidation() {
+	ctx := context.Background()
+	go c.watchForInvalidation(ctx, strings.TrimSuffix(componentcfg.ConfigComponentsPath, "/"))
+	go c.watchForInvalidation(ctx, hardwarePath)
+}
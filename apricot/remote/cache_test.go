@@ -0,0 +1,189 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2021 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+
+package remote
+
+import (
+	"testing"
+	"time"
+)
+
+// fetcher counts how often the cache had to go to apricot for a key.
+type fetcher struct {
+	calls map[string]int
+}
+
+func (f *fetcher) fetch(key string) func() (interface{}, error) {
+	return func() (interface{}, error) {
+		f.calls[key]++
+		return key, nil
+	}
+}
+
+func newFetcher() *fetcher {
+	return &fetcher{calls: make(map[string]int)}
+}
+
+func TestResponseCacheHit(t *testing.T) {
+	rc := newResponseCache(time.Minute, 10)
+	f := newFetcher()
+
+	for i := 0; i < 3; i++ {
+		value, err := rc.get("test", "a", "o2/components/a", f.fetch("a"))
+		if err != nil || value != "a" {
+			t.Fatalf("unexpected value %v, %v", value, err)
+		}
+	}
+	if f.calls["a"] != 1 {
+		t.Errorf("expected 1 fetch, got %d", f.calls["a"])
+	}
+}
+
+func TestResponseCacheLRUEviction(t *testing.T) {
+	rc := newResponseCache(time.Minute, 2)
+	f := newFetcher()
+
+	_, _ = rc.get("test", "a", "o2/components/a", f.fetch("a"))
+	_, _ = rc.get("test", "b", "o2/components/b", f.fetch("b"))
+	_, _ = rc.get("test", "a", "o2/components/a", f.fetch("a")) // a is now the most recently used
+	_, _ = rc.get("test", "c", "o2/components/c", f.fetch("c")) // evicts b
+
+	_, _ = rc.get("test", "a", "o2/components/a", f.fetch("a"))
+	_, _ = rc.get("test", "b", "o2/components/b", f.fetch("b"))
+	if f.calls["a"] != 1 {
+		t.Errorf("expected a to stay cached, fetched %d times", f.calls["a"])
+	}
+	if f.calls["b"] != 2 {
+		t.Errorf("expected b to be evicted, fetched %d times", f.calls["b"])
+	}
+	if rc.lru.Len() != 2 || len(rc.entries) != 2 {
+		t.Errorf("expected 2 entries, got %d in the list and %d in the map", rc.lru.Len(), len(rc.entries))
+	}
+}
+
+func TestResponseCacheTTL(t *testing.T) {
+	rc := newResponseCache(20*time.Millisecond, 10)
+	f := newFetcher()
+
+	_, _ = rc.get("test", "a", "o2/components/a", f.fetch("a"))
+	_, _ = rc.get("test", "pinned", "", f.fetch("pinned"))
+	time.Sleep(50*time.Millisecond)
+	_, _ = rc.get("test", "a", "o2/components/a", f.fetch("a"))
+	_, _ = rc.get("test", "pinned", "", f.fetch("pinned"))
+
+	if f.calls["a"] != 2 {
+		t.Errorf("expected a to expire, fetched %d times", f.calls["a"])
+	}
+	if f.calls["pinned"] != 1 {
+		t.Errorf("expected pinned entry never to expire, fetched %d times", f.calls["pinned"])
+	}
+}
+
+func TestResponseCacheInvalidation(t *testing.T) {
+	rc := newResponseCache(time.Minute, 10)
+	f := newFetcher()
+
+	fill := func() {
+		_, _ = rc.get("test", "readout", "o2/components/readout/PHYSICS", f.fetch("readout"))
+		_, _ = rc.get("test", "qc", "o2/components/qc/PHYSICS", f.fetch("qc"))
+		_, _ = rc.get("test", "pinned", "", f.fetch("pinned"))
+	}
+	fill()
+
+	// a change to a single key only drops the entries which depend on it
+	rc.invalidate("o2/components", []string{"/o2/components/readout/PHYSICS/any/config/"})
+	fill()
+	if f.calls["readout"] != 2 || f.calls["qc"] != 1 {
+		t.Errorf("expected only readout to be invalidated, fetched readout %d and qc %d times", f.calls["readout"], f.calls["qc"])
+	}
+
+	// no keys means everything under prefix
+	rc.invalidate("o2/components", nil)
+	fill()
+	if f.calls["readout"] != 3 || f.calls["qc"] != 2 {
+		t.Errorf("expected everything to be invalidated, fetched readout %d and qc %d times", f.calls["readout"], f.calls["qc"])
+	}
+	if f.calls["pinned"] != 1 {
+		t.Errorf("expected pinned entry never to be invalidated, fetched %d times", f.calls["pinned"])
+	}
+}
+
+func TestResponseCacheDropsStaleFill(t *testing.T) {
+	rc := newResponseCache(time.Minute, 10)
+	calls := 0
+
+	// the configuration changes while the first fetch is in flight
+	value, err := rc.get("test", "a", "o2/components/a", func() (interface{}, error) {
+		calls++
+		rc.invalidate("o2/components/a", nil)
+		return "old", nil
+	})
+	if err != nil || value != "old" {
+		t.Fatalf("unexpected value %v, %v", value, err)
+	}
+
+	value, _ = rc.get("test", "a", "o2/components/a", func() (interface{}, error) {
+		calls++
+		return "new", nil
+	})
+	if value != "new" || calls != 2 {
+		t.Errorf("expected the stale value not to be cached, got %v after %d fetches", value, calls)
+	}
+
+	value, _ = rc.get("test", "a", "o2/components/a", func() (interface{}, error) {
+		calls++
+		return "newer", nil
+	})
+	if value != "new" || calls != 2 {
+		t.Errorf("expected the fresh value to be cached, got %v after %d fetches", value, calls)
+	}
+}
+
+func TestResponseCacheBypass(t *testing.T) {
+	rc := newResponseCache(time.Minute, 10)
+	f := newFetcher()
+	bypass := true
+	rc.setBypass(func() bool { return bypass })
+
+	for i := 0; i < 2; i++ {
+		if _, err := rc.get("test", "a", "o2/components/a", f.fetch("a")); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if f.calls["a"] != 2 {
+		t.Errorf("expected every request to be fetched while bypassed, got %d fetches", f.calls["a"])
+	}
+
+	// switching the bypass off takes effect right away
+	bypass = false
+	for i := 0; i < 2; i++ {
+		if _, err := rc.get("test", "a", "o2/components/a", f.fetch("a")); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if f.calls["a"] != 3 {
+		t.Errorf("expected the cache to be used again, got %d fetches", f.calls["a"])
+	}
+}
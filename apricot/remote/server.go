@@ -27,7 +27,9 @@ package remote
 
 import (
 	"context"
+	"errors"
 	"runtime"
+	"time"
 
 	apricotpb "github.com/AliceO2Group/Control/apricot/protos"
 	"github.com/AliceO2Group/Control/common/logger"
@@ -63,15 +65,37 @@ func NewServer(service configuration.Service) *grpc.Server {
 	return s
 }
 
-func (m *RpcServer) NewRunNumber(_ context.Context, _ *apricotpb.Empty) (*apricotpb.RunNumberResponse, error) {
+func (m *RpcServer) NewRunNumber(_ context.Context, request *apricotpb.NewRunNumberRequest) (*apricotpb.RunNumberResponse, error) {
 	if m == nil || m.service == nil {
 		return nil, E_CONFIGURATION_BACKEND_UNAVAILABLE
 	}
 	m.logMethod()
-	rn, err := m.service.NewRunNumber()
+	rn, err := m.service.NewRunNumber(request.GetEnvironmentId())
 	return &apricotpb.RunNumberResponse{RunNumber: rn}, err
 }
 
+func (m *RpcServer) ListRunNumbers(_ context.Context, _ *apricotpb.Empty) (*apricotpb.RunNumbersResponse, error) {
+	if m == nil || m.service == nil {
+		return nil, E_CONFIGURATION_BACKEND_UNAVAILABLE
+	}
+	m.logMethod()
+	records, err := m.service.ListRunNumbers()
+	if err != nil {
+		return nil, err
+	}
+	response := &apricotpb.RunNumbersResponse{
+		Records: make([]*apricotpb.RunNumberRecord, len(records)),
+	}
+	for i, record := range records {
+		response.Records[i] = &apricotpb.RunNumberRecord{
+			RunNumber:     record.RunNumber,
+			EnvironmentId: record.EnvironmentId,
+			Timestamp:     record.Timestamp,
+		}
+	}
+	return response, E_OK.Err()
+}
+
 func (m *RpcServer) GetDefaults(_ context.Context, _ *apricotpb.Empty) (*apricotpb.StringMap, error) {
 	if m == nil || m.service == nil {
 		return nil, E_CONFIGURATION_BACKEND_UNAVAILABLE
@@ -100,27 +124,12 @@ func (m *RpcServer) GetComponentConfiguration(_ context.Context, request *aprico
 		return nil, E_BAD_INPUT
 	}
 
-	var query *componentcfg.Query
-	if rawPath := request.GetPath(); len(rawPath) > 0 {
-		var err error
-		query, err = componentcfg.NewQuery(rawPath)
-		if err != nil {
-			return nil, E_BAD_INPUT
-		}
-	} else if reqQuery := request.GetQuery(); reqQuery != nil {
-		query = &componentcfg.Query{
-			Component: reqQuery.Component,
-			RunType:   reqQuery.RunType,
-			RoleName:  reqQuery.MachineRole,
-			EntryKey:  reqQuery.Entry,
-			Timestamp: reqQuery.Timestamp,
-		}
-	} else {
-		return nil, E_BAD_INPUT
+	query, err := componentRequestQuery(request)
+	if err != nil {
+		return nil, err
 	}
 
 	var payload string
-	var err error
 	if request.ProcessTemplate {
 		payload, err = m.service.GetAndProcessComponentConfiguration(query, request.GetVarStack())
 	} else {
@@ -133,6 +142,49 @@ func (m *RpcServer) GetComponentConfiguration(_ context.Context, request *aprico
 	return &apricotpb.ComponentResponse{Payload: payload}, E_OK.Err()
 }
 
+func (m *RpcServer) ResolveComponentConfiguration(_ context.Context, request *apricotpb.ComponentRequest) (*apricotpb.ResolvedComponentResponse, error) {
+	if m == nil || m.service == nil {
+		return nil, E_CONFIGURATION_BACKEND_UNAVAILABLE
+	}
+	m.logMethod()
+
+	if request == nil {
+		return nil, E_BAD_INPUT
+	}
+
+	query, err := componentRequestQuery(request)
+	if err != nil {
+		return nil, err
+	}
+
+	payload, entries, err := m.service.ResolveComponentConfiguration(query, request.GetVarStack(), request.ProcessTemplate)
+	if err != nil {
+		return nil, err
+	}
+	response := &apricotpb.ResolvedComponentResponse{
+		Payload: payload,
+		Entries: make([]*apricotpb.ComponentQuery, len(entries)),
+	}
+	for i, entry := range entries {
+		response.Entries[i] = componentQueryToPb(entry)
+	}
+	return response, E_OK.Err()
+}
+
+func componentRequestQuery(request *apricotpb.ComponentRequest) (query *componentcfg.Query, err error) {
+	if rawPath := request.GetPath(); len(rawPath) > 0 {
+		query, err = componentcfg.NewQuery(rawPath)
+		if err != nil {
+			return nil, E_BAD_INPUT
+		}
+	} else if reqQuery := request.GetQuery(); reqQuery != nil {
+		query = componentQueryFromPb(reqQuery)
+	} else {
+		return nil, E_BAD_INPUT
+	}
+	return
+}
+
 func (m *RpcServer) GetDetectorForHost(_ context.Context, request *apricotpb.HostRequest) (*apricotpb.DetectorResponse, error) {
 	if m == nil || m.service == nil {
 		return nil, E_CONFIGURATION_BACKEND_UNAVAILABLE
@@ -181,6 +233,128 @@ func (m *RpcServer) GetEndpointsForCRUCard(_ context.Context, request *apricotpb
 	return &apricotpb.CRUCardEndpointResponse{Endpoints: endpoints}, E_OK.Err()
 }
 
+func (m *RpcServer) AddHost(_ context.Context, request *apricotpb.HostInventoryRequest) (*apricotpb.Empty, error) {
+	if m == nil || m.service == nil {
+		return nil, E_CONFIGURATION_BACKEND_UNAVAILABLE
+	}
+	m.logMethod()
+	if request == nil {
+		return nil, E_BAD_INPUT
+	}
+
+	err := m.service.AddHost(request.GetHostname(), request.GetDetector())
+	if err != nil {
+		return nil, inventoryStatus(err)
+	}
+	return &apricotpb.Empty{}, E_OK.Err()
+}
+
+func (m *RpcServer) RemoveHost(_ context.Context, request *apricotpb.HostRequest) (*apricotpb.Empty, error) {
+	if m == nil || m.service == nil {
+		return nil, E_CONFIGURATION_BACKEND_UNAVAILABLE
+	}
+	m.logMethod()
+	if request == nil {
+		return nil, E_BAD_INPUT
+	}
+
+	err := m.service.RemoveHost(request.GetHostname())
+	if err != nil {
+		return nil, inventoryStatus(err)
+	}
+	return &apricotpb.Empty{}, E_OK.Err()
+}
+
+func (m *RpcServer) AssignHostToDetector(_ context.Context, request *apricotpb.HostInventoryRequest) (*apricotpb.Empty, error) {
+	if m == nil || m.service == nil {
+		return nil, E_CONFIGURATION_BACKEND_UNAVAILABLE
+	}
+	m.logMethod()
+	if request == nil {
+		return nil, E_BAD_INPUT
+	}
+
+	err := m.service.AssignHostToDetector(request.GetHostname(), request.GetDetector())
+	if err != nil {
+		return nil, inventoryStatus(err)
+	}
+	return &apricotpb.Empty{}, E_OK.Err()
+}
+
+func (m *RpcServer) SetCRUCard(_ context.Context, request *apricotpb.SetCRUCardRequest) (*apricotpb.Empty, error) {
+	if m == nil || m.service == nil {
+		return nil, E_CONFIGURATION_BACKEND_UNAVAILABLE
+	}
+	m.logMethod()
+	if request == nil || request.GetCard() == nil {
+		return nil, E_BAD_INPUT
+	}
+
+	card := request.GetCard()
+	err := m.service.SetCRUCard(request.GetHostname(), &configuration.CRUCard{
+		Type:             card.GetType(),
+		PciAddress:       card.GetPciAddress(),
+		Serial:           card.GetSerial(),
+		Endpoint:         card.GetEndpoint(),
+		Numa:             card.GetNuma(),
+		Firmware:         card.GetFirmware(),
+		UserLogicVersion: card.GetUserLogicVersion(),
+	})
+	if err != nil {
+		return nil, inventoryStatus(err)
+	}
+	return &apricotpb.Empty{}, E_OK.Err()
+}
+
+func (m *RpcServer) RemoveCRUCard(_ context.Context, request *apricotpb.RemoveCRUCardRequest) (*apricotpb.Empty, error) {
+	if m == nil || m.service == nil {
+		return nil, E_CONFIGURATION_BACKEND_UNAVAILABLE
+	}
+	m.logMethod()
+	if request == nil {
+		return nil, E_BAD_INPUT
+	}
+
+	err := m.service.RemoveCRUCard(request.GetHostname(), request.GetCardSerial(), request.GetEndpoint())
+	if err != nil {
+		return nil, inventoryStatus(err)
+	}
+	return &apricotpb.Empty{}, E_OK.Err()
+}
+
+func (m *RpcServer) CheckInventory(_ context.Context, _ *apricotpb.Empty) (*apricotpb.InventoryCheckResponse, error) {
+	if m == nil || m.service == nil {
+		return nil, E_CONFIGURATION_BACKEND_UNAVAILABLE
+	}
+	m.logMethod()
+
+	issues, err := m.service.CheckInventory()
+	if err != nil {
+		return nil, err
+	}
+	response := &apricotpb.InventoryCheckResponse{
+		Issues: make([]*apricotpb.InventoryIssue, len(issues)),
+	}
+	for i, issue := range issues {
+		response.Issues[i] = &apricotpb.InventoryIssue{
+			Kind:     issue.Kind,
+			Hostname: issue.Hostname,
+			Detail:   issue.Detail,
+		}
+	}
+	return response, E_OK.Err()
+}
+
+// inventoryStatus lets clients tell a rejected inventory change from a
+// backend error.
+func inventoryStatus(err error) error {
+	var inventoryErr *configuration.InventoryError
+	if errors.As(err, &inventoryErr) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return err
+}
+
 func (m *RpcServer) GetRuntimeEntry(_ context.Context, request *apricotpb.GetRuntimeEntryRequest) (*apricotpb.ComponentResponse, error) {
 	if m == nil || m.service == nil {
 		return nil, E_CONFIGURATION_BACKEND_UNAVAILABLE
@@ -341,7 +515,7 @@ func (m *RpcServer) ImportComponentConfiguration(_ context.Context, request *apr
 
 	existingComponentUpdated, existingEntryUpdated, newTimestamp, err := m.service.ImportComponentConfiguration(pushQuery, request.Payload, request.NewComponent, request.UseVersioning)
 	if err != nil {
-		return nil, err
+		return nil, validationStatus(err)
 	}
 	response := &apricotpb.ImportComponentConfigurationResponse{
 		ExistingComponentUpdated: existingComponentUpdated,
@@ -351,6 +525,175 @@ func (m *RpcServer) ImportComponentConfiguration(_ context.Context, request *apr
 	return response, nil
 }
 
+func (m *RpcServer) ValidateComponentConfiguration(_ context.Context, request *apricotpb.ValidateComponentConfigurationRequest) (*apricotpb.Empty, error) {
+	if m == nil || m.service == nil {
+		return nil, E_CONFIGURATION_BACKEND_UNAVAILABLE
+	}
+	m.logMethod()
+
+	if request == nil || request.Query == nil {
+		return nil, E_BAD_INPUT
+	}
+
+	err := m.service.ValidateComponentConfiguration(componentQueryFromPb(request.Query), request.Payload)
+	if err != nil {
+		return nil, validationStatus(err)
+	}
+	return &apricotpb.Empty{}, nil
+}
+
+func (m *RpcServer) DiffComponentConfiguration(_ context.Context, request *apricotpb.DiffComponentConfigurationRequest) (*apricotpb.DiffComponentConfigurationResponse, error) {
+	if m == nil || m.service == nil {
+		return nil, E_CONFIGURATION_BACKEND_UNAVAILABLE
+	}
+	m.logMethod()
+
+	if request == nil || request.From == nil || request.To == nil {
+		return nil, E_BAD_INPUT
+	}
+
+	format, differences, err := m.service.DiffComponentConfiguration(componentQueryFromPb(request.From), componentQueryFromPb(request.To))
+	if err != nil {
+		return nil, err
+	}
+	return &apricotpb.DiffComponentConfigurationResponse{
+		Format:      format,
+		Differences: differencesToPb(differences),
+	}, nil
+}
+
+func differencesToPb(differences []componentcfg.Difference) []*apricotpb.ConfigurationDifference {
+	pbDifferences := make([]*apricotpb.ConfigurationDifference, len(differences))
+	for i, difference := range differences {
+		pbDifferences[i] = &apricotpb.ConfigurationDifference{
+			Path:     difference.Path,
+			Kind:     difference.Kind,
+			OldValue: difference.OldValue,
+			NewValue: difference.NewValue,
+		}
+	}
+	return pbDifferences
+}
+
+func (m *RpcServer) RollbackComponentConfiguration(_ context.Context, query *apricotpb.ComponentQuery) (*apricotpb.ImportComponentConfigurationResponse, error) {
+	if m == nil || m.service == nil {
+		return nil, E_CONFIGURATION_BACKEND_UNAVAILABLE
+	}
+	m.logMethod()
+
+	if query == nil {
+		return nil, E_BAD_INPUT
+	}
+
+	newTimestamp, err := m.service.RollbackComponentConfiguration(componentQueryFromPb(query))
+	if err != nil {
+		return nil, err
+	}
+	response := &apricotpb.ImportComponentConfigurationResponse{
+		ExistingComponentUpdated: true,
+		ExistingEntryUpdated:     true,
+		NewTimestamp:             newTimestamp,
+	}
+	return response, nil
+}
+
+// validationStatus lets clients tell a rejected payload from a backend error.
+func validationStatus(err error) error {
+	var validationErr *componentcfg.ValidationError
+	if errors.As(err, &validationErr) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return err
+}
+
+func (m *RpcServer) StoreRunSnapshot(_ context.Context, snapshot *apricotpb.RunSnapshot) (*apricotpb.Empty, error) {
+	if m == nil || m.service == nil {
+		return nil, E_CONFIGURATION_BACKEND_UNAVAILABLE
+	}
+	m.logMethod()
+
+	if snapshot == nil {
+		return nil, E_BAD_INPUT
+	}
+
+	err := m.service.StoreRunSnapshot(runSnapshotFromPb(snapshot))
+	if err != nil {
+		return nil, err
+	}
+	return &apricotpb.Empty{}, E_OK.Err()
+}
+
+func (m *RpcServer) GetRunSnapshot(_ context.Context, request *apricotpb.GetRunSnapshotRequest) (*apricotpb.RunSnapshot, error) {
+	if m == nil || m.service == nil {
+		return nil, E_CONFIGURATION_BACKEND_UNAVAILABLE
+	}
+	m.logMethod()
+
+	if request == nil {
+		return nil, E_BAD_INPUT
+	}
+
+	snapshot, err := m.service.GetRunSnapshot(request.RunNumber, request.WithPayloads)
+	if err != nil {
+		return nil, err
+	}
+	return runSnapshotToPb(snapshot), E_OK.Err()
+}
+
+func (m *RpcServer) DiffRunSnapshots(_ context.Context, request *apricotpb.DiffRunSnapshotsRequest) (*apricotpb.DiffRunSnapshotsResponse, error) {
+	if m == nil || m.service == nil {
+		return nil, E_CONFIGURATION_BACKEND_UNAVAILABLE
+	}
+	m.logMethod()
+
+	if request == nil {
+		return nil, E_BAD_INPUT
+	}
+
+	differences, err := m.service.DiffRunSnapshots(request.FromRunNumber, request.ToRunNumber)
+	if err != nil {
+		return nil, err
+	}
+	return &apricotpb.DiffRunSnapshotsResponse{Differences: differencesToPb(differences)}, E_OK.Err()
+}
+
+func componentQueryFromPb(query *apricotpb.ComponentQuery) *componentcfg.Query {
+	return &componentcfg.Query{
+		Component: query.Component,
+		RunType:   query.RunType,
+		RoleName:  query.MachineRole,
+		EntryKey:  query.Entry,
+		Timestamp: query.Timestamp,
+	}
+}
+
+func (m *RpcServer) Watch(request *apricotpb.WatchRequest, stream apricotpb.Apricot_WatchServer) error {
+	if m == nil || m.service == nil {
+		return E_CONFIGURATION_BACKEND_UNAVAILABLE
+	}
+	m.logMethod()
+
+	if request == nil {
+		return E_BAD_INPUT
+	}
+
+	changes, err := m.service.Watch(stream.Context(), request.RawPath)
+	if err != nil {
+		return err
+	}
+	for change := range changes {
+		err = stream.Send(&apricotpb.ConfigurationChange{
+			Prefix:    change.Prefix,
+			Keys:      change.Keys,
+			Timestamp: change.Timestamp.UnixNano() / int64(time.Millisecond),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (m *RpcServer) logMethod() {
 	if !viper.GetBool("verbose") {
 		return
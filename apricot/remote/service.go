@@ -28,19 +28,23 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net/url"
 	"time"
 
 	apricotpb "github.com/AliceO2Group/Control/apricot/protos"
 	"github.com/AliceO2Group/Control/configuration"
+	"github.com/AliceO2Group/Control/configuration/cfgbackend"
 	"github.com/AliceO2Group/Control/configuration/componentcfg"
+	"github.com/spf13/viper"
 	"google.golang.org/grpc"
 )
 
 const CALL_TIMEOUT = 10*time.Second
 
 type RemoteService struct {
-	cli rpcClient
+	cli   rpcClient
+	cache *responseCache // nil unless configCache is set
 }
 
 func NewService(configUri string) (svc configuration.Service, err error) {
@@ -60,20 +64,58 @@ func NewService(configUri string) (svc configuration.Service, err error) {
 	if rpcClient == nil {
 		return nil, fmt.Errorf("cannot dial apricot service at %s", endpoint)
 	}
-	return &RemoteService{
+	remoteSvc := &RemoteService{
 		cli: *rpcClient,
-	}, nil
+	}
+	if viper.GetBool("configCache") {
+		remoteSvc.cache = newResponseCache(viper.GetDuration("configCacheTTL"), viper.GetInt("configCacheMaxEntries"))
+		// Read once, viper is not safe for concurrent use. The core
+		// follows its reloadable settings instead, see SetCacheBypass.
+		bypass := viper.GetBool("configCacheBypass")
+		remoteSvc.cache.setBypass(func() bool { return bypass })
+		remoteSvc.startCacheInvalidation()
+		log.WithField("ttl", viper.GetDuration("configCacheTTL")).
+			WithField("maxEntries", viper.GetInt("configCacheMaxEntries")).
+			Debug("apricot client cache enabled")
+	}
+	return remoteSvc, nil
+}
+
+// SetCacheBypass makes the client cache, if any, ask bypass before every
+// request whether it should be skipped.
+func (c *RemoteService) SetCacheBypass(bypass func() bool) {
+	if c.cache != nil {
+		c.cache.setBypass(bypass)
+	}
 }
 
-func (c *RemoteService) NewRunNumber() (runNumber uint32, err error) {
+func (c *RemoteService) NewRunNumber(environmentId string) (runNumber uint32, err error) {
 	var response *apricotpb.RunNumberResponse
-	response, err = c.cli.NewRunNumber(context.Background(), &apricotpb.Empty{}, grpc.EmptyCallOption{})
+	request := &apricotpb.NewRunNumberRequest{EnvironmentId: environmentId}
+	response, err = c.cli.NewRunNumber(context.Background(), request, grpc.EmptyCallOption{})
 	if err != nil {
 		return 0, err
 	}
 	return response.GetRunNumber(), nil
 }
 
+func (c *RemoteService) ListRunNumbers() (records []configuration.RunNumberRecord, err error) {
+	var response *apricotpb.RunNumbersResponse
+	response, err = c.cli.ListRunNumbers(context.Background(), &apricotpb.Empty{}, grpc.EmptyCallOption{})
+	if err != nil {
+		return nil, err
+	}
+	records = make([]configuration.RunNumberRecord, len(response.GetRecords()))
+	for i, record := range response.GetRecords() {
+		records[i] = configuration.RunNumberRecord{
+			RunNumber:     record.GetRunNumber(),
+			EnvironmentId: record.GetEnvironmentId(),
+			Timestamp:     record.GetTimestamp(),
+		}
+	}
+	return
+}
+
 func (c *RemoteService) GetDefaults() map[string]string {
 	response, err := c.cli.GetDefaults(context.Background(), &apricotpb.Empty{}, grpc.EmptyCallOption{})
 	if err != nil {
@@ -91,7 +133,14 @@ func (c *RemoteService) GetVars() map[string]string {
 }
 
 func (c *RemoteService) GetComponentConfiguration(query *componentcfg.Query) (payload string, err error) {
-	return c.getComponentConfigurationInternal(query, false, nil)
+	var value interface{}
+	value, err = c.cache.get("GetComponentConfiguration", "component:" + query.Path(), componentDependency(query), func() (interface{}, error) {
+		return c.getComponentConfigurationInternal(query, false, nil)
+	})
+	if err != nil {
+		return "", err
+	}
+	return value.(string), nil
 }
 
 func (c *RemoteService) GetAndProcessComponentConfiguration(query *componentcfg.Query, varStack map[string]string) (payload string, err error) {
@@ -100,25 +149,58 @@ func (c *RemoteService) GetAndProcessComponentConfiguration(query *componentcfg.
 
 func (c *RemoteService) getComponentConfigurationInternal(query *componentcfg.Query, processTemplate bool, varStack map[string]string) (payload string, err error) {
 	var response *apricotpb.ComponentResponse
-	componentQuery := &apricotpb.ComponentQuery{
-		Component:   query.Component,
-		RunType:     query.RunType,
-		MachineRole: query.RoleName,
-		Entry:       query.EntryKey,
-		Timestamp:   query.Timestamp,
-	}
-	request := &apricotpb.ComponentRequest{
-		QueryPath: &apricotpb.ComponentRequest_Query{Query: componentQuery},
-		ProcessTemplate: processTemplate,
-		VarStack:        varStack,
-	}
-	response, err = c.cli.GetComponentConfiguration(context.Background(), request, grpc.EmptyCallOption{})
+	response, err = c.cli.GetComponentConfiguration(context.Background(), componentRequest(query, processTemplate, varStack), grpc.EmptyCallOption{})
 	if err != nil {
 		return "", err
 	}
 	return response.GetPayload(), nil
 }
 
+type resolvedComponentConfiguration struct {
+	payload string
+	entries []*componentcfg.Query
+}
+
+// ResolveComponentConfiguration goes through the cache only for plain
+// entries, rendered templates depend on the varStack.
+func (c *RemoteService) ResolveComponentConfiguration(query *componentcfg.Query, varStack map[string]string, processTemplate bool) (payload string, entries []*componentcfg.Query, err error) {
+	if processTemplate {
+		return c.resolveComponentConfigurationInternal(query, varStack, processTemplate)
+	}
+
+	var value interface{}
+	value, err = c.cache.get("ResolveComponentConfiguration", "resolved:" + query.Path(), componentDependency(query), func() (interface{}, error) {
+		resolvedPayload, resolvedEntries, resolveErr := c.resolveComponentConfigurationInternal(query, nil, false)
+		return resolvedComponentConfiguration{payload: resolvedPayload, entries: resolvedEntries}, resolveErr
+	})
+	if err != nil {
+		return "", nil, err
+	}
+	resolved := value.(resolvedComponentConfiguration)
+	return resolved.payload, resolved.entries, nil
+}
+
+func (c *RemoteService) resolveComponentConfigurationInternal(query *componentcfg.Query, varStack map[string]string, processTemplate bool) (payload string, entries []*componentcfg.Query, err error) {
+	var response *apricotpb.ResolvedComponentResponse
+	response, err = c.cli.ResolveComponentConfiguration(context.Background(), componentRequest(query, processTemplate, varStack), grpc.EmptyCallOption{})
+	if err != nil {
+		return "", nil, err
+	}
+	entries = make([]*componentcfg.Query, len(response.GetEntries()))
+	for i, entry := range response.GetEntries() {
+		entries[i] = componentQueryFromPb(entry)
+	}
+	return response.GetPayload(), entries, nil
+}
+
+func componentRequest(query *componentcfg.Query, processTemplate bool, varStack map[string]string) *apricotpb.ComponentRequest {
+	return &apricotpb.ComponentRequest{
+		QueryPath:       &apricotpb.ComponentRequest_Query{Query: componentQueryToPb(query)},
+		ProcessTemplate: processTemplate,
+		VarStack:        varStack,
+	}
+}
+
 func (c *RemoteService) RawGetRecursive(path string) (payload string, err error) {
 	var response *apricotpb.ComponentResponse
 	request := &apricotpb.RawGetRecursiveRequest{RawPath: path}
@@ -130,6 +212,17 @@ func (c *RemoteService) RawGetRecursive(path string) (payload string, err error)
 }
 
 func (c *RemoteService) GetDetectorForHost(hostname string) (payload string, err error) {
+	var value interface{}
+	value, err = c.cache.get("GetDetectorForHost", "detector:" + hostname, hardwarePath + "/detectors", func() (interface{}, error) {
+		return c.getDetectorForHostInternal(hostname)
+	})
+	if err != nil {
+		return "", err
+	}
+	return value.(string), nil
+}
+
+func (c *RemoteService) getDetectorForHostInternal(hostname string) (payload string, err error) {
 	var response *apricotpb.DetectorResponse
 	request := &apricotpb.HostRequest{
 		Hostname: hostname,
@@ -143,6 +236,17 @@ func (c *RemoteService) GetDetectorForHost(hostname string) (payload string, err
 }
 
 func (c *RemoteService) GetCRUCardsForHost(hostname string) (cards string, err error) {
+	var value interface{}
+	value, err = c.cache.get("GetCRUCardsForHost", "cards:" + hostname, hardwarePath + "/flps/" + hostname + "/cards", func() (interface{}, error) {
+		return c.getCRUCardsForHostInternal(hostname)
+	})
+	if err != nil {
+		return "", err
+	}
+	return value.(string), nil
+}
+
+func (c *RemoteService) getCRUCardsForHostInternal(hostname string) (cards string, err error) {
 	var response *apricotpb.CRUCardsResponse
 	request := &apricotpb.HostRequest{
 		Hostname: hostname,
@@ -167,6 +271,99 @@ func (c *RemoteService) GetEndpointsForCRUCard(hostname, cardSerial string) (car
 	return response.GetEndpoints(), nil
 }
 
+func (c *RemoteService) AddHost(hostname string, detector string) (err error) {
+	request := &apricotpb.HostInventoryRequest{
+		Hostname: hostname,
+		Detector: detector,
+	}
+	_, err = c.cli.AddHost(context.Background(), request, grpc.EmptyCallOption{})
+	if err != nil {
+		return
+	}
+	c.cache.invalidate(hardwarePath, nil)
+	return
+}
+
+func (c *RemoteService) RemoveHost(hostname string) (err error) {
+	request := &apricotpb.HostRequest{
+		Hostname: hostname,
+	}
+	_, err = c.cli.RemoveHost(context.Background(), request, grpc.EmptyCallOption{})
+	if err != nil {
+		return
+	}
+	c.cache.invalidate(hardwarePath, nil)
+	return
+}
+
+func (c *RemoteService) AssignHostToDetector(hostname string, detector string) (err error) {
+	request := &apricotpb.HostInventoryRequest{
+		Hostname: hostname,
+		Detector: detector,
+	}
+	_, err = c.cli.AssignHostToDetector(context.Background(), request, grpc.EmptyCallOption{})
+	if err != nil {
+		return
+	}
+	c.cache.invalidate(hardwarePath + "/detectors", nil)
+	return
+}
+
+func (c *RemoteService) SetCRUCard(hostname string, card *configuration.CRUCard) (err error) {
+	if card == nil {
+		return errors.New("cannot set nil CRU card")
+	}
+	request := &apricotpb.SetCRUCardRequest{
+		Hostname: hostname,
+		Card: &apricotpb.CRUCard{
+			Type:             card.Type,
+			PciAddress:       card.PciAddress,
+			Serial:           card.Serial,
+			Endpoint:         card.Endpoint,
+			Numa:             card.Numa,
+			Firmware:         card.Firmware,
+			UserLogicVersion: card.UserLogicVersion,
+		},
+	}
+	_, err = c.cli.SetCRUCard(context.Background(), request, grpc.EmptyCallOption{})
+	if err != nil {
+		return
+	}
+	c.cache.invalidate(hardwarePath + "/flps/" + hostname + "/cards", nil)
+	return
+}
+
+func (c *RemoteService) RemoveCRUCard(hostname string, cardSerial string, endpoint string) (err error) {
+	request := &apricotpb.RemoveCRUCardRequest{
+		Hostname:   hostname,
+		CardSerial: cardSerial,
+		Endpoint:   endpoint,
+	}
+	_, err = c.cli.RemoveCRUCard(context.Background(), request, grpc.EmptyCallOption{})
+	if err != nil {
+		return
+	}
+	c.cache.invalidate(hardwarePath + "/flps/" + hostname + "/cards", nil)
+	return
+}
+
+func (c *RemoteService) CheckInventory() (issues []configuration.InventoryIssue, err error) {
+	var response *apricotpb.InventoryCheckResponse
+	response, err = c.cli.CheckInventory(context.Background(), &apricotpb.Empty{}, grpc.EmptyCallOption{})
+	if err != nil {
+		return nil, err
+	}
+	issues = make([]configuration.InventoryIssue, len(response.GetIssues()))
+	for i, issue := range response.GetIssues() {
+		issues[i] = configuration.InventoryIssue{
+			Kind:     issue.GetKind(),
+			Hostname: issue.GetHostname(),
+			Detail:   issue.GetDetail(),
+		}
+	}
+	return
+}
+
 func (c *RemoteService) GetRuntimeEntry(component string, key string) (payload string, err error) {
 	var response *apricotpb.ComponentResponse
 	request := &apricotpb.GetRuntimeEntryRequest{
@@ -271,9 +468,182 @@ func (c *RemoteService) ImportComponentConfiguration(query *componentcfg.Query,
 	if err != nil {
 		return
 	}
+	c.cache.invalidate(query.AbsoluteWithoutTimestamp(), nil)
 
 	existingComponentUpdated = response.ExistingComponentUpdated
 	existingEntryUpdated = response.ExistingEntryUpdated
 	newTimestamp = response.NewTimestamp
 	return
 }
+
+func (c *RemoteService) ValidateComponentConfiguration(query *componentcfg.Query, payload string) (err error) {
+	request := &apricotpb.ValidateComponentConfigurationRequest{
+		Query:   componentQueryToPb(query),
+		Payload: payload,
+	}
+	_, err = c.cli.ValidateComponentConfiguration(context.Background(), request, grpc.EmptyCallOption{})
+	return
+}
+
+func (c *RemoteService) DiffComponentConfiguration(from *componentcfg.Query, to *componentcfg.Query) (format string, differences []componentcfg.Difference, err error) {
+	var response *apricotpb.DiffComponentConfigurationResponse
+	request := &apricotpb.DiffComponentConfigurationRequest{
+		From: componentQueryToPb(from),
+		To:   componentQueryToPb(to),
+	}
+
+	response, err = c.cli.DiffComponentConfiguration(context.Background(), request, grpc.EmptyCallOption{})
+	if err != nil {
+		return
+	}
+
+	format = response.GetFormat()
+	differences = differencesFromPb(response.GetDifferences())
+	return
+}
+
+func differencesFromPb(pbDifferences []*apricotpb.ConfigurationDifference) []componentcfg.Difference {
+	differences := make([]componentcfg.Difference, len(pbDifferences))
+	for i, difference := range pbDifferences {
+		differences[i] = componentcfg.Difference{
+			Path:     difference.GetPath(),
+			Kind:     difference.GetKind(),
+			OldValue: difference.GetOldValue(),
+			NewValue: difference.GetNewValue(),
+		}
+	}
+	return differences
+}
+
+func (c *RemoteService) RollbackComponentConfiguration(query *componentcfg.Query) (newTimestamp int64, err error) {
+	var response *apricotpb.ImportComponentConfigurationResponse
+	response, err = c.cli.RollbackComponentConfiguration(context.Background(), componentQueryToPb(query), grpc.EmptyCallOption{})
+	if err != nil {
+		return
+	}
+	c.cache.invalidate(query.AbsoluteWithoutTimestamp(), nil)
+	newTimestamp = response.GetNewTimestamp()
+	return
+}
+
+func (c *RemoteService) StoreRunSnapshot(snapshot *componentcfg.RunSnapshot) (err error) {
+	_, err = c.cli.StoreRunSnapshot(context.Background(), runSnapshotToPb(snapshot), grpc.EmptyCallOption{})
+	return
+}
+
+func (c *RemoteService) GetRunSnapshot(runNumber uint32, withPayloads bool) (snapshot *componentcfg.RunSnapshot, err error) {
+	var response *apricotpb.RunSnapshot
+	request := &apricotpb.GetRunSnapshotRequest{
+		RunNumber:    runNumber,
+		WithPayloads: withPayloads,
+	}
+	response, err = c.cli.GetRunSnapshot(context.Background(), request, grpc.EmptyCallOption{})
+	if err != nil {
+		return
+	}
+	snapshot = runSnapshotFromPb(response)
+	return
+}
+
+func (c *RemoteService) DiffRunSnapshots(fromRunNumber uint32, toRunNumber uint32) (differences []componentcfg.Difference, err error) {
+	var response *apricotpb.DiffRunSnapshotsResponse
+	request := &apricotpb.DiffRunSnapshotsRequest{
+		FromRunNumber: fromRunNumber,
+		ToRunNumber:   toRunNumber,
+	}
+	response, err = c.cli.DiffRunSnapshots(context.Background(), request, grpc.EmptyCallOption{})
+	if err != nil {
+		return
+	}
+	differences = differencesFromPb(response.GetDifferences())
+	return
+}
+
+func runSnapshotToPb(snapshot *componentcfg.RunSnapshot) *apricotpb.RunSnapshot {
+	if snapshot == nil {
+		return nil
+	}
+	pbSnapshot := &apricotpb.RunSnapshot{
+		RunNumber:     snapshot.RunNumber,
+		EnvironmentId: snapshot.EnvironmentId,
+		Timestamp:     snapshot.Timestamp,
+		Entries:       make([]*apricotpb.ComponentQuery, len(snapshot.Entries)),
+		Payloads:      make(map[string]*apricotpb.StringMap, len(snapshot.Payloads)),
+	}
+	for i, entry := range snapshot.Entries {
+		pbSnapshot.Entries[i] = componentQueryToPb(entry)
+	}
+	for rolePath, props := range snapshot.Payloads {
+		pbSnapshot.Payloads[rolePath] = &apricotpb.StringMap{StringMap: props}
+	}
+	return pbSnapshot
+}
+
+func runSnapshotFromPb(pbSnapshot *apricotpb.RunSnapshot) *componentcfg.RunSnapshot {
+	snapshot := &componentcfg.RunSnapshot{
+		RunNumber:     pbSnapshot.GetRunNumber(),
+		EnvironmentId: pbSnapshot.GetEnvironmentId(),
+		Timestamp:     pbSnapshot.GetTimestamp(),
+		Entries:       make([]*componentcfg.Query, len(pbSnapshot.GetEntries())),
+	}
+	for i, entry := range pbSnapshot.GetEntries() {
+		snapshot.Entries[i] = componentQueryFromPb(entry)
+	}
+	if len(pbSnapshot.GetPayloads()) > 0 {
+		snapshot.Payloads = make(map[string]map[string]string, len(pbSnapshot.GetPayloads()))
+		for rolePath, props := range pbSnapshot.GetPayloads() {
+			snapshot.Payloads[rolePath] = props.GetStringMap()
+		}
+	}
+	return snapshot
+}
+
+func componentQueryToPb(query *componentcfg.Query) *apricotpb.ComponentQuery {
+	if query == nil {
+		return nil
+	}
+	return &apricotpb.ComponentQuery{
+		Component:   query.Component,
+		RunType:     query.RunType,
+		MachineRole: query.RoleName,
+		Entry:       query.EntryKey,
+		Timestamp:   query.Timestamp,
+	}
+}
+
+func (c *RemoteService) Watch(ctx context.Context, path string) (<-chan cfgbackend.Change, error) {
+	request := &apricotpb.WatchRequest{RawPath: path}
+	stream, err := c.cli.Watch(ctx, request, grpc.EmptyCallOption{})
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan cfgbackend.Change)
+	go func() {
+		defer close(ch)
+		for {
+			response, err := stream.Recv()
+			if err != nil {
+				if err != io.EOF && ctx.Err() == nil {
+					log.WithError(err).
+						WithField("path", path).
+						Warn("configuration watch stream interrupted")
+				}
+				return
+			}
+			change := cfgbackend.Change{
+				Prefix:    response.GetPrefix(),
+				Keys:      response.GetKeys(),
+				Timestamp: time.Unix(0, response.GetTimestamp() * int64(time.Millisecond)),
+			}
+			// Whoever reacts to this change must not get stale values
+			c.cache.invalidate(change.Prefix, change.Keys)
+			select {
+			case ch <- change:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch, nil
+}
@@ -0,0 +1,53 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2021 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+
+package cmd
+
+import (
+	"github.com/AliceO2Group/Control/coconut/configuration"
+	"github.com/spf13/cobra"
+)
+
+var configurationDiffCmd = &cobra.Command{
+	Use:   "diff <query>@<timestamp> <query>@<timestamp>",
+	Aliases: []string{"d"},
+	Example: `coconut conf diff <component>/<run type>/<machine role>/<entry>@<timestamp> <component>/<run type>/<machine role>/<entry>@<timestamp>
+coconut conf diff <component>/<run type>/<machine role>/<entry>@<timestamp> @<timestamp>
+coconut conf diff <component>/<run type>/<machine role>/<entry>@<timestamp> <component>/<run type>/<machine role>/<entry>
+coconut conf diff <component>/<run type>/<machine role>/<entry>@<timestamp> @<timestamp> -o json`,
+	Short: "Compare two versions of a configuration entry",
+	Long: `The configuration diff command shows what changed between two versions
+of a component configuration entry. The second argument can be a full query,
+or just @<timestamp> to refer to another version of the same entry. Omitting
+the timestamp refers to the latest version.
+JSON, YAML and INI payloads are compared key by key, anything else line by line.`,
+	Run: configuration.WrapCall(configuration.Diff),
+	Args: cobra.ExactArgs(2),
+}
+
+func init() {
+	configurationCmd.AddCommand(configurationDiffCmd)
+	configurationDiffCmd.Flags().StringP("output", "o", "text", "output format for the differences (text/yaml/json)")
+}
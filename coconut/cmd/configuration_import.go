@@ -39,12 +39,16 @@ coconut conf import <component>/<run type>/<machine role>/<entry> <file_path> --
 coconut conf import <component> <entry> <file_path>.json
 coconut conf import <component> <entry> <file_path> --no-versioning 
 coconut conf import <component> <entry> <file_path> --no-versioning --new-component
+coconut conf import <component> <entry> <file_path> --validate-only
 `,
 	Short: "Import a configuration file for the specified component and entry",
 	Long: `The configuration import command generates a timestamp and saves
 the configuration file to Consul under the <component>/<entry>/<timestamp> path. 
 Supported configuration file types are JSON, YAML, TOML and INI, 
-and their file extensions are recognized automatically.`,
+and their file extensions are recognized automatically.
+If a schema is registered for the component and entry, the payload is
+validated against it before import. With --validate-only, only the
+validation is performed and nothing is written.`,
 	Run: configuration.WrapCall(configuration.Import),
 	Args:  cobra.RangeArgs(2, 3),
 }
@@ -54,6 +58,7 @@ func init() {
 	configurationImportCmd.Flags().BoolP("new-component", "n",  false, "create a new configuration component while importing entry")
 	configurationImportCmd.Flags().StringP("format", "f",  "", "force a specific configuration file type, overriding any file extension")
 	configurationImportCmd.Flags().Bool("no-versioning",  false, "create an unversioned configuration entry (no timestamps are stored)")
+	configurationImportCmd.Flags().Bool("validate-only",  false, "validate the payload against the registered schema without importing it")
 	configurationImportCmd.Flags().StringP("runtype", "r",  "", "request configuration for this run type (e.g. PHYSICS, TECHNICAL, etc.)")
 	configurationImportCmd.Flags().StringP("role", "l",  "", "request configuration for this O² machine role")
 }
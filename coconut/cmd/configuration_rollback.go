@@ -0,0 +1,52 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2021 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+
+package cmd
+
+import (
+	"github.com/AliceO2Group/Control/coconut/configuration"
+	"github.com/spf13/cobra"
+)
+
+var configurationRollbackCmd = &cobra.Command{
+	Use:   "rollback <query>@<timestamp>",
+	Aliases: []string{"rb"},
+	Example: `coconut conf rollback <component>/<run type>/<machine role>/<entry>@<timestamp>
+coconut conf rollback <component> <entry> -t <timestamp>
+coconut conf rollback <component> <entry> -r <run type> -l <machine role> -t <timestamp>`,
+	Short: "Restore an older version of a configuration entry",
+	Long: `The configuration rollback command re-imports an older version of a
+component configuration entry as its newest version. Existing versions are
+never modified or removed, so the rollback itself shows up in the history.`,
+	Run: configuration.WrapCall(configuration.Rollback),
+	Args: cobra.RangeArgs(1, 2),
+}
+
+func init() {
+	configurationCmd.AddCommand(configurationRollbackCmd)
+	configurationRollbackCmd.Flags().StringP("timestamp", "t",  "", "version of the configuration entry to restore")
+	configurationRollbackCmd.Flags().StringP("runtype", "r",  "", "request configuration for this run type (e.g. PHYSICS, TECHNICAL, etc.)")
+	configurationRollbackCmd.Flags().StringP("role", "l",  "", "request configuration for this O² machine role")
+}
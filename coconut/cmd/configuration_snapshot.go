@@ -0,0 +1,53 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2021 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+
+package cmd
+
+import (
+	"github.com/AliceO2Group/Control/coconut/configuration"
+	"github.com/spf13/cobra"
+)
+
+var configurationSnapshotCmd = &cobra.Command{
+	Use:   "snapshot <run number> [<run number>]",
+	Aliases: []string{"snap"},
+	Example: `coconut conf snapshot <run number>
+coconut conf snapshot <run number> -p -o yaml
+coconut conf snapshot <run number> <run number>`,
+	Short: "Show or compare the configuration used by runs",
+	Long: `The configuration snapshot command shows which version of each component
+configuration entry was used by a run. With --payloads, it also shows the fully
+rendered properties which were pushed to each task on CONFIGURE, by role path.
+With two run numbers, it shows what changed in the configuration between the
+first run and the second one.`,
+	Run: configuration.WrapCall(configuration.Snapshot),
+	Args: cobra.RangeArgs(1, 2),
+}
+
+func init() {
+	configurationCmd.AddCommand(configurationSnapshotCmd)
+	configurationSnapshotCmd.Flags().StringP("output", "o", "text", "output format (text/yaml/json)")
+	configurationSnapshotCmd.Flags().BoolP("payloads", "p", false, "include the rendered task payloads")
+}
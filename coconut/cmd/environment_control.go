@@ -41,7 +41,7 @@ The reached state is returned.
 An event name must be passed via the mandatory event flag.
 Valid events:
   CONFIGURE            RESET                EXIT
-  START_ACTIVITY       STOP_ACTIVITY
+  START_ACTIVITY       STOP_ACTIVITY        RECOVER
 
 Not all events are available in all states.`,
 	Run:   control.WrapCall(control.ControlEnvironment),
@@ -0,0 +1,47 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2021 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package cmd
+
+import (
+	"github.com/AliceO2Group/Control/coconut/configuration"
+	"github.com/spf13/cobra"
+)
+
+var inventoryCheckCmd = &cobra.Command{
+	Use:   "check",
+	Example: `coconut inventory check
+coconut inventory check -o json`,
+	Short: "Check the inventory for inconsistencies",
+	Long: `The inventory check command reports hosts assigned to more than one detector
+or to an unknown detector, detector assignments of hosts which are not in the
+inventory, and CRU cards without endpoints.`,
+	Run: configuration.WrapCall(configuration.CheckInventory),
+	Args: cobra.NoArgs,
+}
+
+func init() {
+	inventoryCmd.AddCommand(inventoryCheckCmd)
+	inventoryCheckCmd.Flags().StringP("output", "o", "text", "output format (text/yaml/json)")
+}
@@ -0,0 +1,57 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2021 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/AliceO2Group/Control/coconut/control"
+	"github.com/AliceO2Group/Control/common/product"
+	"github.com/spf13/cobra"
+)
+
+// taskLogsCmd represents the task logs command
+var taskLogsCmd = &cobra.Command{
+	Use:   "logs [task id]",
+	Aliases: []string{"log", "l"},
+	Short: "show the output of a task",
+	Long: fmt.Sprintf(`The task logs command requests from %s the output of a task,
+as captured by its executor in the Mesos sandbox.
+
+By default the last 100 lines of the task's stdout are shown. Use --stderr for
+the standard error stream, and --follow to keep printing new output as the task
+writes it, until interrupted.`, product.PRETTY_SHORTNAME),
+	Run:   control.WrapStreamingCall(control.GetTaskLogs),
+	Args:  cobra.ExactArgs(1),
+}
+
+func init() {
+	taskCmd.AddCommand(taskLogsCmd)
+
+	taskLogsCmd.Flags().BoolP("follow", "f", false, "keep printing new output as it is written")
+	taskLogsCmd.Flags().Int32P("lines", "n", 100, "number of lines to show from the end of the log, 0 for all available up to 10000 lines or 1MB")
+	taskLogsCmd.Flags().Bool("stderr", false, "show the standard error stream instead of stdout")
+}
@@ -272,6 +272,20 @@ func History(svc configuration.Service, cmd *cobra.Command, args []string, o io.
 	return nil, 0
 }
 
+func RunNumbers(svc configuration.Service, cmd *cobra.Command, args []string, o io.Writer)(err error, code int) {
+	var records []configuration.RunNumberRecord
+	records, err = svc.ListRunNumbers()
+	if err != nil {
+		return err, EC_CONNECTION_ERROR
+	}
+
+	err = formatRunNumbers(cmd, records, o)
+	if err != nil {
+		return err, EC_LOGIC_ERROR
+	}
+	return nil, 0
+}
+
 func Import(svc configuration.Service, cmd *cobra.Command, args []string, o io.Writer)(err error, code int) {
 	useNewComponent, err := cmd.Flags().GetBool("new-component")
 	if err != nil {
@@ -285,6 +299,10 @@ func Import(svc configuration.Service, cmd *cobra.Command, args []string, o io.W
 	if err != nil {
 		return err, EC_INVALID_ARGS
 	}
+	validateOnly, err := cmd.Flags().GetBool("validate-only")
+	if err != nil {
+		return err, EC_INVALID_ARGS
+	}
 
 	var pushQuery *componentcfg.Query
 	// The last argument is always assumed to be the input file, so we must exclude it
@@ -314,6 +332,18 @@ func Import(svc configuration.Service, cmd *cobra.Command, args []string, o io.W
 		return err, EC_LOGIC_ERROR
 	}
 
+	if validateOnly {
+		err = svc.ValidateComponentConfiguration(pushQuery, string(payload))
+		if err != nil {
+			return err, EC_LOGIC_ERROR
+		}
+		_, _ = fmt.Fprintln(o, "Configuration payload is valid for " + red(pushQuery.Component) + componentcfg.SEPARATOR +
+			blue(pushQuery.RunType) + componentcfg.SEPARATOR +
+			red(pushQuery.RoleName) + componentcfg.SEPARATOR +
+			blue(pushQuery.EntryKey))
+		return nil, EC_ZERO
+	}
+
 	var existingComponentUpdated, existingEntryUpdated bool
 	var newTimestamp int64
 	existingComponentUpdated, existingEntryUpdated, newTimestamp, err = svc.ImportComponentConfiguration(pushQuery, string(payload), useNewComponent, !useNoVersion)
@@ -345,3 +375,119 @@ func Import(svc configuration.Service, cmd *cobra.Command, args []string, o io.W
 	return nil, 0
 }
 
+
+// coconut conf diff
+func Diff(svc configuration.Service, cmd *cobra.Command, args []string, o io.Writer)(err error, code int) {
+	if len(args) != 2 {
+		return errors.New(fmt.Sprintf("accepts 2 args, received %d", len(args))), EC_INVALID_ARGS
+	}
+
+	var from, to *componentcfg.Query
+	from, err = componentcfg.NewQuery(args[0])
+	if err != nil {
+		return err, EC_INVALID_ARGS
+	}
+	if strings.HasPrefix(args[1], "@") {
+		// coconut conf diff c/R/r/e@t1 @t2
+		toCopy := *from
+		toCopy.Timestamp = strings.TrimPrefix(args[1], "@")
+		to = &toCopy
+	} else {
+		to, err = componentcfg.NewQuery(args[1])
+		if err != nil {
+			return err, EC_INVALID_ARGS
+		}
+	}
+
+	var (
+		format string
+		differences []componentcfg.Difference
+	)
+	format, differences, err = svc.DiffComponentConfiguration(from, to)
+	if err != nil {
+		return err, EC_CONNECTION_ERROR
+	}
+
+	var output []byte
+	output, err = formatDifferences(cmd, format, differences)
+	if err != nil {
+		return err, EC_LOGIC_ERROR
+	}
+	_, _ = fmt.Fprintln(o, string(output))
+
+	return nil, EC_ZERO
+}
+
+// coconut conf rollback
+func Rollback(svc configuration.Service, cmd *cobra.Command, args []string, o io.Writer)(err error, code int) {
+	var query *componentcfg.Query
+	query, err = queryFromFlags(cmd, args, true)
+	if err != nil {
+		return err, EC_INVALID_ARGS
+	}
+	if len(query.Timestamp) == 0 {
+		return errors.New("please provide the timestamp of the version to restore"), EC_INVALID_ARGS
+	}
+
+	var newTimestamp int64
+	newTimestamp, err = svc.RollbackComponentConfiguration(query)
+	if err != nil {
+		return err, EC_LOGIC_ERROR
+	}
+
+	toPrintKey := red(query.Component) + componentcfg.SEPARATOR +
+		blue(query.RunType) + componentcfg.SEPARATOR +
+		red(query.RoleName) + componentcfg.SEPARATOR +
+		blue(query.EntryKey)
+
+	_, _ = fmt.Fprintln(o, "Configuration rolled back: " + toPrintKey + "@" + strconv.FormatInt(newTimestamp, 10) +
+		" (restored from @" + query.Timestamp + ")")
+	return nil, EC_ZERO
+}
+
+// coconut conf snapshot
+func Snapshot(svc configuration.Service, cmd *cobra.Command, args []string, o io.Writer)(err error, code int) {
+	if len(args) < 1 || len(args) > 2 {
+		return errors.New(fmt.Sprintf("accepts 1 or 2 args, received %d", len(args))), EC_INVALID_ARGS
+	}
+
+	runNumbers := make([]uint32, len(args))
+	for i, arg := range args {
+		var rn uint64
+		rn, err = strconv.ParseUint(arg, 10, 32)
+		if err != nil {
+			return errors.New(fmt.Sprintf("bad run number %s", arg)), EC_INVALID_ARGS
+		}
+		runNumbers[i] = uint32(rn)
+	}
+
+	var output []byte
+	if len(runNumbers) == 2 {
+		// coconut conf snapshot <run> <other run>
+		var differences []componentcfg.Difference
+		differences, err = svc.DiffRunSnapshots(runNumbers[0], runNumbers[1])
+		if err != nil {
+			return err, EC_CONNECTION_ERROR
+		}
+		output, err = formatDifferences(cmd, "", differences)
+	} else {
+		var withPayloads bool
+		withPayloads, err = cmd.Flags().GetBool("payloads")
+		if err != nil {
+			return err, EC_INVALID_ARGS
+		}
+
+		var snapshot *componentcfg.RunSnapshot
+		snapshot, err = svc.GetRunSnapshot(runNumbers[0], withPayloads)
+		if err != nil {
+			return err, EC_CONNECTION_ERROR
+		}
+		output, err = formatSnapshot(cmd, snapshot)
+	}
+	if err != nil {
+		return err, EC_LOGIC_ERROR
+	}
+	_, _ = fmt.Fprintln(o, string(output))
+
+	return nil, EC_ZERO
+}
@@ -34,9 +34,12 @@ import (
 	"io/ioutil"
 	"os"
 	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/AliceO2Group/Control/configuration"
 	apricotpb "github.com/AliceO2Group/Control/apricot/protos"
 	"github.com/AliceO2Group/Control/configuration/componentcfg"
 	"github.com/fatih/color"
@@ -48,6 +51,8 @@ import (
 var(
 	blue = color.New(color.FgHiBlue).SprintFunc()
 	red = color.New(color.FgHiRed).SprintFunc()
+	green = color.New(color.FgHiGreen).SprintFunc()
+	yellow = color.New(color.FgHiYellow).SprintFunc()
 	//                                                 component        /RUNTYPE          /rolename             /entry
 	inputComponentEntryRegex = regexp.MustCompile(`^([a-zA-Z0-9-_]+)(\/[A-Z0-9-_]+){1}(\/[a-z-A-Z0-9-_]+){1}(\/[a-z-A-Z0-9-_]+){1}$`)
 )
@@ -213,3 +218,171 @@ func isFileExtensionValid(extension string) bool{
 	extension = strings.ToUpper(extension)
 	return extension == "JSON" || extension == "YAML" || extension == "YML" || extension == "INI" || extension == "TOML"
 }
+
+func formatDifferences(cmd *cobra.Command, format string, differences []componentcfg.Difference) (parsedOutput []byte, err error) {
+	outputFormat, err := cmd.Flags().GetString("output")
+	if err != nil {
+		return
+	}
+
+	switch strings.ToLower(outputFormat) {
+	case "json":
+		parsedOutput, err = json.MarshalIndent(differences, "", "    ")
+		return
+	case "yaml":
+		parsedOutput, err = yaml.Marshal(differences)
+		parsedOutput = bytes.TrimSuffix(parsedOutput, []byte("\n"))
+		return
+	}
+
+	lines := make([]string, 0, len(differences) + 2)
+	if len(format) > 0 {
+		lines = append(lines, "format: " + format)
+	}
+	if len(differences) == 0 {
+		lines = append(lines, "no differences")
+	}
+	for _, difference := range differences {
+		switch difference.Kind {
+		case componentcfg.DIFF_ADDED:
+			lines = append(lines, green("+ " + difference.Path + ": " + difference.NewValue))
+		case componentcfg.DIFF_REMOVED:
+			lines = append(lines, red("- " + difference.Path + ": " + difference.OldValue))
+		default:
+			lines = append(lines, yellow("~ " + difference.Path + ": " + difference.OldValue + " → " + difference.NewValue))
+		}
+	}
+	return []byte(strings.Join(lines, "\n")), nil
+}
+
+// snapshotOutput is how a RunSnapshot is presented in JSON and YAML
+type snapshotOutput struct {
+	RunNumber     uint32                       `json:"runNumber" yaml:"runNumber"`
+	EnvironmentId string                       `json:"environmentId" yaml:"environmentId"`
+	Timestamp     int64                        `json:"timestamp" yaml:"timestamp"`
+	Entries       []string                     `json:"entries" yaml:"entries"`
+	Payloads      map[string]map[string]string `json:"payloads,omitempty" yaml:"payloads,omitempty"`
+}
+
+func formatSnapshot(cmd *cobra.Command, snapshot *componentcfg.RunSnapshot) (parsedOutput []byte, err error) {
+	outputFormat, err := cmd.Flags().GetString("output")
+	if err != nil {
+		return
+	}
+
+	output := snapshotOutput{
+		RunNumber:     snapshot.RunNumber,
+		EnvironmentId: snapshot.EnvironmentId,
+		Timestamp:     snapshot.Timestamp,
+		Entries:       snapshot.EntryPaths(),
+		Payloads:      snapshot.Payloads,
+	}
+
+	switch strings.ToLower(outputFormat) {
+	case "json":
+		parsedOutput, err = json.MarshalIndent(output, "", "    ")
+		return
+	case "yaml":
+		parsedOutput, err = yaml.Marshal(output)
+		parsedOutput = bytes.TrimSuffix(parsedOutput, []byte("\n"))
+		return
+	}
+
+	lines := []string{
+		"run number: " + strconv.FormatUint(uint64(output.RunNumber), 10),
+		"environment: " + output.EnvironmentId,
+		"taken: " + time.Unix(0, output.Timestamp * int64(time.Millisecond)).Format(time.RFC3339),
+		"entries:",
+	}
+	for _, entry := range output.Entries {
+		lines = append(lines, "    " + entry)
+	}
+	if len(output.Payloads) > 0 {
+		lines = append(lines, "payloads:")
+		rolePaths := make([]string, 0, len(output.Payloads))
+		for rolePath := range output.Payloads {
+			rolePaths = append(rolePaths, rolePath)
+		}
+		sort.Strings(rolePaths)
+		for _, rolePath := range rolePaths {
+			lines = append(lines, "    " + blue(rolePath))
+			keys := make([]string, 0, len(output.Payloads[rolePath]))
+			for k := range output.Payloads[rolePath] {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			for _, k := range keys {
+				lines = append(lines, "        " + k + ": " + output.Payloads[rolePath][k])
+			}
+		}
+	}
+	return []byte(strings.Join(lines, "\n")), nil
+}
+
+func formatRunNumbers(cmd *cobra.Command, records []configuration.RunNumberRecord, o io.Writer) (err error) {
+	outputFormat, err := cmd.Flags().GetString("output")
+	if err != nil {
+		return
+	}
+
+	var parsedOutput []byte
+	switch strings.ToLower(outputFormat) {
+	case "json":
+		parsedOutput, err = json.MarshalIndent(records, "", "    ")
+	case "yaml":
+		parsedOutput, err = yaml.Marshal(records)
+		parsedOutput = bytes.TrimSuffix(parsedOutput, []byte("\n"))
+	default:
+		table := tablewriter.NewWriter(o)
+		table.SetHeader([]string{"run number", "environment", "issued"})
+		table.SetBorder(false)
+		for _, record := range records {
+			table.Append([]string{
+				strconv.FormatUint(uint64(record.RunNumber), 10),
+				record.EnvironmentId,
+				time.Unix(0, record.Timestamp * int64(time.Millisecond)).Format(time.RFC822),
+			})
+		}
+		table.Render()
+		return
+	}
+	if err != nil {
+		return
+	}
+	_, _ = fmt.Fprintln(o, string(parsedOutput))
+	return
+}
+
+func formatInventoryIssues(cmd *cobra.Command, issues []configuration.InventoryIssue, o io.Writer) (err error) {
+	outputFormat, err := cmd.Flags().GetString("output")
+	if err != nil {
+		return
+	}
+
+	var parsedOutput []byte
+	switch strings.ToLower(outputFormat) {
+	case "json":
+		parsedOutput, err = json.MarshalIndent(issues, "", "    ")
+	case "yaml":
+		parsedOutput, err = yaml.Marshal(issues)
+		parsedOutput = bytes.TrimSuffix(parsedOutput, []byte("\n"))
+	default:
+		if len(issues) == 0 {
+			_, _ = fmt.Fprintln(o, green("inventory is consistent"))
+			return
+		}
+		table := tablewriter.NewWriter(o)
+		table.SetHeader([]string{"host", "issue", "detail"})
+		table.SetBorder(false)
+		for _, issue := range issues {
+			table.Append([]string{red(issue.Hostname), issue.Kind, issue.Detail})
+		}
+		table.Render()
+		return
+	}
+	if err != nil {
+		return
+	}
+	_, _ = fmt.Fprintln(o, string(parsedOutput))
+	return
+}
@@ -0,0 +1,169 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2021 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+
+package configuration
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/AliceO2Group/Control/configuration"
+	"github.com/spf13/cobra"
+)
+
+func ListHosts(svc configuration.Service, cmd *cobra.Command, args []string, o io.Writer)(err error, code int) {
+	detector := ""
+	if len(args) == 1 {
+		detector = strings.ToUpper(args[0])
+	}
+
+	var hosts []string
+	hosts, err = svc.GetHostInventory(detector)
+	if err != nil {
+		return err, EC_CONNECTION_ERROR
+	}
+
+	format, err := cmd.Flags().GetString("output")
+	if err != nil {
+		return err, EC_INVALID_ARGS
+	}
+	if format == "text" {
+		_, _ = fmt.Fprintln(o, strings.Join(hosts, "\n"))
+		return nil, 0
+	}
+
+	var output []byte
+	output, err = formatListOutput(cmd, hosts)
+	if err != nil {
+		return err, EC_LOGIC_ERROR
+	}
+	_, _ = fmt.Fprintln(o, string(output))
+	return nil, 0
+}
+
+func AddHost(svc configuration.Service, cmd *cobra.Command, args []string, o io.Writer)(err error, code int) {
+	hostname := args[0]
+	detector := ""
+	if len(args) == 2 {
+		detector = args[1]
+	}
+
+	err = svc.AddHost(hostname, detector)
+	if err != nil {
+		return err, EC_CONNECTION_ERROR
+	}
+
+	msg := "Host added to inventory: " + red(hostname)
+	if len(detector) > 0 {
+		msg += ", detector " + blue(strings.ToUpper(detector))
+	}
+	_, _ = fmt.Fprintln(o, msg)
+	return nil, 0
+}
+
+func RemoveHost(svc configuration.Service, cmd *cobra.Command, args []string, o io.Writer)(err error, code int) {
+	err = svc.RemoveHost(args[0])
+	if err != nil {
+		return err, EC_CONNECTION_ERROR
+	}
+	_, _ = fmt.Fprintln(o, "Host removed from inventory: " + red(args[0]))
+	return nil, 0
+}
+
+func AssignHost(svc configuration.Service, cmd *cobra.Command, args []string, o io.Writer)(err error, code int) {
+	err = svc.AssignHostToDetector(args[0], args[1])
+	if err != nil {
+		return err, EC_CONNECTION_ERROR
+	}
+	_, _ = fmt.Fprintln(o, "Host " + red(args[0]) + " assigned to detector " + blue(strings.ToUpper(args[1])))
+	return nil, 0
+}
+
+func AddCard(svc configuration.Service, cmd *cobra.Command, args []string, o io.Writer)(err error, code int) {
+	card := &configuration.CRUCard{
+		Serial: args[1],
+	}
+	if len(args) == 3 {
+		card.Endpoint = args[2]
+	}
+	for flag, field := range map[string]*string{
+		"type":               &card.Type,
+		"pci-address":        &card.PciAddress,
+		"numa":               &card.Numa,
+		"firmware":           &card.Firmware,
+		"user-logic-version": &card.UserLogicVersion,
+	} {
+		*field, err = cmd.Flags().GetString(flag)
+		if err != nil {
+			return err, EC_INVALID_ARGS
+		}
+	}
+
+	err = svc.SetCRUCard(args[0], card)
+	if err != nil {
+		return err, EC_CONNECTION_ERROR
+	}
+
+	msg := "CRU card " + blue(card.Serial)
+	if len(card.Endpoint) > 0 {
+		msg += " endpoint " + blue(card.Endpoint)
+	}
+	_, _ = fmt.Fprintln(o, msg + " registered on host " + red(args[0]))
+	return nil, 0
+}
+
+func RemoveCard(svc configuration.Service, cmd *cobra.Command, args []string, o io.Writer)(err error, code int) {
+	endpoint := ""
+	if len(args) == 3 {
+		endpoint = args[2]
+	}
+
+	err = svc.RemoveCRUCard(args[0], args[1], endpoint)
+	if err != nil {
+		return err, EC_CONNECTION_ERROR
+	}
+
+	msg := "CRU card " + blue(args[1])
+	if len(endpoint) > 0 {
+		msg += " endpoint " + blue(endpoint)
+	}
+	_, _ = fmt.Fprintln(o, msg + " removed from host " + red(args[0]))
+	return nil, 0
+}
+
+func CheckInventory(svc configuration.Service, cmd *cobra.Command, args []string, o io.Writer)(err error, code int) {
+	var issues []configuration.InventoryIssue
+	issues, err = svc.CheckInventory()
+	if err != nil {
+		return err, EC_CONNECTION_ERROR
+	}
+
+	err = formatInventoryIssues(cmd, issues, o)
+	if err != nil {
+		return err, EC_LOGIC_ERROR
+	}
+	return nil, 0
+}
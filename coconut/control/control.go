@@ -32,10 +32,12 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"os/signal"
 	"regexp"
 	"sort"
 	"strconv"
 	"strings"
+	"syscall"
 	"text/template"
 	"time"
 
@@ -109,6 +111,37 @@ func WrapCall(call ControlCall) RunFunc {
 	}
 }
 
+// WrapStreamingCall is like WrapCall, but for calls which keep writing output
+// until the server closes the stream or the user interrupts them, so there is
+// no call timeout, no spinner and output goes straight to stdout.
+func WrapStreamingCall(call ControlCall) RunFunc {
+	return func(cmd *cobra.Command, args []string) {
+		endpoint := viper.GetString("endpoint")
+		log.WithPrefix(cmd.Use).
+			WithField("endpoint", endpoint).
+			Debug("initializing gRPC client")
+
+		cxt, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		interrupt := make(chan os.Signal, 1)
+		signal.Notify(interrupt, os.Interrupt, syscall.SIGTERM)
+		go func() {
+			<-interrupt
+			cancel()
+		}()
+
+		rpc := coconut.NewClient(cxt, cancel, endpoint)
+
+		err := call(cxt, rpc, cmd, args, os.Stdout)
+		if err != nil && cxt.Err() == nil {
+			log.WithPrefix(cmd.Use).
+				WithError(err).
+				Fatal("command finished with error")
+			os.Exit(1)
+		}
+	}
+}
 
 func GetInfo(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Command, args []string, o io.Writer) (err error) {
 	var response *pb.GetFrameworkInfoReply
@@ -353,6 +386,9 @@ func CreateEnvironment(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.C
 		varsStr = stringMapToString(env.Vars, "\t")
 		userVarsStr = stringMapToString(env.UserVars, "\t")
 	)
+	if env.GetConfigurationChanged() {
+		_, _ = fmt.Fprintf(o, "configuration:      %s\n", yellow("changed since environment creation"))
+	}
 	if len(defaultsStr) != 0 {
 		_, _ = fmt.Fprintf(o, "global defaults:\n%s\n", defaultsStr)
 	}
@@ -623,7 +659,7 @@ func GetTasks(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Command, a
 					t.GetClassName(),
 					t.GetDeploymentInfo().GetHostname(),
 					strconv.FormatBool(t.GetLocked()),
-					t.GetStatus(),
+					formatTaskStatus(t),
 					colorState(t.GetState()),
 					t.GetPid(),
 				}
@@ -634,6 +670,124 @@ func GetTasks(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Command, a
 }
 
 
+func GetTaskLogs(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Command, args []string, o io.Writer) (err error) {
+	if len(args) != 1 {
+		err = errors.New(fmt.Sprintf("accepts 1 arg(s), received %d", len(args)))
+		return
+	}
+	taskId := args[0]
+
+	follow, err := cmd.Flags().GetBool("follow")
+	if err != nil {
+		return
+	}
+	lines, err := cmd.Flags().GetInt32("lines")
+	if err != nil {
+		return
+	}
+	stderr, err := cmd.Flags().GetBool("stderr")
+	if err != nil {
+		return
+	}
+	stream := "stdout"
+	if stderr {
+		stream = "stderr"
+	}
+
+	logStream, err := rpc.GetTaskLogs(cxt, &pb.GetTaskLogsRequest{
+		TaskId: taskId,
+		Stream: stream,
+		Lines:  lines,
+		Follow: follow,
+	}, grpc.EmptyCallOption{})
+	if err != nil {
+		return
+	}
+
+	for {
+		var chunk *pb.GetTaskLogsReply
+		chunk, err = logStream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return
+		}
+		_, _ = fmt.Fprint(o, chunk.GetData())
+	}
+}
+
+func ShowTask(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Command, args []string, o io.Writer) (err error) {
+	if len(args) != 1 {
+		err = errors.New(fmt.Sprintf("accepts 1 arg(s), received %d", len(args)))
+		return
+	}
+
+	var response *pb.GetTaskReply
+	response, err = rpc.GetTask(cxt, &pb.GetTaskRequest{TaskId: args[0]}, grpc.EmptyCallOption{})
+	if err != nil {
+		return
+	}
+
+	task := response.GetTask()
+	shortInfo := task.GetShortInfo()
+	commandInfo := task.GetCommandInfo()
+
+	_, _ = fmt.Fprintf(o, "task id:            %s\n", shortInfo.GetTaskId())
+	_, _ = fmt.Fprintf(o, "class name:         %s\n", task.GetClassInfo().GetName())
+	_, _ = fmt.Fprintf(o, "control mode:       %s\n", task.GetClassInfo().GetControlMode())
+	_, _ = fmt.Fprintf(o, "hostname:           %s\n", shortInfo.GetDeploymentInfo().GetHostname())
+	_, _ = fmt.Fprintf(o, "status:             %s\n", formatTaskStatus(shortInfo))
+	_, _ = fmt.Fprintf(o, "state:              %s\n", colorState(shortInfo.GetState()))
+	_, _ = fmt.Fprintf(o, "PID:                %s\n", shortInfo.GetPid())
+	if shortInfo.GetUnhealthy() {
+		_, _ = fmt.Fprintf(o, "health:             %s\n", red(shortInfo.GetHealthMessage()))
+	}
+	if len(task.GetEnvId()) != 0 {
+		_, _ = fmt.Fprintf(o, "environment id:     %s\n", task.GetEnvId())
+		_, _ = fmt.Fprintf(o, "role path:          %s\n", task.GetTaskPath())
+	}
+	if commandInfo != nil {
+		_, _ = fmt.Fprintf(o, "command:            %s\n", strings.Join(append([]string{commandInfo.GetValue()}, commandInfo.GetArguments()...), " "))
+	}
+
+	if occ := task.GetOccCapabilities(); occ != nil {
+		negotiated := "negotiated"
+		if !occ.GetNegotiated() {
+			negotiated = grey("assumed, legacy OCC server")
+		}
+		_, _ = fmt.Fprintf(o, "OCC capabilities:   %s\n", negotiated)
+		_, _ = fmt.Fprintf(o, "\ttransport:  %s\n", occ.GetTransport())
+		_, _ = fmt.Fprintf(o, "\tstreaming:  %s\n", strconv.FormatBool(occ.GetStateStream()))
+		if occ.GetPtree() {
+			_, _ = fmt.Fprintf(o, "\tptree:      %s\n", strings.Join(occ.GetPropertyFormats(), ", "))
+		} else {
+			_, _ = fmt.Fprintf(o, "\tptree:      %s\n", "no")
+		}
+		if len(occ.GetVersion()) > 0 {
+			_, _ = fmt.Fprintf(o, "\tversion:    %s\n", occ.GetVersion())
+		}
+	}
+
+	usage := task.GetResourceUsage()
+	if usage == nil {
+		_, _ = fmt.Fprintf(o, "resource usage:     %s\n", grey("not available"))
+		return
+	}
+	sampledAt := time.Unix(0, usage.GetSampledAt()*int64(time.Millisecond))
+	_, _ = fmt.Fprintf(o, "resource usage:     sampled %s (%s ago)\n",
+		sampledAt.Local().Format("2006-01-02 15:04:05 MST"),
+		time.Since(sampledAt).Truncate(time.Second).String())
+	_, _ = fmt.Fprintf(o, "\tprocesses:  %d\n", usage.GetProcesses())
+	_, _ = fmt.Fprintf(o, "\tthreads:    %d\n", usage.GetThreads())
+	_, _ = fmt.Fprintf(o, "\tCPU:        %.1f%% (%.1fs total)\n", usage.GetCpuPercent(), usage.GetCpuSeconds())
+	_, _ = fmt.Fprintf(o, "\tmemory:     %s RSS\n", formatBytes(usage.GetRssBytes()))
+	_, _ = fmt.Fprintf(o, "\topen fds:   %d\n", usage.GetOpenFds())
+	_, _ = fmt.Fprintf(o, "\tI/O:        %s read, %s written\n", formatBytes(usage.GetReadBytes()), formatBytes(usage.GetWriteBytes()))
+	return
+}
+
+
 func CleanTasks(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Command, args []string, o io.Writer) (err error) {
 	if len(args) > 0 {
 		for _, id := range args {
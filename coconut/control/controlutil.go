@@ -154,6 +154,32 @@ func formatTimestamp(rfc3339timestamp string) string {
 	return formatted
 }
 
+func formatBytes(bytes uint64) string {
+	const unit = 1024
+	if bytes < unit {
+		return fmt.Sprintf("%d B", bytes)
+	}
+	div, exp := uint64(unit), 0
+	for n := bytes / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(bytes)/float64(div), "KMGTPE"[exp])
+}
+
+// formatTaskStatus shows tasks which are still starting up along with the
+// time elapsed since launch, e.g. "starting (12s)", and flags tasks whose
+// health checks are failing.
+func formatTaskStatus(t *pb.ShortTaskInfo) string {
+	if len(t.GetStartupPhase()) > 0 {
+		return fmt.Sprintf("starting (%ds)", t.GetStartupElapsed())
+	}
+	if t.GetUnhealthy() {
+		return t.GetStatus() + " " + red("(unhealthy)")
+	}
+	return t.GetStatus()
+}
+
 func formatNumber(numberOfMachines int32) string {
 	nMString := strconv.FormatInt(int64(numberOfMachines), 10)
 	if numberOfMachines == 0 {
@@ -29,6 +29,7 @@ type ControlClient interface {
 	DestroyEnvironment(ctx context.Context, in *DestroyEnvironmentRequest, opts ...grpc.CallOption) (*DestroyEnvironmentReply, error)
 	GetTasks(ctx context.Context, in *GetTasksRequest, opts ...grpc.CallOption) (*GetTasksReply, error)
 	GetTask(ctx context.Context, in *GetTaskRequest, opts ...grpc.CallOption) (*GetTaskReply, error)
+	GetTaskLogs(ctx context.Context, in *GetTaskLogsRequest, opts ...grpc.CallOption) (Control_GetTaskLogsClient, error)
 	CleanupTasks(ctx context.Context, in *CleanupTasksRequest, opts ...grpc.CallOption) (*CleanupTasksReply, error)
 	GetRoles(ctx context.Context, in *GetRolesRequest, opts ...grpc.CallOption) (*GetRolesReply, error)
 	GetWorkflowTemplates(ctx context.Context, in *GetWorkflowTemplatesRequest, opts ...grpc.CallOption) (*GetWorkflowTemplatesReply, error)
@@ -182,6 +183,38 @@ func (c *controlClient) GetTask(ctx context.Context, in *GetTaskRequest, opts ..
 	return out, nil
 }
 
+func (c *controlClient) GetTaskLogs(ctx context.Context, in *GetTaskLogsRequest, opts ...grpc.CallOption) (Control_GetTaskLogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Control_serviceDesc.Streams[1], "/o2control.Control/GetTaskLogs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &controlGetTaskLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Control_GetTaskLogsClient interface {
+	Recv() (*GetTaskLogsReply, error)
+	grpc.ClientStream
+}
+
+type controlGetTaskLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *controlGetTaskLogsClient) Recv() (*GetTaskLogsReply, error) {
+	m := new(GetTaskLogsReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *controlClient) CleanupTasks(ctx context.Context, in *CleanupTasksRequest, opts ...grpc.CallOption) (*CleanupTasksReply, error) {
 	out := new(CleanupTasksReply)
 	err := c.cc.Invoke(ctx, "/o2control.Control/CleanupTasks", in, out, opts...)
@@ -273,7 +306,7 @@ func (c *controlClient) SetRepoDefaultRevision(ctx context.Context, in *SetRepoD
 }
 
 func (c *controlClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (Control_SubscribeClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Control_serviceDesc.Streams[1], "/o2control.Control/Subscribe", opts...)
+	stream, err := c.cc.NewStream(ctx, &_Control_serviceDesc.Streams[2], "/o2control.Control/Subscribe", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -329,6 +362,7 @@ type ControlServer interface {
 	DestroyEnvironment(context.Context, *DestroyEnvironmentRequest) (*DestroyEnvironmentReply, error)
 	GetTasks(context.Context, *GetTasksRequest) (*GetTasksReply, error)
 	GetTask(context.Context, *GetTaskRequest) (*GetTaskReply, error)
+	GetTaskLogs(*GetTaskLogsRequest, Control_GetTaskLogsServer) error
 	CleanupTasks(context.Context, *CleanupTasksRequest) (*CleanupTasksReply, error)
 	GetRoles(context.Context, *GetRolesRequest) (*GetRolesReply, error)
 	GetWorkflowTemplates(context.Context, *GetWorkflowTemplatesRequest) (*GetWorkflowTemplatesReply, error)
@@ -384,6 +418,9 @@ func (UnimplementedControlServer) GetTasks(context.Context, *GetTasksRequest) (*
 func (UnimplementedControlServer) GetTask(context.Context, *GetTaskRequest) (*GetTaskReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTask not implemented")
 }
+func (UnimplementedControlServer) GetTaskLogs(*GetTaskLogsRequest, Control_GetTaskLogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetTaskLogs not implemented")
+}
 func (UnimplementedControlServer) CleanupTasks(context.Context, *CleanupTasksRequest) (*CleanupTasksReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CleanupTasks not implemented")
 }
@@ -652,6 +689,27 @@ func _Control_GetTask_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Control_GetTaskLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetTaskLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ControlServer).GetTaskLogs(m, &controlGetTaskLogsServer{stream})
+}
+
+type Control_GetTaskLogsServer interface {
+	Send(*GetTaskLogsReply) error
+	grpc.ServerStream
+}
+
+type controlGetTaskLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *controlGetTaskLogsServer) Send(m *GetTaskLogsReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Control_CleanupTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CleanupTasksRequest)
 	if err := dec(in); err != nil {
@@ -970,6 +1028,11 @@ var _Control_serviceDesc = grpc.ServiceDesc{
 			Handler:       _Control_TrackStatus_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GetTaskLogs",
+			Handler:       _Control_GetTaskLogs_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "Subscribe",
 			Handler:       _Control_Subscribe_Handler,
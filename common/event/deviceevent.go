@@ -25,6 +25,9 @@
 package event
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/AliceO2Group/Control/executor/protos"
 	"github.com/mesos/mesos-go/api/v1/lib"
 )
@@ -80,6 +83,30 @@ func NewDeviceEvent(origin DeviceEventOrigin, t pb.DeviceEventType) (de DeviceEv
 				Origin: origin,
 			},
 		}
+	case pb.DeviceEventType_TASK_RESOURCE_USAGE:
+		de = &TaskResourceUsage{
+			DeviceEventBase: DeviceEventBase{
+				eventBase: *newDeviceEventBase("DeviceEvent", nil),
+				Type:   t,
+				Origin: origin,
+			},
+		}
+	case pb.DeviceEventType_PARTIAL_FAILURE:
+		de = &PartialFailure{
+			DeviceEventBase: DeviceEventBase{
+				eventBase: *newDeviceEventBase("DeviceEvent", nil),
+				Type:   t,
+				Origin: origin,
+			},
+		}
+	case pb.DeviceEventType_TASK_HEALTH:
+		de = &TaskHealth{
+			DeviceEventBase: DeviceEventBase{
+				eventBase: *newDeviceEventBase("DeviceEvent", nil),
+				Type:   t,
+				Origin: origin,
+			},
+		}
 	case pb.DeviceEventType_NULL_DEVICE_EVENT:
 		de = nil
 	}
@@ -101,8 +128,68 @@ type BasicTaskTerminated struct {
 	Stderr string                   `json:"stderr"`
 	VoluntaryTermination bool       `json:"voluntaryTermination"`
 	FinalMesosState mesos.TaskState `json:"finalMesosState"`
+	KillStage string                `json:"killStage,omitempty"`
+	Outputs map[string]string       `json:"outputs,omitempty"` // structured results printed by the task
 }
 
 func (e *BasicTaskTerminated) GetName() string {
 	return "BASIC_TASK_TERMINATED"
-}
\ No newline at end of file
+}
+// ResourceUsage is a sample of the resources used by the whole process
+// tree of a task, as read by the executor from /proc.
+type ResourceUsage struct {
+	SampledAt  time.Time `json:"sampledAt"`
+	Processes  int       `json:"processes"`
+	CpuSeconds float64   `json:"cpuSeconds"` // user+system time since process start
+	CpuPercent float64   `json:"cpuPercent"` // over the last sampling interval, 100 = 1 core
+	RssBytes   uint64    `json:"rssBytes"`
+	OpenFds    int       `json:"openFds"`
+	Threads    int       `json:"threads"`
+	ReadBytes  uint64    `json:"readBytes"`
+	WriteBytes uint64    `json:"writeBytes"`
+}
+
+type TaskResourceUsage struct {
+	DeviceEventBase
+	Usage ResourceUsage `json:"usage"`
+}
+
+func (e *TaskResourceUsage) GetName() string {
+	return "TASK_RESOURCE_USAGE"
+}
+
+// PartialFailure is reported by tasks which control a group of devices, such
+// as the ODC shim, whenever the number of devices in ERROR changes.
+type PartialFailure struct {
+	DeviceEventBase
+	ErrorCount  int    `json:"errorCount"`
+	DeviceCount int    `json:"deviceCount"`
+	Message     string `json:"message,omitempty"`
+}
+
+func (e *PartialFailure) GetName() string {
+	return "PARTIAL_FAILURE"
+}
+
+// TaskHealth is sent by the executor when a health check of a RUNNING task
+// crosses its failure threshold, and again once it passes. Index is the
+// position of the check among the health checks of the task class, since
+// several checks may share the same type.
+type TaskHealth struct {
+	DeviceEventBase
+	Check    string `json:"check"`
+	Index    int    `json:"index"`
+	Policy   string `json:"policy"`
+	Healthy  bool   `json:"healthy"`
+	Failures int    `json:"failures"`
+	Message  string `json:"message,omitempty"`
+}
+
+func (e *TaskHealth) GetName() string {
+	return "TASK_HEALTH"
+}
+
+// CheckKey identifies the health check within its task, e.g. http#1.
+func (e *TaskHealth) CheckKey() string {
+	return fmt.Sprintf("%s#%d", e.Check, e.Index)
+}
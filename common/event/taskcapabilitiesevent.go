@@ -0,0 +1,64 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2021 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package event
+
+import "github.com/AliceO2Group/Control/common/utils"
+
+// OccCapabilities is what the executor found out about the OCC server of a
+// controllable task when connecting to it.
+type OccCapabilities struct {
+	Transport       string   `json:"transport"` // "protobuf" or "json"
+	StateStream     bool     `json:"stateStream"`
+	Ptree           bool     `json:"ptree"`
+	PropertyFormats []string `json:"propertyFormats,omitempty"`
+	Version         string   `json:"version,omitempty"`
+	Negotiated      bool     `json:"negotiated"` // false for legacy OCC servers, whose capabilities are assumed
+}
+
+type TaskCapabilitiesEvent struct {
+	eventBase
+	TaskId       string          `json:"taskId"`
+	Capabilities OccCapabilities `json:"capabilities"`
+}
+
+func (tce *TaskCapabilitiesEvent) GetName() string {
+	return "TASK_CAPABILITIES"
+}
+
+func (tce *TaskCapabilitiesEvent) GetTaskId() string {
+	return tce.TaskId
+}
+
+func NewTaskCapabilitiesEvent(id string, capabilities OccCapabilities) (tce *TaskCapabilitiesEvent) {
+	tce = &TaskCapabilitiesEvent{
+		eventBase: eventBase{
+			Timestamp:   utils.NewUnixTimestamp(),
+			MessageType: "TaskCapabilitiesEvent",
+		},
+		TaskId:       id,
+		Capabilities: capabilities,
+	}
+	return tce
+}
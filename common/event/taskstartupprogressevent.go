@@ -0,0 +1,63 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2021 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+
+package event
+
+import "github.com/AliceO2Group/Control/common/utils"
+
+// Startup phases of a controllable task, as reported by the executor
+const (
+	StartupPhaseConnecting   = "connecting"   // waiting for the control interface to come up
+	StartupPhaseInitializing = "initializing" // waiting for the STANDBY-equivalent state
+	StartupPhaseProbing      = "probing"      // running the readiness command
+)
+
+type TaskStartupProgressEvent struct {
+	eventBase
+	TaskId      string `json:"taskId"`
+	Phase       string `json:"phase"`
+	ElapsedMs   int64  `json:"elapsedMs"`
+}
+
+func (tsp *TaskStartupProgressEvent) GetName() string {
+	return "TASK_STARTUP_PROGRESS"
+}
+
+func (tsp *TaskStartupProgressEvent) GetTaskId() string {
+	return tsp.TaskId
+}
+
+func NewTaskStartupProgressEvent(id string, phase string, elapsedMs int64) (tsp *TaskStartupProgressEvent) {
+	tsp = &TaskStartupProgressEvent{
+		eventBase: eventBase{
+			Timestamp:   utils.NewUnixTimestamp(),
+			MessageType: "TaskStartupProgressEvent",
+		},
+		TaskId:      id,
+		Phase:       phase,
+		ElapsedMs:   elapsedMs,
+	}
+	return tsp
+}
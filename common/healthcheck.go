@@ -0,0 +1,244 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2021 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+
+package common
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	HealthCheckHeartbeat = "heartbeat" // OCC GetState
+	HealthCheckHttp      = "http"
+	HealthCheckCommand   = "command"
+)
+
+const (
+	HealthPolicyWarn      = "warn"      // log only
+	HealthPolicyUnhealthy = "unhealthy" // flag the task as unhealthy until the check passes again
+	HealthPolicyError     = "error"     // move the task to ERROR, and let the environment handle it
+)
+
+const (
+	DefaultHealthCheckInterval  = 10*time.Second
+	DefaultHealthCheckTimeout   = 5*time.Second
+	DefaultHealthCheckThreshold = 3
+)
+
+// HealthCheck describes a check which the executor runs periodically
+// against a controllable task while it is RUNNING. Once Threshold
+// consecutive checks fail, the core is notified and applies Policy.
+type HealthCheck struct {
+	Type      string        `json:"type"`
+	Interval  time.Duration `json:"interval,omitempty"`
+	Timeout   time.Duration `json:"timeout,omitempty"`
+	Threshold int           `json:"threshold,omitempty"`
+	Url       *string       `json:"url,omitempty"`     // http only
+	Command   *string       `json:"command,omitempty"` // command only, run through /bin/sh
+	Policy    string        `json:"policy,omitempty"`
+}
+
+func (m *HealthCheck) UnmarshalYAML(unmarshal func(interface{}) error) (err error) {
+	type _healthCheck struct {
+		Type      string  `yaml:"type"`
+		Interval  *string `yaml:"interval,omitempty"`
+		Timeout   *string `yaml:"timeout,omitempty"`
+		Threshold *string `yaml:"threshold,omitempty"`
+		Url       *string `yaml:"url,omitempty"`
+		Command   *string `yaml:"command,omitempty"`
+		Policy    string  `yaml:"policy,omitempty"`
+	}
+	aux := _healthCheck{}
+	err = unmarshal(&aux)
+	if err != nil {
+		return
+	}
+
+	hc := HealthCheck{
+		Type:    strings.TrimSpace(strings.ToLower(aux.Type)),
+		Url:     aux.Url,
+		Command: aux.Command,
+		Policy:  strings.TrimSpace(strings.ToLower(aux.Policy)),
+	}
+	switch hc.Type {
+	case HealthCheckHeartbeat:
+	case HealthCheckHttp:
+		if hc.GetUrl() == "" {
+			return fmt.Errorf("http health check without url")
+		}
+	case HealthCheckCommand:
+		if hc.GetCommand() == "" {
+			return fmt.Errorf("command health check without command")
+		}
+	default:
+		return fmt.Errorf("unknown health check type %s, allowed values: %s", aux.Type,
+			strings.Join([]string{HealthCheckHeartbeat, HealthCheckHttp, HealthCheckCommand}, ", "))
+	}
+	switch hc.Policy {
+	case "", HealthPolicyWarn, HealthPolicyUnhealthy, HealthPolicyError:
+	default:
+		return fmt.Errorf("unknown health check policy %s, allowed values: %s", aux.Policy,
+			strings.Join([]string{HealthPolicyWarn, HealthPolicyUnhealthy, HealthPolicyError}, ", "))
+	}
+	if aux.Interval != nil {
+		hc.Interval, err = time.ParseDuration(strings.TrimSpace(*aux.Interval))
+		if err != nil {
+			return
+		}
+	}
+	if aux.Timeout != nil {
+		hc.Timeout, err = time.ParseDuration(strings.TrimSpace(*aux.Timeout))
+		if err != nil {
+			return
+		}
+	}
+	if aux.Threshold != nil {
+		hc.Threshold, err = strconv.Atoi(strings.TrimSpace(*aux.Threshold))
+		if err != nil {
+			return
+		}
+	}
+	*m = hc
+	return
+}
+
+func (m HealthCheck) MarshalYAML() (interface{}, error) {
+	type _healthCheck struct {
+		Type      string  `yaml:"type"`
+		Interval  string  `yaml:"interval,omitempty"`
+		Timeout   string  `yaml:"timeout,omitempty"`
+		Threshold int     `yaml:"threshold,omitempty"`
+		Url       *string `yaml:"url,omitempty"`
+		Command   *string `yaml:"command,omitempty"`
+		Policy    string  `yaml:"policy,omitempty"`
+	}
+	aux := _healthCheck{
+		Type:      m.Type,
+		Threshold: m.Threshold,
+		Url:       m.Url,
+		Command:   m.Command,
+		Policy:    m.Policy,
+	}
+	if m.Interval != 0 {
+		aux.Interval = m.Interval.String()
+	}
+	if m.Timeout != 0 {
+		aux.Timeout = m.Timeout.String()
+	}
+	return aux, nil
+}
+
+func (m *HealthCheck) Equals(other *HealthCheck) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.Type == other.Type &&
+		m.GetInterval() == other.GetInterval() &&
+		m.GetTimeout() == other.GetTimeout() &&
+		m.GetThreshold() == other.GetThreshold() &&
+		m.GetUrl() == other.GetUrl() &&
+		m.GetCommand() == other.GetCommand() &&
+		m.GetPolicy() == other.GetPolicy()
+}
+
+func (m *HealthCheck) GetInterval() time.Duration {
+	if m != nil && m.Interval > 0 {
+		return m.Interval
+	}
+	return DefaultHealthCheckInterval
+}
+
+func (m *HealthCheck) GetTimeout() time.Duration {
+	if m != nil && m.Timeout > 0 {
+		return m.Timeout
+	}
+	return DefaultHealthCheckTimeout
+}
+
+func (m *HealthCheck) GetThreshold() int {
+	if m != nil && m.Threshold > 0 {
+		return m.Threshold
+	}
+	return DefaultHealthCheckThreshold
+}
+
+func (m *HealthCheck) GetUrl() string {
+	if m != nil && m.Url != nil {
+		return strings.TrimSpace(*m.Url)
+	}
+	return ""
+}
+
+func (m *HealthCheck) GetCommand() string {
+	if m != nil && m.Command != nil {
+		return strings.TrimSpace(*m.Command)
+	}
+	return ""
+}
+
+func (m *HealthCheck) GetPolicy() string {
+	if m != nil && len(m.Policy) > 0 {
+		return m.Policy
+	}
+	return HealthPolicyWarn
+}
+
+type HealthChecks []HealthCheck
+
+func (m HealthChecks) Equals(other HealthChecks) bool {
+	if len(m) != len(other) {
+		return false
+	}
+	for i := range m {
+		if !m[i].Equals(&other[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Copy returns a deep copy, whose Url and Command can be templated
+// without affecting the original.
+func (m HealthChecks) Copy() HealthChecks {
+	if m == nil {
+		return nil
+	}
+	hcs := make(HealthChecks, len(m))
+	for i, hc := range m {
+		hcs[i] = hc
+		if hc.Url != nil {
+			url := *hc.Url
+			hcs[i].Url = &url
+		}
+		if hc.Command != nil {
+			command := *hc.Command
+			hcs[i].Command = &command
+		}
+	}
+	return hcs
+}
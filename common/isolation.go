@@ -0,0 +1,186 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2021 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+
+package common
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Isolation modes for tasks, see Isolation
+const (
+	IsolationNone    = ""
+	IsolationSystemd = "systemd" // transient systemd scope with cgroup limits
+	IsolationOci     = "oci"     // OCI bundle run with runc or crun
+	IsolationPodman  = "podman"  // container image run with podman
+)
+
+const DefaultOciRuntime = "runc"
+
+type IsolationMount struct {
+	Source   string `json:"source" yaml:"source"`
+	Target   string `json:"target" yaml:"target"`
+	ReadOnly bool   `json:"readOnly,omitempty" yaml:"readOnly,omitempty"`
+}
+
+// Isolation describes how the executor should isolate a task from the rest
+// of the host. With the zero value the task runs as a plain child process of
+// the executor.
+// Cpus and Memory (in MB, like ResourceWants) are enforced as cgroup limits
+// in all modes. Mounts and Devices only apply to container modes, where
+// Bundle is the path of an OCI bundle (oci) and Image a container image
+// reference (podman).
+type Isolation struct {
+	Mode    string           `json:"mode,omitempty"`
+	Cpus    float64          `json:"cpus,omitempty"`
+	Memory  float64          `json:"memory,omitempty"`
+	Runtime string           `json:"runtime,omitempty"`
+	Bundle  string           `json:"bundle,omitempty"`
+	Image   string           `json:"image,omitempty"`
+	Mounts  []IsolationMount `json:"mounts,omitempty"`
+	Devices []string         `json:"devices,omitempty"`
+}
+
+func (m *Isolation) UnmarshalYAML(unmarshal func(interface{}) error) (err error) {
+	type _isolation struct {
+		Mode    string           `yaml:"mode"`
+		Cpus    *string          `yaml:"cpus"`
+		Memory  *string          `yaml:"memory"`
+		Runtime string           `yaml:"runtime"`
+		Bundle  string           `yaml:"bundle"`
+		Image   string           `yaml:"image"`
+		Mounts  []IsolationMount `yaml:"mounts"`
+		Devices []string         `yaml:"devices"`
+	}
+	aux := _isolation{}
+	err = unmarshal(&aux)
+	if err != nil {
+		return
+	}
+
+	iso := Isolation{
+		Mode:    strings.ToLower(strings.TrimSpace(aux.Mode)),
+		Runtime: strings.TrimSpace(aux.Runtime),
+		Bundle:  strings.TrimSpace(aux.Bundle),
+		Image:   strings.TrimSpace(aux.Image),
+		Mounts:  aux.Mounts,
+		Devices: aux.Devices,
+	}
+	switch iso.Mode {
+	case "none":
+		iso.Mode = IsolationNone
+	case IsolationNone, IsolationSystemd, IsolationOci, IsolationPodman:
+	default:
+		return fmt.Errorf("unknown isolation mode %s", aux.Mode)
+	}
+	if aux.Cpus != nil {
+		iso.Cpus, err = strconv.ParseFloat(strings.TrimSpace(*aux.Cpus), 64)
+		if err != nil {
+			return
+		}
+	}
+	if aux.Memory != nil {
+		iso.Memory, err = strconv.ParseFloat(strings.TrimSpace(*aux.Memory), 64)
+		if err != nil {
+			return
+		}
+	}
+	*m = iso
+	return
+}
+
+func (m Isolation) MarshalYAML() (interface{}, error) {
+	type _isolation struct {
+		Mode    string           `yaml:"mode,omitempty"`
+		Cpus    string           `yaml:"cpus,omitempty"`
+		Memory  string           `yaml:"memory,omitempty"`
+		Runtime string           `yaml:"runtime,omitempty"`
+		Bundle  string           `yaml:"bundle,omitempty"`
+		Image   string           `yaml:"image,omitempty"`
+		Mounts  []IsolationMount `yaml:"mounts,omitempty"`
+		Devices []string         `yaml:"devices,omitempty"`
+	}
+	aux := _isolation{
+		Mode:    m.Mode,
+		Runtime: m.Runtime,
+		Bundle:  m.Bundle,
+		Image:   m.Image,
+		Mounts:  m.Mounts,
+		Devices: m.Devices,
+	}
+	if m.Cpus != 0 {
+		aux.Cpus = strconv.FormatFloat(m.Cpus, 'f', -1, 64)
+	}
+	if m.Memory != 0 {
+		aux.Memory = strconv.FormatFloat(m.Memory, 'f', -1, 64)
+	}
+	return aux, nil
+}
+
+func (m *Isolation) Equals(other *Isolation) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.Mode != other.Mode ||
+		m.Cpus != other.Cpus ||
+		m.Memory != other.Memory ||
+		m.GetRuntime() != other.GetRuntime() ||
+		m.Bundle != other.Bundle ||
+		m.Image != other.Image ||
+		len(m.Mounts) != len(other.Mounts) ||
+		len(m.Devices) != len(other.Devices) {
+		return false
+	}
+	for i := range m.Mounts {
+		if m.Mounts[i] != other.Mounts[i] {
+			return false
+		}
+	}
+	for i := range m.Devices {
+		if m.Devices[i] != other.Devices[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *Isolation) IsEnabled() bool {
+	return m != nil && m.Mode != IsolationNone
+}
+
+// IsContainer returns true if the task runs in its own PID namespace, i.e.
+// the PID of the process started by the executor is not the PID of the task
+func (m *Isolation) IsContainer() bool {
+	return m != nil && (m.Mode == IsolationOci || m.Mode == IsolationPodman)
+}
+
+func (m *Isolation) GetRuntime() string {
+	if m != nil && len(m.Runtime) > 0 {
+		return m.Runtime
+	}
+	return DefaultOciRuntime
+}
@@ -0,0 +1,137 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2021 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+
+package common
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	DefaultKillTransitionTimeout = 5*time.Second
+	DefaultKillSigtermTimeout    = 1*time.Second
+)
+
+// KillSequence describes how the executor brings down a running task.
+// Unless disabled, controllable tasks are first driven through their
+// shutdown transitions (STOP, RESET, EXIT...) within TransitionTimeout.
+// If the process is still alive, it receives SIGTERM, and after
+// SigtermTimeout elapses SIGINT. If it still doesn't exit, the whole
+// process group is SIGKILLed.
+type KillSequence struct {
+	ShutdownTransition *bool         `json:"shutdownTransition,omitempty"`
+	TransitionTimeout  time.Duration `json:"transitionTimeout,omitempty"`
+	SigtermTimeout     time.Duration `json:"sigtermTimeout,omitempty"`
+}
+
+func (m *KillSequence) UnmarshalYAML(unmarshal func(interface{}) error) (err error) {
+	type _killSequence struct {
+		ShutdownTransition *string `yaml:"shutdownTransition,omitempty"`
+		TransitionTimeout  *string `yaml:"transitionTimeout,omitempty"`
+		SigtermTimeout     *string `yaml:"sigtermTimeout,omitempty"`
+	}
+	aux := _killSequence{}
+	err = unmarshal(&aux)
+	if err != nil {
+		return
+	}
+
+	ks := KillSequence{}
+	if aux.ShutdownTransition != nil {
+		ks.ShutdownTransition = new(bool)
+		switch strings.TrimSpace(strings.ToLower(*aux.ShutdownTransition)) {
+		case "true":
+			*ks.ShutdownTransition = true
+		case "false":
+			*ks.ShutdownTransition = false
+		default:
+			return fmt.Errorf("invalid kill sequence shutdownTransition %s, allowed values: true, false", *aux.ShutdownTransition)
+		}
+	}
+	if aux.TransitionTimeout != nil {
+		ks.TransitionTimeout, err = time.ParseDuration(strings.TrimSpace(*aux.TransitionTimeout))
+		if err != nil {
+			return
+		}
+	}
+	if aux.SigtermTimeout != nil {
+		ks.SigtermTimeout, err = time.ParseDuration(strings.TrimSpace(*aux.SigtermTimeout))
+		if err != nil {
+			return
+		}
+	}
+	*m = ks
+	return
+}
+
+func (m KillSequence) MarshalYAML() (interface{}, error) {
+	type _killSequence struct {
+		ShutdownTransition *bool   `yaml:"shutdownTransition,omitempty"`
+		TransitionTimeout  string  `yaml:"transitionTimeout,omitempty"`
+		SigtermTimeout     string  `yaml:"sigtermTimeout,omitempty"`
+	}
+	aux := _killSequence{
+		ShutdownTransition: m.ShutdownTransition,
+	}
+	if m.TransitionTimeout != 0 {
+		aux.TransitionTimeout = m.TransitionTimeout.String()
+	}
+	if m.SigtermTimeout != 0 {
+		aux.SigtermTimeout = m.SigtermTimeout.String()
+	}
+	return aux, nil
+}
+
+func (m *KillSequence) Equals(other *KillSequence) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.GetShutdownTransition() == other.GetShutdownTransition() &&
+		m.GetTransitionTimeout() == other.GetTransitionTimeout() &&
+		m.GetSigtermTimeout() == other.GetSigtermTimeout()
+}
+
+func (m *KillSequence) GetShutdownTransition() bool {
+	if m != nil && m.ShutdownTransition != nil {
+		return *m.ShutdownTransition
+	}
+	return true
+}
+
+func (m *KillSequence) GetTransitionTimeout() time.Duration {
+	if m != nil && m.TransitionTimeout > 0 {
+		return m.TransitionTimeout
+	}
+	return DefaultKillTransitionTimeout
+}
+
+func (m *KillSequence) GetSigtermTimeout() time.Duration {
+	if m != nil && m.SigtermTimeout > 0 {
+		return m.SigtermTimeout
+	}
+	return DefaultKillSigtermTimeout
+}
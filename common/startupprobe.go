@@ -0,0 +1,103 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2021 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+
+package common
+
+import (
+	"strings"
+	"time"
+)
+
+const DefaultStartupTimeout = 30*time.Second
+
+// StartupProbe describes how the executor decides that a controllable task
+// has finished starting up. Once the executor is connected to its control
+// port, the task must reach its STANDBY-equivalent state within Timeout,
+// and if a Readiness command is set, it must also exit with status 0 before
+// the task is reported as running.
+type StartupProbe struct {
+	Timeout   time.Duration `json:"timeout,omitempty"`
+	Readiness *string       `json:"readiness,omitempty"`
+}
+
+func (m *StartupProbe) UnmarshalYAML(unmarshal func(interface{}) error) (err error) {
+	type _startupProbe struct {
+		Timeout   *string `yaml:"timeout,omitempty"`
+		Readiness *string `yaml:"readiness,omitempty"`
+	}
+	aux := _startupProbe{}
+	err = unmarshal(&aux)
+	if err != nil {
+		return
+	}
+
+	sp := StartupProbe{
+		Readiness: aux.Readiness,
+	}
+	if aux.Timeout != nil {
+		sp.Timeout, err = time.ParseDuration(strings.TrimSpace(*aux.Timeout))
+		if err != nil {
+			return
+		}
+	}
+	*m = sp
+	return
+}
+
+func (m StartupProbe) MarshalYAML() (interface{}, error) {
+	type _startupProbe struct {
+		Timeout   string  `yaml:"timeout,omitempty"`
+		Readiness *string `yaml:"readiness,omitempty"`
+	}
+	aux := _startupProbe{
+		Readiness: m.Readiness,
+	}
+	if m.Timeout != 0 {
+		aux.Timeout = m.Timeout.String()
+	}
+	return aux, nil
+}
+
+func (m *StartupProbe) Equals(other *StartupProbe) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.GetTimeout() == other.GetTimeout() &&
+		m.GetReadiness() == other.GetReadiness()
+}
+
+func (m *StartupProbe) GetTimeout() time.Duration {
+	if m != nil && m.Timeout > 0 {
+		return m.Timeout
+	}
+	return DefaultStartupTimeout
+}
+
+func (m *StartupProbe) GetReadiness() string {
+	if m != nil && m.Readiness != nil {
+		return strings.TrimSpace(*m.Readiness)
+	}
+	return ""
+}
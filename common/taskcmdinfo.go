@@ -37,4 +37,29 @@ type TaskCommandInfo struct {
 
 	// Only applies to hooks and basic tasks
 	Timeout     time.Duration           `json:"timeout,omitempty"`
+
+	// How the executor should decide that the task is up, and bring it
+	// down when killed
+	Startup     StartupProbe            `json:"startup,omitempty"`
+	Kill        KillSequence            `json:"kill,omitempty"`
+
+	// Checks the executor runs periodically while the task is RUNNING
+	Health      HealthChecks            `json:"health,omitempty"`
+
+	// Whether the task runs in a systemd scope or a container, and with
+	// which limits, mounts and devices
+	Isolation   Isolation               `json:"isolation,omitempty"`
+
+	// How often the executor reports the resource usage of the task's
+	// process tree, 0 disables sampling
+	ResourceSamplingInterval time.Duration `json:"resourceSamplingInterval,omitempty"`
+
+	// Size limits for the stdout and stderr log files the executor writes
+	// in the sandbox, 0 means executor default
+	LogMaxSize  int64                   `json:"logMaxSize,omitempty"`
+	LogMaxFiles int                     `json:"logMaxFiles,omitempty"`
+
+	// Whether the task may outlive a crashed executor, so that a restarted
+	// executor can adopt it. Only applies to controllable tasks
+	Adoptable   bool                    `json:"adoptable,omitempty"`
 }
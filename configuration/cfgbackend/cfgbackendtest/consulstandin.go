@@ -0,0 +1,250 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2021 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+// Package cfgbackendtest provides stand-ins for the configuration backends,
+// for the tests of the packages which read and write configuration.
+package cfgbackendtest
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/consul/api"
+)
+
+// consulStandIn is a minimal in-process replacement for the Consul KV and
+// transaction HTTP endpoints, good enough to run tests against a
+// ConsulSource without a Consul agent.
+type consulStandIn struct {
+	mu     sync.Mutex
+	kvs    map[string]*api.KVPair
+	index  uint64
+	notify chan struct{}
+}
+
+// NewConsulStandIn starts a Consul stand-in with an empty KV store. The
+// caller must Close the returned server.
+func NewConsulStandIn() *httptest.Server {
+	cs := &consulStandIn{
+		kvs:    make(map[string]*api.KVPair),
+		notify: make(chan struct{}),
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/kv/", cs.handleKV)
+	mux.HandleFunc("/v1/txn", cs.handleTxn)
+	return httptest.NewServer(mux)
+}
+
+func (cs *consulStandIn) handleKV(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodGet {
+		cs.block(r)
+	}
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+
+	key := strings.TrimPrefix(r.URL.Path, "/v1/kv/")
+	query := r.URL.Query()
+	_, recurse := query["recurse"]
+
+	switch r.Method {
+	case http.MethodGet:
+		if _, ok := query["keys"]; ok {
+			keys := make([]string, 0)
+			for _, kvp := range cs.list(key) {
+				keys = append(keys, kvp.Key)
+			}
+			cs.reply(w, keys, len(keys) > 0)
+			return
+		}
+		if recurse {
+			kvps := cs.list(key)
+			cs.reply(w, kvps, len(kvps) > 0)
+			return
+		}
+		kvp, ok := cs.kvs[key]
+		cs.reply(w, api.KVPairs{kvp}, ok)
+	case http.MethodPut:
+		value, _ := ioutil.ReadAll(r.Body)
+		if casStr := query.Get("cas"); casStr != "" {
+			cas, _ := strconv.ParseUint(casStr, 10, 64)
+			existing, ok := cs.kvs[key]
+			if (cas == 0 && ok) || (cas != 0 && (!ok || existing.ModifyIndex != cas)) {
+				cs.reply(w, false, true)
+				return
+			}
+		}
+		cs.set(key, value)
+		cs.reply(w, true, true)
+	case http.MethodDelete:
+		if recurse {
+			cs.deleteTree(key)
+		} else {
+			cs.delete(key)
+		}
+		cs.reply(w, true, true)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (cs *consulStandIn) handleTxn(w http.ResponseWriter, r *http.Request) {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+
+	var ops api.TxnOps
+	if err := json.NewDecoder(r.Body).Decode(&ops); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	// Same limit as a real Consul agent
+	if len(ops) > 64 {
+		http.Error(w, "Transaction contains too many operations", http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	// Checks go first, a failing one rolls back the whole transaction
+	response := api.TxnResponse{Results: make(api.TxnResults, 0)}
+	for i, op := range ops {
+		if op.KV == nil {
+			continue
+		}
+		existing, ok := cs.kvs[op.KV.Key]
+		failed := false
+		switch op.KV.Verb {
+		case api.KVCheckIndex:
+			failed = !ok || existing.ModifyIndex != op.KV.Index
+		case api.KVCheckNotExists:
+			failed = ok
+		}
+		if failed {
+			response.Errors = append(response.Errors, &api.TxnError{OpIndex: i, What: "failed index check for key " + op.KV.Key})
+		}
+	}
+	if len(response.Errors) > 0 {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusConflict)
+		_ = json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	for _, op := range ops {
+		if op.KV == nil {
+			continue
+		}
+		switch op.KV.Verb {
+		case api.KVSet:
+			cs.set(op.KV.Key, op.KV.Value)
+			response.Results = append(response.Results, &api.TxnResult{KV: cs.kvs[op.KV.Key]})
+		case api.KVDelete:
+			cs.delete(op.KV.Key)
+		case api.KVDeleteTree:
+			cs.deleteTree(op.KV.Key)
+		}
+	}
+	cs.reply(w, response, true)
+}
+
+// block implements blocking queries: a read with ?index=N only returns once
+// the store has moved past N, or after ?wait.
+func (cs *consulStandIn) block(r *http.Request) {
+	index, _ := strconv.ParseUint(r.URL.Query().Get("index"), 10, 64)
+	cs.mu.Lock()
+	if index == 0 || cs.index > index {
+		cs.mu.Unlock()
+		return
+	}
+	notify := cs.notify
+	cs.mu.Unlock()
+
+	wait, err := time.ParseDuration(r.URL.Query().Get("wait"))
+	if err != nil {
+		wait = 5*time.Minute
+	}
+	select {
+	case <-notify:
+	case <-r.Context().Done():
+	case <-time.After(wait):
+	}
+}
+
+// touch bumps the store index and wakes up blocked readers.
+func (cs *consulStandIn) touch() {
+	cs.index++
+	close(cs.notify)
+	cs.notify = make(chan struct{})
+}
+
+func (cs *consulStandIn) set(key string, value []byte) {
+	cs.touch()
+	kvp, ok := cs.kvs[key]
+	if !ok {
+		kvp = &api.KVPair{Key: key, CreateIndex: cs.index}
+		cs.kvs[key] = kvp
+	}
+	kvp.Value = value
+	kvp.ModifyIndex = cs.index
+}
+
+func (cs *consulStandIn) list(prefix string) api.KVPairs {
+	kvps := make(api.KVPairs, 0)
+	for k, kvp := range cs.kvs {
+		if strings.HasPrefix(k, prefix) {
+			kvps = append(kvps, kvp)
+		}
+	}
+	sort.Slice(kvps, func(i, j int) bool { return kvps[i].Key < kvps[j].Key })
+	return kvps
+}
+
+func (cs *consulStandIn) delete(key string) {
+	if _, ok := cs.kvs[key]; ok {
+		delete(cs.kvs, key)
+		cs.touch()
+	}
+}
+
+func (cs *consulStandIn) deleteTree(prefix string) {
+	for k := range cs.kvs {
+		if strings.HasPrefix(k, prefix) {
+			cs.delete(k)
+		}
+	}
+}
+
+func (cs *consulStandIn) reply(w http.ResponseWriter, payload interface{}, found bool) {
+	w.Header().Set("X-Consul-Index", strconv.FormatUint(cs.index, 10))
+	if !found {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(payload)
+}
@@ -1,16 +1,25 @@
 package cfgbackend_test
 
 import (
+	"fmt"
 	"io"
 	"io/ioutil"
+	"net/http/httptest"
 	"os"
+	"strings"
 	"testing"
 
+	"github.com/AliceO2Group/Control/configuration/cfgbackend"
+	"github.com/AliceO2Group/Control/configuration/cfgbackend/cfgbackendtest"
+	"github.com/go-git/go-git/v5"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"gopkg.in/yaml.v3"
 )
 
 var tmpDir *string
+var consulServer *httptest.Server
+var etcdServer *httptest.Server
 const configFile = "configuration_test.yaml"
 
 func TestConfiguration(t *testing.T) {
@@ -35,8 +44,72 @@ var _ = BeforeSuite(func() {
 
 	_, err = io.Copy(to, from)
 	Expect(err).NotTo(HaveOccurred())
+
+	// seed the Consul stand-in with the same tree
+	consulServer = cfgbackendtest.NewConsulStandIn()
+	cs, err := cfgbackend.NewConsulSource(consulAddress())
+	Expect(err).NotTo(HaveOccurred())
+	data, err := ioutil.ReadFile("./" + configFile)
+	Expect(err).NotTo(HaveOccurred())
+	seedSource(cs, data)
+
+	// and the etcd stand-in
+	etcdServer = newEtcdStandIn()
+	es, err := cfgbackend.NewEtcdSource(etcdAddress())
+	Expect(err).NotTo(HaveOccurred())
+	seedSource(es, data)
+
+	// and a git repository, with one file per key under o2/control
+	_, err = git.PlainInit(gitRepoPath(), false)
+	Expect(err).NotTo(HaveOccurred())
+	gs, err := cfgbackend.NewSource("git://" + gitRepoPath())
+	Expect(err).NotTo(HaveOccurred())
+	var tree map[string]map[string]map[string]interface{}
+	Expect(yaml.Unmarshal(data, &tree)).To(Succeed())
+	for k, v := range tree["o2"]["control"] {
+		subtree, err := yaml.Marshal(v)
+		Expect(err).NotTo(HaveOccurred())
+		err = gs.PutRecursiveYaml("o2/control/" + k, subtree)
+		Expect(err).NotTo(HaveOccurred())
+	}
 })
 
+// seedSource writes the test tree one task or workflow at a time, as the
+// whole of it doesn't fit in a single transaction.
+func seedSource(src cfgbackend.Source, data []byte) {
+	var tree map[string]map[string]map[string]interface{}
+	Expect(yaml.Unmarshal(data, &tree)).To(Succeed())
+	put := func(key string, value interface{}) {
+		subtree, err := yaml.Marshal(value)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(src.PutRecursiveYaml(key, subtree)).To(Succeed())
+	}
+	for k, v := range tree["o2"]["control"] {
+		items, isArray := v.([]interface{})
+		if !isArray {
+			put("o2/control/" + k, v)
+			continue
+		}
+		for i, item := range items {
+			put(fmt.Sprintf("o2/control/%s[%d]", k, i), item)
+		}
+	}
+}
+
+func consulAddress() string {
+	return strings.TrimPrefix(consulServer.URL, "http://")
+}
+
+func gitRepoPath() string {
+	return *tmpDir + "/git"
+}
+
+func etcdAddress() string {
+	return strings.TrimPrefix(etcdServer.URL, "http://")
+}
+
 var _ = AfterSuite(func() {
+	consulServer.Close()
+	etcdServer.Close()
 	os.RemoveAll(*tmpDir)
 })
@@ -25,18 +25,38 @@
 package cfgbackend
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"regexp"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/consul/api"
 	"gopkg.in/yaml.v3"
 )
 
+// Consul refuses transactions with more than 64 operations, or requests
+// larger than 512kB (txn_max_req_len). Values are base64 encoded in the
+// request, so we keep the raw keys and values of a transaction within half
+// of that. PutRecursive refuses trees which don't fit, since several
+// transactions together wouldn't be atomic.
+const (
+	consulTxnMaxOps   = 64
+	consulTxnMaxBytes = 256*1024
+)
+
+// Consul has no notion of arrays, so we store them the same way they're
+// addressed in keys: the items of array foo are under foo[0], foo[1], ...,
+// while empty arrays and maps are stored as the empty folders foo[]/ and foo/.
+var consulArrayKeyRe = regexp.MustCompile(`^(.+)\[(\d*)\]$`)
+
 type ConsulSource struct {
 	uri string
 	kv  *api.KV
+	txn *api.Txn
 }
 
 func NewConsulSource(uri string) (cc *ConsulSource, err error) {
@@ -49,6 +69,7 @@ func NewConsulSource(uri string) (cc *ConsulSource, err error) {
 	cc = &ConsulSource{
 		uri: uri,
 		kv: cli.KV(),
+		txn: cli.Txn(),
 	}
 	return
 }
@@ -116,10 +137,45 @@ func (cc *ConsulSource) GetRecursive(key string) (value Item, err error) {
 	if err != nil {
 		return
 	}
+	return itemFromKVPairs(requestKey, kvps), nil
+}
+
+// itemFromKVPairs rebuilds the tree at requestKey from a prefix listing.
+func itemFromKVPairs(requestKey string, kvps api.KVPairs) Item {
+	if len(requestKey) == 0 {
+		return mapify(kvps)
+	}
+
+	// The listing also includes siblings which merely start with the same
+	// characters, so we sort out what's really under requestKey
+	var (
+		leaf     *api.KVPair
+		children = make(api.KVPairs, 0)
+		elements = make(api.KVPairs, 0)
+	)
 	for _, kvp := range kvps {
-		kvp.Key = stripRequestKey(requestKey, kvp.Key)
+		switch {
+		case kvp.Key == requestKey:
+			leaf = kvp
+		case strings.HasPrefix(kvp.Key, requestKey + "/"):
+			kvp.Key = stripRequestKey(requestKey + "/", kvp.Key)
+			children = append(children, kvp)
+		case strings.HasPrefix(kvp.Key, requestKey + "["):
+			// We keep a dummy name in front of the index, so that mapify
+			// builds the array for us
+			kvp.Key = "_" + stripRequestKey(requestKey, kvp.Key)
+			elements = append(elements, kvp)
+		}
+	}
+	switch {
+	case len(children) > 0:
+		return mapify(children)
+	case len(elements) > 0:
+		return mapify(elements)["_"]
+	case leaf != nil:
+		return String(leaf.Value)
 	}
-	return mapify(kvps), nil
+	return make(Map)
 }
 
 func (cc *ConsulSource) GetRecursiveYaml(key string) (value []byte, err error) {
@@ -138,22 +194,155 @@ func (cc *ConsulSource) Put(key string, value string) (err error) {
 	return
 }
 
-func (cc *ConsulSource) PutRecursive(string, Item) error {
-	// FIXME
-	panic("implement me")
+// PutRecursive atomically replaces whatever is at key with the given tree.
+// The whole replacement must fit in a single Consul transaction, bigger
+// trees are refused and must be written as several smaller subtrees.
+func (cc *ConsulSource) PutRecursive(key string, value Item) (err error) {
+	if value == nil {
+		return errors.New("cannot put nil item")
+	}
+	requestKey := formatKey(key)
+
+	var ops api.KVTxnOps
+	if len(requestKey) == 0 {
+		// At the root we replace each top level key, but leave alone
+		// those which aren't in the new tree
+		if value.Type() != IT_Map {
+			return errors.New("only a map can be put at the root of the configuration tree")
+		}
+		for k, v := range value.Map() {
+			ops = append(ops, replaceOps(k, v)...)
+		}
+	} else {
+		ops = replaceOps(requestKey, value)
+	}
+
+	if opCount, size := txnSize(ops); opCount > consulTxnMaxOps || size > consulTxnMaxBytes {
+		return fmt.Errorf("cannot write %s atomically: the tree needs %d operations and %d bytes, " +
+			"but a Consul transaction is limited to %d operations and %d bytes, write it as smaller subtrees instead",
+			key, opCount, size, consulTxnMaxOps, consulTxnMaxBytes)
+	}
+	return cc.commitTxn(ops)
+}
+
+func (cc *ConsulSource) PutRecursiveYaml(key string, value []byte) (err error) {
+	var (
+		raw    interface{}
+		cooked Item
+	)
+	err = yaml.Unmarshal(value, &raw)
+	if err != nil {
+		return
+	}
+
+	cooked, err = intfToItem(raw)
+	if err != nil {
+		return
+	}
+
+	err = cc.PutRecursive(key, cooked)
+	return
+}
+
+// Delete removes key and everything under it in a single transaction.
+func (cc *ConsulSource) Delete(key string) (err error) {
+	requestKey := strings.TrimSuffix(formatKey(key), "/")
+	if len(requestKey) == 0 {
+		return errors.New("cannot delete the root of the configuration tree")
+	}
+	return cc.commitTxn(deleteOps(requestKey))
+}
+
+func (cc *ConsulSource) GetIndex(key string) (index uint64, err error) {
+	var kvp *api.KVPair
+	kvp, _, err = cc.kv.Get(formatKey(key), &api.QueryOptions{RequireConsistent: true})
+	if err != nil || kvp == nil {
+		return
+	}
+	return kvp.ModifyIndex, nil
+}
+
+// Txn runs ops as a single Consul transaction, which Consul rolls back if
+// any of the index checks fails.
+func (cc *ConsulSource) Txn(ops []KVTxnOp) (ok bool, err error) {
+	var kvOps api.KVTxnOps
+	for _, op := range ops {
+		key := formatKey(op.Key)
+		switch op.Verb {
+		case KVTxnSet:
+			kvOps = append(kvOps, &api.KVTxnOp{Verb: api.KVSet, Key: key, Value: []byte(op.Value)})
+		case KVTxnDelete:
+			key = strings.TrimSuffix(key, "/")
+			if len(key) == 0 {
+				return false, errors.New("cannot delete the root of the configuration tree")
+			}
+			kvOps = append(kvOps, deleteOps(key)...)
+		case KVTxnCheckIndex:
+			if op.Index == 0 {
+				kvOps = append(kvOps, &api.KVTxnOp{Verb: api.KVCheckNotExists, Key: key})
+			} else {
+				kvOps = append(kvOps, &api.KVTxnOp{Verb: api.KVCheckIndex, Key: key, Index: op.Index})
+			}
+		default:
+			return false, fmt.Errorf("unknown transaction verb %d", op.Verb)
+		}
+	}
+	if len(kvOps) > consulTxnMaxOps {
+		return false, fmt.Errorf("transaction of %d operations exceeds the Consul limit of %d", len(kvOps), consulTxnMaxOps)
+	}
+
+	txnOps := make(api.TxnOps, len(kvOps))
+	for i, op := range kvOps {
+		txnOps[i] = &api.TxnOp{KV: op}
+	}
+	ok, _, _, err = cc.txn.Txn(txnOps, nil)
+	return
 }
 
-func (cc *ConsulSource) PutRecursiveYaml(string, []byte) error {
-	// FIXME
-	panic("implement me")
+func (cc *ConsulSource) commitTxn(ops api.KVTxnOps) error {
+	txnOps := make(api.TxnOps, len(ops))
+	for i, op := range ops {
+		txnOps[i] = &api.TxnOp{KV: op}
+	}
+	ok, response, _, err := cc.txn.Txn(txnOps, nil)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		errs := make([]string, 0)
+		if response != nil {
+			for _, txnErr := range response.Errors {
+				errs = append(errs, fmt.Sprintf("op %d: %s", txnErr.OpIndex, txnErr.What))
+			}
+		}
+		return fmt.Errorf("consul transaction rolled back: %s", strings.Join(errs, "; "))
+	}
+	return nil
 }
 
 func (cc *ConsulSource) Exists(key string) (exists bool, err error) {
-	kvp, _, err := cc.kv.Get(formatKey(key), nil)
+	requestKey := formatKey(key)
+	if len(requestKey) == 0 {
+		return
+	}
+	kvp, _, err := cc.kv.Get(requestKey, nil)
 	if err != nil {
 		return
 	}
-	exists = kvp != nil
+	if kvp != nil {
+		return true, nil
+	}
+	// Maps and arrays only exist through the keys under them
+	for _, prefix := range []string{requestKey + "/", requestKey + "["} {
+		var keys []string
+		keys, _, err = cc.kv.Keys(prefix, "", nil)
+		if err != nil {
+			return
+		}
+		if len(keys) > 0 {
+			return true, nil
+		}
+	}
 	return
 }
 
@@ -169,6 +358,95 @@ func (cc *ConsulSource) IsDir(key string) (isDir bool) {
 	return
 }
 
+// Watch uses Consul blocking queries on the prefix, and sends a Change
+// whenever the modify index of any key under it moves. Only the indexes are
+// compared: a key changed if its ModifyIndex is past the index of the
+// previous query, or if it's gone.
+func (cc *ConsulSource) Watch(ctx context.Context, prefix string) (<-chan Change, error) {
+	requestKey := formatKey(prefix)
+	kvps, meta, err := cc.kv.List(requestKey, (&api.QueryOptions{}).WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan Change)
+	go func() {
+		defer close(ch)
+		known := consulKeysUnder(requestKey, kvps)
+		waitIndex := meta.LastIndex
+		for {
+			opts := (&api.QueryOptions{
+				WaitIndex: waitIndex,
+				WaitTime:  consulWatchWaitTime,
+			}).WithContext(ctx)
+			kvps, meta, err := cc.kv.List(requestKey, opts)
+			if ctx.Err() != nil {
+				return
+			}
+			if err != nil {
+				log.WithError(err).
+					WithField("prefix", prefix).
+					Warn("configuration watch failed, retrying")
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(consulWatchRetryInterval):
+				}
+				continue
+			}
+
+			// The wait timed out without any change
+			if meta.LastIndex == waitIndex {
+				continue
+			}
+			// The index can go backwards e.g. after a snapshot restore, in
+			// which case Consul says we should start over, and we can't
+			// tell what changed from the indexes
+			previousIndex := waitIndex
+			if meta.LastIndex < waitIndex {
+				waitIndex = 0
+				previousIndex = 0
+			} else {
+				waitIndex = meta.LastIndex
+			}
+
+			current := consulKeysUnder(requestKey, kvps)
+			changed := make([]string, 0)
+			for _, kvp := range kvps {
+				if _, ok := current[kvp.Key]; ok && kvp.ModifyIndex > previousIndex {
+					changed = append(changed, kvp.Key)
+				}
+			}
+			for k := range known {
+				if _, ok := current[k]; !ok {
+					changed = append(changed, k)
+				}
+			}
+			known = current
+			if len(changed) == 0 {
+				continue
+			}
+			sort.Strings(changed)
+			select {
+			case ch <- Change{Prefix: prefix, Keys: changed, Timestamp: time.Now()}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch, nil
+}
+
+func consulKeysUnder(requestKey string, kvps api.KVPairs) map[string]struct{} {
+	keys := make(map[string]struct{})
+	for _, kvp := range kvps {
+		if isUnderKey(requestKey, kvp.Key) {
+			keys[kvp.Key] = struct{}{}
+		}
+	}
+	return keys
+}
+
 func formatKey(key string) (consulKey string) {
 	// Trim leading slashes
 	consulKey = strings.TrimLeft(key, "/")
@@ -225,5 +503,88 @@ func mapify(kvps api.KVPairs) Map {
 	for prefix, kvpairslist := range prefixSet {
 		m[prefix] = mapify(kvpairslist)
 	}
+	return arrayify(m)
+}
+
+// arrayify collects the foo[0], foo[1], ... entries of m into an Array
+// under foo, see consulArrayKeyRe.
+func arrayify(m Map) Map {
+	type indexedItem struct {
+		index int
+		item  Item
+	}
+	arrays := make(map[string][]indexedItem)
+	for k, v := range m {
+		matches := consulArrayKeyRe.FindStringSubmatch(k)
+		if matches == nil {
+			continue
+		}
+		delete(m, k)
+		if _, ok := arrays[matches[1]]; !ok {
+			arrays[matches[1]] = make([]indexedItem, 0)
+		}
+		if len(matches[2]) == 0 { // foo[]/, an empty array
+			continue
+		}
+		index, _ := strconv.Atoi(matches[2])
+		arrays[matches[1]] = append(arrays[matches[1]], indexedItem{index, v})
+	}
+	for k, items := range arrays {
+		sort.Slice(items, func(i, j int) bool { return items[i].index < items[j].index })
+		a := make(Array, len(items))
+		for i, it := range items {
+			a[i] = it.item
+		}
+		m[k] = a
+	}
 	return m
 }
+
+// replaceOps returns the transaction operations which remove anything at
+// key and write value in its place.
+func replaceOps(key string, value Item) (ops api.KVTxnOps) {
+	return append(deleteOps(key), flattenItem(key, value)...)
+}
+
+// deleteOps returns the transaction operations which remove key, whether it
+// is a leaf, a map or an array.
+func deleteOps(key string) api.KVTxnOps {
+	return api.KVTxnOps{
+		&api.KVTxnOp{Verb: api.KVDelete, Key: key},
+		&api.KVTxnOp{Verb: api.KVDeleteTree, Key: key + "/"},
+		&api.KVTxnOp{Verb: api.KVDeleteTree, Key: key + "["},
+	}
+}
+
+func flattenItem(key string, value Item) (ops api.KVTxnOps) {
+	switch value.Type() {
+	case IT_Map:
+		m := value.Map()
+		if len(m) == 0 {
+			return api.KVTxnOps{&api.KVTxnOp{Verb: api.KVSet, Key: key + "/"}}
+		}
+		for k, v := range m {
+			ops = append(ops, flattenItem(key + "/" + k, v)...)
+		}
+	case IT_Array:
+		a := value.Array()
+		if len(a) == 0 {
+			return api.KVTxnOps{&api.KVTxnOp{Verb: api.KVSet, Key: key + "[]/"}}
+		}
+		for i, v := range a {
+			ops = append(ops, flattenItem(fmt.Sprintf("%s[%d]", key, i), v)...)
+		}
+	default:
+		ops = api.KVTxnOps{&api.KVTxnOp{Verb: api.KVSet, Key: key, Value: []byte(value.Value())}}
+	}
+	return
+}
+
+// txnSize returns the number of operations in ops and the size of their
+// raw keys and values.
+func txnSize(ops api.KVTxnOps) (count int, size int) {
+	for _, op := range ops {
+		size += len(op.Key) + len(op.Value)
+	}
+	return len(ops), size
+}
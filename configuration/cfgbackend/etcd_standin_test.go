@@ -0,0 +1,321 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2021 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+
+package cfgbackend_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"sync"
+)
+
+// etcdStandIn is a minimal in-process replacement for the etcd v3 JSON
+// gateway, good enough to run the configuration tests against an
+// EtcdSource without an etcd cluster.
+type etcdStandIn struct {
+	mu       sync.Mutex
+	kvs      map[string]*etcdStandInKV
+	revision int64
+	events   []etcdStandInEvent
+	notify   chan struct{}
+}
+
+type etcdStandInKV struct {
+	Key         []byte `json:"key"`
+	Value       []byte `json:"value,omitempty"`
+	ModRevision int64  `json:"mod_revision,string"`
+}
+
+type etcdStandInEvent struct {
+	Type string        `json:"type,omitempty"`
+	Kv   etcdStandInKV `json:"kv"`
+}
+
+type etcdStandInRange struct {
+	Key      []byte `json:"key"`
+	RangeEnd []byte `json:"range_end"`
+	KeysOnly bool   `json:"keys_only"`
+}
+
+type etcdStandInHeader struct {
+	Revision int64 `json:"revision,string"`
+}
+
+type etcdStandInOp struct {
+	RequestPut         *etcdStandInKV    `json:"request_put"`
+	RequestDeleteRange *etcdStandInRange `json:"request_delete_range"`
+}
+
+func newEtcdStandIn() *httptest.Server {
+	es := &etcdStandIn{
+		kvs:    make(map[string]*etcdStandInKV),
+		notify: make(chan struct{}),
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v3/kv/range", es.handleRange)
+	mux.HandleFunc("/v3/kv/put", es.handlePut)
+	mux.HandleFunc("/v3/kv/deleterange", es.handleDeleteRange)
+	mux.HandleFunc("/v3/kv/txn", es.handleTxn)
+	mux.HandleFunc("/v3/watch", es.handleWatch)
+	return httptest.NewServer(mux)
+}
+
+func (es *etcdStandIn) handleRange(w http.ResponseWriter, r *http.Request) {
+	var req etcdStandInRange
+	if !es.decode(w, r, &req) {
+		return
+	}
+	es.mu.Lock()
+	defer es.mu.Unlock()
+
+	kvs := make([]etcdStandInKV, 0)
+	for _, k := range es.keys(req) {
+		kv := *es.kvs[k]
+		if req.KeysOnly {
+			kv.Value = nil
+		}
+		kvs = append(kvs, kv)
+	}
+	es.reply(w, map[string]interface{}{"header": es.header(), "kvs": kvs, "count": strconv.Itoa(len(kvs))})
+}
+
+func (es *etcdStandIn) handlePut(w http.ResponseWriter, r *http.Request) {
+	var req etcdStandInKV
+	if !es.decode(w, r, &req) {
+		return
+	}
+	es.mu.Lock()
+	defer es.mu.Unlock()
+
+	es.revision++
+	es.put(req.Key, req.Value)
+	es.touch()
+	es.reply(w, map[string]interface{}{"header": es.header()})
+}
+
+func (es *etcdStandIn) handleDeleteRange(w http.ResponseWriter, r *http.Request) {
+	var req etcdStandInRange
+	if !es.decode(w, r, &req) {
+		return
+	}
+	es.mu.Lock()
+	defer es.mu.Unlock()
+
+	es.revision++
+	deleted := es.deleteRange(req)
+	es.touch()
+	es.reply(w, map[string]interface{}{"header": es.header(), "deleted": strconv.Itoa(deleted)})
+}
+
+func (es *etcdStandIn) handleTxn(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Compare []struct {
+			Key         []byte `json:"key"`
+			Target      string `json:"target"`
+			Result      string `json:"result"`
+			ModRevision int64  `json:"mod_revision,string"`
+		} `json:"compare"`
+		Success []etcdStandInOp `json:"success"`
+	}
+	if !es.decode(w, r, &req) {
+		return
+	}
+	es.mu.Lock()
+	defer es.mu.Unlock()
+
+	// Only mod revision equality is supported, which is all EtcdSource uses
+	for _, cmp := range req.Compare {
+		var modRevision int64
+		if kv, ok := es.kvs[string(cmp.Key)]; ok {
+			modRevision = kv.ModRevision
+		}
+		if cmp.Target != "MOD" || cmp.Result != "EQUAL" || modRevision != cmp.ModRevision {
+			es.reply(w, map[string]interface{}{"header": es.header(), "succeeded": false})
+			return
+		}
+	}
+
+	// Same limits as a real etcd server
+	if len(req.Success) > 128 {
+		es.fail(w, "etcdserver: too many operations in txn request")
+		return
+	}
+	written := make(map[string]bool)
+	for _, op := range req.Success {
+		if op.RequestPut != nil {
+			if written[string(op.RequestPut.Key)] {
+				es.fail(w, "etcdserver: duplicate key given in txn request")
+				return
+			}
+			written[string(op.RequestPut.Key)] = true
+		}
+	}
+	for _, op := range req.Success {
+		if op.RequestDeleteRange == nil {
+			continue
+		}
+		for k := range written {
+			if es.inRange(*op.RequestDeleteRange, k) {
+				es.fail(w, "etcdserver: duplicate key given in txn request")
+				return
+			}
+		}
+	}
+
+	es.revision++
+	for _, op := range req.Success {
+		if op.RequestPut != nil {
+			es.put(op.RequestPut.Key, op.RequestPut.Value)
+		} else if op.RequestDeleteRange != nil {
+			es.deleteRange(*op.RequestDeleteRange)
+		}
+	}
+	es.touch()
+	es.reply(w, map[string]interface{}{"header": es.header(), "succeeded": true})
+}
+
+// handleWatch streams the events in the requested range as newline
+// delimited JSON messages, like the gateway does.
+func (es *etcdStandIn) handleWatch(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		CreateRequest struct {
+			etcdStandInRange
+			StartRevision int64 `json:"start_revision,string"`
+		} `json:"create_request"`
+	}
+	if !es.decode(w, r, &req) {
+		return
+	}
+	flusher, _ := w.(http.Flusher)
+	w.Header().Set("Content-Type", "application/json")
+	encoder := json.NewEncoder(w)
+
+	es.mu.Lock()
+	next := req.CreateRequest.StartRevision
+	if next == 0 {
+		next = es.revision + 1
+	}
+	_ = encoder.Encode(map[string]interface{}{"result": map[string]interface{}{"header": es.header(), "created": true}})
+	for {
+		events := make([]etcdStandInEvent, 0)
+		for _, ev := range es.events {
+			if ev.Kv.ModRevision >= next && es.inRange(req.CreateRequest.etcdStandInRange, string(ev.Kv.Key)) {
+				events = append(events, ev)
+			}
+		}
+		next = es.revision + 1
+		if len(events) > 0 {
+			_ = encoder.Encode(map[string]interface{}{"result": map[string]interface{}{"header": es.header(), "events": events}})
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+		notify := es.notify
+		es.mu.Unlock()
+
+		select {
+		case <-notify:
+		case <-r.Context().Done():
+			return
+		}
+		es.mu.Lock()
+	}
+}
+
+func (es *etcdStandIn) put(key []byte, value []byte) {
+	kv := &etcdStandInKV{Key: key, Value: value, ModRevision: es.revision}
+	es.kvs[string(key)] = kv
+	es.events = append(es.events, etcdStandInEvent{Kv: *kv})
+}
+
+func (es *etcdStandIn) deleteRange(req etcdStandInRange) (deleted int) {
+	for _, k := range es.keys(req) {
+		delete(es.kvs, k)
+		es.events = append(es.events, etcdStandInEvent{Type: "DELETE", Kv: etcdStandInKV{Key: []byte(k), ModRevision: es.revision}})
+		deleted++
+	}
+	return
+}
+
+// keys returns the sorted keys in the range, following the etcd rules: no
+// range end means the key alone, and a range end of \0 means every key
+// from the key onwards.
+func (es *etcdStandIn) keys(req etcdStandInRange) []string {
+	keys := make([]string, 0)
+	for k := range es.kvs {
+		if es.inRange(req, k) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func (es *etcdStandIn) inRange(req etcdStandInRange, key string) bool {
+	switch {
+	case len(req.RangeEnd) == 0:
+		return key == string(req.Key)
+	case bytes.Equal(req.RangeEnd, []byte{0}):
+		return key >= string(req.Key)
+	}
+	return key >= string(req.Key) && key < string(req.RangeEnd)
+}
+
+// touch wakes up the watchers.
+func (es *etcdStandIn) touch() {
+	close(es.notify)
+	es.notify = make(chan struct{})
+}
+
+func (es *etcdStandIn) header() etcdStandInHeader {
+	return etcdStandInHeader{Revision: es.revision}
+}
+
+func (es *etcdStandIn) decode(w http.ResponseWriter, r *http.Request, req interface{}) bool {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return false
+	}
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return false
+	}
+	return true
+}
+
+func (es *etcdStandIn) fail(w http.ResponseWriter, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusBadRequest)
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{"error": message, "code": 3, "message": message})
+}
+
+func (es *etcdStandIn) reply(w http.ResponseWriter, payload interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(payload)
+}
@@ -0,0 +1,666 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2021 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+
+package cfgbackend
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/consul/api"
+	"gopkg.in/yaml.v3"
+)
+
+// etcd refuses transactions with more than 128 operations, or requests
+// larger than 1.5MiB, by default. Keys and values are base64 encoded by the
+// JSON gateway, so we keep their raw size within 1MiB. PutRecursive refuses
+// trees which don't fit, since several transactions together wouldn't be
+// atomic.
+const (
+	etcdTxnMaxOps          = 128
+	etcdTxnMaxBytes        = 1024*1024
+	etcdRequestTimeout     = 30*time.Second
+	etcdWatchRetryInterval = 5*time.Second
+)
+
+// EtcdSource talks to the etcd v3 API through the JSON gateway which every
+// etcd server exposes next to gRPC. Trees are stored with the same keys as
+// in Consul, see consulArrayKeyRe.
+type EtcdSource struct {
+	uri       string
+	endpoints []string
+	cli       *http.Client
+}
+
+// The JSON gateway encodes bytes as base64 and 64-bit integers as strings.
+type etcdKeyValue struct {
+	Key         []byte `json:"key"`
+	Value       []byte `json:"value,omitempty"`
+	ModRevision int64  `json:"mod_revision,string,omitempty"`
+}
+
+type etcdResponseHeader struct {
+	Revision int64 `json:"revision,string,omitempty"`
+}
+
+type etcdRangeRequest struct {
+	Key      []byte `json:"key"`
+	RangeEnd []byte `json:"range_end,omitempty"`
+	KeysOnly bool   `json:"keys_only,omitempty"`
+}
+
+type etcdRangeResponse struct {
+	Header etcdResponseHeader `json:"header"`
+	Kvs    []etcdKeyValue     `json:"kvs"`
+}
+
+type etcdPutRequest struct {
+	Key   []byte `json:"key"`
+	Value []byte `json:"value"`
+}
+
+type etcdDeleteRangeRequest struct {
+	Key      []byte `json:"key"`
+	RangeEnd []byte `json:"range_end,omitempty"`
+}
+
+type etcdRequestOp struct {
+	RequestPut         *etcdPutRequest         `json:"request_put,omitempty"`
+	RequestDeleteRange *etcdDeleteRangeRequest `json:"request_delete_range,omitempty"`
+}
+
+// etcdCompare only covers comparisons of the mod revision, which are what we
+// need for check-and-set. A missing key has mod revision 0.
+type etcdCompare struct {
+	Key         []byte `json:"key"`
+	Target      string `json:"target"`
+	Result      string `json:"result"`
+	ModRevision int64  `json:"mod_revision,string"`
+}
+
+type etcdTxnRequest struct {
+	Compare []etcdCompare   `json:"compare,omitempty"`
+	Success []etcdRequestOp `json:"success"`
+}
+
+type etcdTxnResponse struct {
+	Header    etcdResponseHeader `json:"header"`
+	Succeeded bool               `json:"succeeded"`
+}
+
+type etcdWatchCreateRequest struct {
+	Key           []byte `json:"key"`
+	RangeEnd      []byte `json:"range_end,omitempty"`
+	StartRevision int64  `json:"start_revision,string,omitempty"`
+}
+
+type etcdWatchRequest struct {
+	CreateRequest *etcdWatchCreateRequest `json:"create_request"`
+}
+
+type etcdWatchResponse struct {
+	Result *struct {
+		Header          etcdResponseHeader `json:"header"`
+		Canceled        bool               `json:"canceled"`
+		CompactRevision int64              `json:"compact_revision,string,omitempty"`
+		Events          []struct {
+			Kv etcdKeyValue `json:"kv"`
+		} `json:"events"`
+	} `json:"result"`
+	Error *etcdError `json:"error"`
+}
+
+type etcdError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// NewEtcdSource expects one or more comma separated host:port endpoints of
+// the etcd cluster, requests go to the first one which answers.
+func NewEtcdSource(uri string) (ec *EtcdSource, err error) {
+	endpoints := make([]string, 0)
+	for _, endpoint := range strings.Split(uri, ",") {
+		endpoint = strings.TrimSpace(endpoint)
+		if len(endpoint) == 0 {
+			continue
+		}
+		if !strings.HasPrefix(endpoint, "http://") && !strings.HasPrefix(endpoint, "https://") {
+			endpoint = "http://" + endpoint
+		}
+		endpoints = append(endpoints, strings.TrimSuffix(endpoint, "/"))
+	}
+	if len(endpoints) == 0 {
+		return nil, errors.New("no etcd endpoint given")
+	}
+	ec = &EtcdSource{
+		uri: uri,
+		endpoints: endpoints,
+		cli: &http.Client{},
+	}
+	return
+}
+
+// post sends a request to the first etcd endpoint which answers.
+func (ec *EtcdSource) post(ctx context.Context, method string, request interface{}) (resp *http.Response, err error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return
+	}
+	for _, endpoint := range ec.endpoints {
+		var req *http.Request
+		req, err = http.NewRequestWithContext(ctx, http.MethodPost, endpoint + "/v3/" + method, bytes.NewReader(body))
+		if err != nil {
+			return
+		}
+		req.Header.Set("Content-Type", "application/json")
+		resp, err = ec.cli.Do(req)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			continue
+		}
+		if resp.StatusCode != http.StatusOK {
+			defer resp.Body.Close()
+			payload, _ := ioutil.ReadAll(resp.Body)
+			var etcdErr etcdError
+			if json.Unmarshal(payload, &etcdErr) == nil && len(etcdErr.Message) > 0 {
+				return nil, fmt.Errorf("etcd %s failed: %s", method, etcdErr.Message)
+			}
+			return nil, fmt.Errorf("etcd %s failed: %s", method, resp.Status)
+		}
+		return
+	}
+	return
+}
+
+func (ec *EtcdSource) call(method string, request interface{}, response interface{}) error {
+	ctx, cancel := context.WithTimeout(context.Background(), etcdRequestTimeout)
+	defer cancel()
+	resp, err := ec.post(ctx, method, request)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if response == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(response)
+}
+
+// etcdPrefixRange returns the range of keys which start with prefix, the
+// empty prefix being the whole keyspace.
+func etcdPrefixRange(prefix string) (key []byte, rangeEnd []byte) {
+	if len(prefix) == 0 {
+		return []byte{0}, []byte{0}
+	}
+	key = []byte(prefix)
+	rangeEnd = make([]byte, len(key))
+	copy(rangeEnd, key)
+	for i := len(rangeEnd) - 1; i >= 0; i-- {
+		if rangeEnd[i] < 0xff {
+			rangeEnd[i]++
+			return key, rangeEnd[:i+1]
+		}
+	}
+	return key, []byte{0}
+}
+
+// list returns the keys which start with prefix as Consul KV pairs, so that
+// the tree building code can be shared with ConsulSource.
+func (ec *EtcdSource) list(prefix string, keysOnly bool) (kvps api.KVPairs, err error) {
+	key, rangeEnd := etcdPrefixRange(prefix)
+	var response etcdRangeResponse
+	err = ec.call("kv/range", &etcdRangeRequest{Key: key, RangeEnd: rangeEnd, KeysOnly: keysOnly}, &response)
+	if err != nil {
+		return
+	}
+	kvps = make(api.KVPairs, len(response.Kvs))
+	for i, kv := range response.Kvs {
+		kvps[i] = &api.KVPair{Key: string(kv.Key), Value: kv.Value, ModifyIndex: uint64(kv.ModRevision)}
+	}
+	return
+}
+
+// get returns the pair at exactly key, or nil.
+func (ec *EtcdSource) get(key string) (kvp *api.KVPair, err error) {
+	var response etcdRangeResponse
+	err = ec.call("kv/range", &etcdRangeRequest{Key: []byte(key)}, &response)
+	if err != nil || len(response.Kvs) == 0 {
+		return
+	}
+	kv := response.Kvs[0]
+	return &api.KVPair{Key: string(kv.Key), Value: kv.Value, ModifyIndex: uint64(kv.ModRevision)}, nil
+}
+
+// GetNextUInt32 increments the counter at key with a transaction which only
+// goes through if nobody else wrote the key since we read it.
+func (ec *EtcdSource) GetNextUInt32(key string) (value uint32, err error) {
+	etcdKey := formatKey(key)
+	kvp, err := ec.get(etcdKey)
+	if err != nil {
+		return
+	}
+	var (
+		value64     uint64
+		modRevision int64
+	)
+	if kvp != nil {
+		value64, err = strconv.ParseUint(string(kvp.Value), 10, 32)
+		if err != nil {
+			return
+		}
+		modRevision = int64(kvp.ModifyIndex)
+	}
+	value = uint32(value64)
+	value++
+
+	var response etcdTxnResponse
+	err = ec.call("kv/txn", &etcdTxnRequest{
+		Compare: []etcdCompare{{Key: []byte(etcdKey), Target: "MOD", Result: "EQUAL", ModRevision: modRevision}},
+		Success: []etcdRequestOp{{RequestPut: &etcdPutRequest{Key: []byte(etcdKey), Value: []byte(strconv.FormatUint(uint64(value), 10))}}},
+	}, &response)
+	if err != nil {
+		return
+	}
+	if !response.Succeeded {
+		err = errors.New("cannot write back incremented CAS key")
+	}
+	return
+}
+
+func (ec *EtcdSource) Get(key string) (value string, err error) {
+	kvp, err := ec.get(formatKey(key))
+	if err != nil {
+		return
+	}
+	if kvp == nil {
+		return "", fmt.Errorf("nil response for key %s", key)
+	}
+	return string(kvp.Value), nil
+}
+
+// GetKeysByPrefix lists the keys under keyPrefix. etcd has no folders, so we
+// add those which Consul would list, e.g. o2/components/readout/, to keep
+// the callers backend agnostic.
+func (ec *EtcdSource) GetKeysByPrefix(keyPrefix string)(keys []string, err error) {
+	if len(keyPrefix) > 0 {
+		keyPrefix = strings.TrimSuffix(formatKey(keyPrefix), "/") + "/"
+	}
+	kvps, err := ec.list(keyPrefix, true)
+	if err != nil {
+		return
+	}
+	keySet := make(map[string]struct{})
+	for _, kvp := range kvps {
+		keySet[kvp.Key] = struct{}{}
+		for i := len(keyPrefix); i < len(kvp.Key) - 1; i++ {
+			if kvp.Key[i] == '/' {
+				keySet[kvp.Key[:i+1]] = struct{}{}
+			}
+		}
+	}
+	keys = make([]string, 0, len(keySet))
+	for k := range keySet {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return
+}
+
+func (ec *EtcdSource) GetRecursive(key string) (value Item, err error) {
+	requestKey := formatKey(key)
+	kvps, err := ec.list(requestKey, false)
+	if err != nil {
+		return
+	}
+	return itemFromKVPairs(requestKey, kvps), nil
+}
+
+func (ec *EtcdSource) GetRecursiveYaml(key string) (value []byte, err error) {
+	var item Item
+	item, err = ec.GetRecursive(key)
+	if err != nil {
+		return
+	}
+	value, err = yaml.Marshal(item)
+	return
+}
+
+func (ec *EtcdSource) Put(key string, value string) (err error) {
+	return ec.call("kv/put", &etcdPutRequest{Key: []byte(formatKey(key)), Value: []byte(value)}, nil)
+}
+
+// PutRecursive replaces whatever is at key with the given tree. etcd refuses
+// a transaction which deletes and writes the same key, so rather than
+// deleting the subtree first we only delete the keys the new tree doesn't
+// have. As with Consul, the replacement is atomic, and trees which don't fit
+// in a single transaction are refused.
+func (ec *EtcdSource) PutRecursive(key string, value Item) (err error) {
+	if value == nil {
+		return errors.New("cannot put nil item")
+	}
+	requestKey := formatKey(key)
+
+	var (
+		writes   api.KVTxnOps
+		existing api.KVPairs
+	)
+	if len(requestKey) == 0 {
+		// At the root we replace each top level key, but leave alone
+		// those which aren't in the new tree
+		if value.Type() != IT_Map {
+			return errors.New("only a map can be put at the root of the configuration tree")
+		}
+		for k, v := range value.Map() {
+			writes = append(writes, flattenItem(k, v)...)
+			var kvps api.KVPairs
+			kvps, err = ec.subtree(k)
+			if err != nil {
+				return
+			}
+			existing = append(existing, kvps...)
+		}
+	} else {
+		writes = flattenItem(requestKey, value)
+		existing, err = ec.subtree(requestKey)
+		if err != nil {
+			return
+		}
+	}
+
+	written := make(map[string]bool)
+	for _, op := range writes {
+		written[op.Key] = true
+	}
+	ops := make([]etcdRequestOp, 0, len(writes) + len(existing))
+	for _, kvp := range existing {
+		if !written[kvp.Key] {
+			ops = append(ops, etcdRequestOp{RequestDeleteRange: &etcdDeleteRangeRequest{Key: []byte(kvp.Key)}})
+		}
+	}
+	for _, op := range writes {
+		ops = append(ops, etcdRequestOp{RequestPut: &etcdPutRequest{Key: []byte(op.Key), Value: op.Value}})
+	}
+
+	if opCount, size := etcdTxnSize(ops); opCount > etcdTxnMaxOps || size > etcdTxnMaxBytes {
+		return fmt.Errorf("cannot write %s atomically: the tree needs %d operations and %d bytes, " +
+			"but an etcd transaction is limited to %d operations and %d bytes, write it as smaller subtrees instead",
+			key, opCount, size, etcdTxnMaxOps, etcdTxnMaxBytes)
+	}
+	return ec.commitTxn(ops)
+}
+
+// subtree returns the pairs at and under requestKey, without siblings which
+// merely start with the same characters.
+func (ec *EtcdSource) subtree(requestKey string) (kvps api.KVPairs, err error) {
+	all, err := ec.list(requestKey, false)
+	if err != nil {
+		return
+	}
+	kvps = make(api.KVPairs, 0, len(all))
+	for _, kvp := range all {
+		if isUnderKey(requestKey, kvp.Key) {
+			kvps = append(kvps, kvp)
+		}
+	}
+	return
+}
+
+// Delete removes key and everything under it in a single transaction.
+func (ec *EtcdSource) Delete(key string) (err error) {
+	requestKey := strings.TrimSuffix(formatKey(key), "/")
+	if len(requestKey) == 0 {
+		return errors.New("cannot delete the root of the configuration tree")
+	}
+	return ec.commitTxn(etcdDeleteOps(requestKey))
+}
+
+// etcdDeleteOps deletes key and everything under it.
+func etcdDeleteOps(key string) []etcdRequestOp {
+	ops := []etcdRequestOp{{RequestDeleteRange: &etcdDeleteRangeRequest{Key: []byte(key)}}}
+	for _, prefix := range []string{key + "/", key + "["} {
+		rangeKey, rangeEnd := etcdPrefixRange(prefix)
+		ops = append(ops, etcdRequestOp{RequestDeleteRange: &etcdDeleteRangeRequest{Key: rangeKey, RangeEnd: rangeEnd}})
+	}
+	return ops
+}
+
+func (ec *EtcdSource) GetIndex(key string) (index uint64, err error) {
+	kvp, err := ec.get(formatKey(key))
+	if err != nil || kvp == nil {
+		return
+	}
+	return kvp.ModifyIndex, nil
+}
+
+// Txn runs ops as a single etcd transaction, with the index checks as its
+// compare clause.
+func (ec *EtcdSource) Txn(ops []KVTxnOp) (ok bool, err error) {
+	var request etcdTxnRequest
+	for _, op := range ops {
+		key := formatKey(op.Key)
+		switch op.Verb {
+		case KVTxnSet:
+			request.Success = append(request.Success, etcdRequestOp{RequestPut: &etcdPutRequest{Key: []byte(key), Value: []byte(op.Value)}})
+		case KVTxnDelete:
+			key = strings.TrimSuffix(key, "/")
+			if len(key) == 0 {
+				return false, errors.New("cannot delete the root of the configuration tree")
+			}
+			request.Success = append(request.Success, etcdDeleteOps(key)...)
+		case KVTxnCheckIndex:
+			request.Compare = append(request.Compare, etcdCompare{Key: []byte(key), Target: "MOD", Result: "EQUAL", ModRevision: int64(op.Index)})
+		default:
+			return false, fmt.Errorf("unknown transaction verb %d", op.Verb)
+		}
+	}
+	if len(request.Success) > etcdTxnMaxOps {
+		return false, fmt.Errorf("transaction of %d operations exceeds the etcd limit of %d", len(request.Success), etcdTxnMaxOps)
+	}
+
+	var response etcdTxnResponse
+	err = ec.call("kv/txn", &request, &response)
+	if err != nil {
+		return
+	}
+	return response.Succeeded, nil
+}
+
+func (ec *EtcdSource) commitTxn(ops []etcdRequestOp) error {
+	return ec.call("kv/txn", &etcdTxnRequest{Success: ops}, nil)
+}
+
+// etcdTxnSize returns the number of operations in ops and the size of their
+// raw keys and values.
+func etcdTxnSize(ops []etcdRequestOp) (count int, size int) {
+	for _, op := range ops {
+		if op.RequestPut != nil {
+			size += len(op.RequestPut.Key) + len(op.RequestPut.Value)
+		}
+		if op.RequestDeleteRange != nil {
+			size += len(op.RequestDeleteRange.Key) + len(op.RequestDeleteRange.RangeEnd)
+		}
+	}
+	return len(ops), size
+}
+
+func (ec *EtcdSource) PutRecursiveYaml(key string, value []byte) (err error) {
+	var (
+		raw    interface{}
+		cooked Item
+	)
+	err = yaml.Unmarshal(value, &raw)
+	if err != nil {
+		return
+	}
+
+	cooked, err = intfToItem(raw)
+	if err != nil {
+		return
+	}
+
+	err = ec.PutRecursive(key, cooked)
+	return
+}
+
+func (ec *EtcdSource) Exists(key string) (exists bool, err error) {
+	requestKey := formatKey(key)
+	if len(requestKey) == 0 {
+		return
+	}
+	kvps, err := ec.list(requestKey, true)
+	if err != nil {
+		return
+	}
+	for _, kvp := range kvps {
+		if isUnderKey(requestKey, kvp.Key) {
+			return true, nil
+		}
+	}
+	return
+}
+
+func (ec *EtcdSource) IsDir(key string) (isDir bool) {
+	kvp, err := ec.get(formatKey(key))
+	if err != nil {
+		return false
+	}
+	if kvp == nil {
+		return true
+	}
+	return strings.HasSuffix(kvp.Key, "/")
+}
+
+// Watch streams etcd watch events on the prefix, starting right after the
+// revision at which it was called.
+func (ec *EtcdSource) Watch(ctx context.Context, prefix string) (<-chan Change, error) {
+	requestKey := formatKey(prefix)
+	key, rangeEnd := etcdPrefixRange(requestKey)
+	var response etcdRangeResponse
+	err := ec.call("kv/range", &etcdRangeRequest{Key: key, RangeEnd: rangeEnd, KeysOnly: true}, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan Change)
+	go func() {
+		defer close(ch)
+		nextRevision := response.Header.Revision + 1
+		for {
+			err := ec.watch(ctx, requestKey, &nextRevision, func(keys []string) bool {
+				select {
+				case ch <- Change{Prefix: prefix, Keys: keys, Timestamp: time.Now()}:
+					return true
+				case <-ctx.Done():
+					return false
+				}
+			})
+			if ctx.Err() != nil {
+				return
+			}
+			log.WithError(err).
+				WithField("prefix", prefix).
+				Warn("configuration watch failed, retrying")
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(etcdWatchRetryInterval):
+			}
+		}
+	}()
+	return ch, nil
+}
+
+// watch follows a single watch stream until it breaks, and moves
+// nextRevision along so that the next stream resumes where it left off.
+func (ec *EtcdSource) watch(ctx context.Context, requestKey string, nextRevision *int64, send func([]string) bool) error {
+	key, rangeEnd := etcdPrefixRange(requestKey)
+	resp, err := ec.post(ctx, "watch", &etcdWatchRequest{CreateRequest: &etcdWatchCreateRequest{
+		Key:           key,
+		RangeEnd:      rangeEnd,
+		StartRevision: *nextRevision,
+	}})
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	decoder := json.NewDecoder(bufio.NewReader(resp.Body))
+	for {
+		var message etcdWatchResponse
+		if err = decoder.Decode(&message); err != nil {
+			return err
+		}
+		if message.Error != nil {
+			return errors.New(message.Error.Message)
+		}
+		result := message.Result
+		if result == nil {
+			continue
+		}
+		if result.CompactRevision > 0 {
+			// The revisions we missed are gone, we resume from the oldest
+			// one etcd still has
+			log.WithField("compactRevision", result.CompactRevision).
+				Warn("configuration watch fell behind etcd compaction")
+			*nextRevision = result.CompactRevision
+		}
+		if result.Canceled {
+			return errors.New("etcd watch canceled")
+		}
+
+		keySet := make(map[string]struct{})
+		for _, event := range result.Events {
+			if event.Kv.ModRevision >= *nextRevision {
+				*nextRevision = event.Kv.ModRevision + 1
+			}
+			if k := string(event.Kv.Key); isUnderKey(requestKey, k) {
+				keySet[k] = struct{}{}
+			}
+		}
+		if len(keySet) == 0 {
+			continue
+		}
+		keys := make([]string, 0, len(keySet))
+		for k := range keySet {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		if !send(keys) {
+			return ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,639 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2021 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+
+package cfgbackend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	gitFileExt            = ".yaml"
+	gitDefaultAuthorName  = "AliECS"
+	gitDefaultAuthorEmail = "aliecs@localhost"
+	gitWatchPollInterval  = time.Second
+)
+
+// GitSource keeps the configuration tree in the YAML files of a git working
+// copy, and commits every change it makes, so that the history of the
+// repository is the history of the configuration.
+// Directories are maps, and a file <path>.yaml holds the item at key <path>,
+// e.g. o2/components/readout.yaml holds o2/components/readout. Hidden files
+// and files without the .yaml extension are ignored.
+type GitSource struct {
+	uri  string
+	path string
+	repo *git.Repository
+	mu   sync.RWMutex
+}
+
+// gitKeySegment is one step of a key, e.g. tasks[1] is {"tasks", 1}, while
+// segments without an array index have index -1.
+type gitKeySegment struct {
+	name  string
+	index int
+}
+
+func newGitSource(uri string) (gc *GitSource, err error) {
+	repoPath := strings.TrimPrefix(uri, "git://")
+	repo, err := git.PlainOpen(repoPath)
+	if err != nil {
+		return nil, fmt.Errorf("cannot open git repository %s: %w", repoPath, err)
+	}
+	gc = &GitSource{
+		uri: uri,
+		path: repoPath,
+		repo: repo,
+	}
+	return
+}
+
+func parseGitKey(key string) (segments []gitKeySegment, err error) {
+	requestKey := strings.Trim(formatKey(key), "/")
+	if len(requestKey) == 0 {
+		return []gitKeySegment{}, nil
+	}
+	for _, part := range strings.Split(requestKey, "/") {
+		segment := gitKeySegment{name: part, index: -1}
+		if matches := consulArrayKeyRe.FindStringSubmatch(part); matches != nil {
+			segment.name = matches[1]
+			segment.index, err = strconv.Atoi(matches[2])
+			if err != nil {
+				return nil, fmt.Errorf("bad array index in key %s", key)
+			}
+		}
+		if len(segment.name) == 0 || strings.HasPrefix(segment.name, ".") {
+			return nil, fmt.Errorf("bad key %s", key)
+		}
+		segments = append(segments, segment)
+	}
+	return
+}
+
+// readTree builds the whole configuration tree from the working copy.
+func (gc *GitSource) readTree() (tree Map, err error) {
+	return gc.readDir("")
+}
+
+func (gc *GitSource) readDir(dir string) (m Map, err error) {
+	entries, err := ioutil.ReadDir(filepath.Join(gc.path, dir))
+	if err != nil {
+		return
+	}
+	m = make(Map)
+	for _, entry := range entries {
+		name := entry.Name()
+		if strings.HasPrefix(name, ".") {
+			continue
+		}
+		var (
+			key  string
+			item Item
+		)
+		if entry.IsDir() {
+			key = name
+			item, err = gc.readDir(path.Join(dir, name))
+		} else if strings.HasSuffix(name, gitFileExt) {
+			key = strings.TrimSuffix(name, gitFileExt)
+			item, err = gc.readFile(path.Join(dir, name))
+		} else {
+			continue
+		}
+		if err != nil {
+			return
+		}
+		if existing, ok := m[key]; ok {
+			// Both foo.yaml and foo/ are there, so they must be maps
+			// without keys in common
+			if existing.Type() != IT_Map || item.Type() != IT_Map {
+				return nil, fmt.Errorf("key %s is defined both in %s%s and %s/", path.Join(dir, key), key, gitFileExt, key)
+			}
+			for k, v := range item.Map() {
+				if _, dup := existing.Map()[k]; dup {
+					return nil, fmt.Errorf("key %s is defined both in %s%s and %s/", path.Join(dir, key, k), key, gitFileExt, key)
+				}
+				existing.Map()[k] = v
+			}
+			continue
+		}
+		m[key] = item
+	}
+	return
+}
+
+func (gc *GitSource) readFile(file string) (item Item, err error) {
+	data, err := ioutil.ReadFile(filepath.Join(gc.path, file))
+	if err != nil {
+		return
+	}
+	var raw interface{}
+	err = yaml.Unmarshal(data, &raw)
+	if err != nil {
+		return nil, fmt.Errorf("bad configuration file %s: %w", file, err)
+	}
+	if raw == nil {
+		return make(Map), nil
+	}
+	return intfToItem(raw)
+}
+
+func (gc *GitSource) writeFile(file string, item Item) (err error) {
+	data, err := yaml.Marshal(item)
+	if err != nil {
+		return
+	}
+	fullPath := filepath.Join(gc.path, file)
+	err = os.MkdirAll(filepath.Dir(fullPath), 0755)
+	if err != nil {
+		return
+	}
+	return ioutil.WriteFile(fullPath, data, 0644)
+}
+
+func (gc *GitSource) isDir(dir string) bool {
+	info, err := os.Stat(filepath.Join(gc.path, dir))
+	return err == nil && info.IsDir()
+}
+
+func (gc *GitSource) isFile(file string) bool {
+	info, err := os.Stat(filepath.Join(gc.path, file))
+	return err == nil && !info.IsDir()
+}
+
+// lookupItem follows segments down the tree.
+func lookupItem(root Item, segments []gitKeySegment) (item Item, ok bool) {
+	item = root
+	for _, segment := range segments {
+		if item == nil || item.Type() != IT_Map {
+			return nil, false
+		}
+		item = item.Map()[segment.name]
+		if segment.index >= 0 {
+			if item == nil || item.Type() != IT_Array || segment.index >= len(item.Array()) {
+				return nil, false
+			}
+			item = item.Array()[segment.index]
+		}
+	}
+	return item, item != nil
+}
+
+// setItem writes value at segments under m, creating the missing maps along
+// the way. Arrays are never extended, an index must already exist.
+func setItem(m Map, segments []gitKeySegment, value Item) error {
+	segment := segments[0]
+	last := len(segments) == 1
+	if segment.index < 0 {
+		if last {
+			m[segment.name] = value
+			return nil
+		}
+		child := m[segment.name]
+		if child == nil {
+			child = make(Map)
+			m[segment.name] = child
+		}
+		if child.Type() != IT_Map {
+			return fmt.Errorf("cannot set a key under %s, which is not a map", segment.name)
+		}
+		return setItem(child.Map(), segments[1:], value)
+	}
+
+	child := m[segment.name]
+	if child == nil || child.Type() != IT_Array || segment.index >= len(child.Array()) {
+		return fmt.Errorf("no array item %s[%d]", segment.name, segment.index)
+	}
+	a := child.Array()
+	if last {
+		a[segment.index] = value
+		return nil
+	}
+	if a[segment.index] == nil || a[segment.index].Type() != IT_Map {
+		return fmt.Errorf("cannot set a key under %s[%d], which is not a map", segment.name, segment.index)
+	}
+	return setItem(a[segment.index].Map(), segments[1:], value)
+}
+
+func (gc *GitSource) Get(key string) (value string, err error) {
+	item, err := gc.GetRecursive(key)
+	if err != nil {
+		return
+	}
+	if item.Type() != IT_Value {
+		return "", fmt.Errorf("key %s is not a value", key)
+	}
+	return item.Value(), nil
+}
+
+func (gc *GitSource) GetKeysByPrefix(keyPrefix string)(keys []string, err error) {
+	if len(keyPrefix) > 0 {
+		keyPrefix = strings.TrimSuffix(formatKey(keyPrefix), "/") + "/"
+	}
+	gc.mu.RLock()
+	tree, err := gc.readTree()
+	gc.mu.RUnlock()
+	if err != nil {
+		return
+	}
+
+	// The same listing as Consul would give, i.e. the leaves and the
+	// folders under keyPrefix
+	leaves := make(map[string]string)
+	flatten("", tree, leaves)
+	folders := make(map[string]struct{})
+	gitFolders("", tree, folders)
+	keys = make([]string, 0)
+	for k := range leaves {
+		if strings.HasPrefix(k, keyPrefix) {
+			keys = append(keys, k)
+		}
+	}
+	for k := range folders {
+		if strings.HasPrefix(k, keyPrefix) && k != keyPrefix {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return
+}
+
+// gitFolders collects the keys of the maps in the tree, spelled as Consul
+// folders, e.g. o2/components/.
+func gitFolders(key string, item Item, folders map[string]struct{}) {
+	switch item.Type() {
+	case IT_Map:
+		if len(key) > 0 {
+			folders[key + "/"] = struct{}{}
+		}
+		for k, v := range item.Map() {
+			if len(key) == 0 {
+				gitFolders(k, v, folders)
+			} else {
+				gitFolders(key + "/" + k, v, folders)
+			}
+		}
+	case IT_Array:
+		for i, v := range item.Array() {
+			gitFolders(fmt.Sprintf("%s[%d]", key, i), v, folders)
+		}
+	}
+}
+
+func (gc *GitSource) GetRecursive(key string) (value Item, err error) {
+	segments, err := parseGitKey(key)
+	if err != nil {
+		return
+	}
+	gc.mu.RLock()
+	tree, err := gc.readTree()
+	gc.mu.RUnlock()
+	if err != nil {
+		return
+	}
+	item, ok := lookupItem(tree, segments)
+	if !ok {
+		return nil, fmt.Errorf("no value for key %s", key)
+	}
+	return item, nil
+}
+
+func (gc *GitSource) GetRecursiveYaml(key string) (value []byte, err error) {
+	var item Item
+	item, err = gc.GetRecursive(key)
+	if err != nil {
+		return
+	}
+	value, err = yaml.Marshal(item)
+	return
+}
+
+func (gc *GitSource) Exists(key string) (exists bool, err error) {
+	segments, err := parseGitKey(key)
+	if err != nil || len(segments) == 0 {
+		return false, err
+	}
+	gc.mu.RLock()
+	tree, err := gc.readTree()
+	gc.mu.RUnlock()
+	if err != nil {
+		return
+	}
+	_, exists = lookupItem(tree, segments)
+	return
+}
+
+func (gc *GitSource) IsDir(key string) (isDir bool) {
+	item, err := gc.GetRecursive(key)
+	if err != nil {
+		return false
+	}
+	return item.Type() == IT_Map
+}
+
+func (gc *GitSource) Put(key string, value string) (err error) {
+	return gc.PutRecursive(key, String(value))
+}
+
+// PutRecursive replaces whatever is at key with the given tree, and commits
+// the result. A key goes into the file named after its first segment which
+// isn't a directory, new keys getting directories down to their last
+// segment, while a map put at a directory is spread over its files.
+func (gc *GitSource) PutRecursive(key string, value Item) (err error) {
+	if value == nil {
+		return errors.New("cannot put nil item")
+	}
+	segments, err := parseGitKey(key)
+	if err != nil {
+		return
+	}
+	value = value.DeepCopy()
+
+	gc.mu.Lock()
+	defer gc.mu.Unlock()
+
+	if len(segments) == 0 {
+		// At the root we replace each top level key, but leave alone
+		// those which aren't in the new tree
+		if value.Type() != IT_Map {
+			return errors.New("only a map can be put at the root of the configuration tree")
+		}
+		err = gc.putDir("", value.Map(), true)
+		if err != nil {
+			return
+		}
+		return gc.commit("Update configuration root", ".")
+	}
+
+	dir := ""
+	for i, segment := range segments {
+		next := path.Join(dir, segment.name)
+		last := i == len(segments) - 1
+		// New keys get a directory for each map along the way
+		newDir := !last && segment.index < 0 && !gc.isDir(next) && !gc.isFile(next + gitFileExt)
+		if newDir || (segment.index < 0 && gc.isDir(next) && !gc.isFile(next + gitFileExt)) {
+			if !last {
+				dir = next
+				continue
+			}
+			// The key is a directory
+			if value.Type() == IT_Map {
+				err = gc.putDir(next, value.Map(), false)
+			} else {
+				err = os.RemoveAll(filepath.Join(gc.path, next))
+				if err == nil {
+					err = gc.writeFile(next + gitFileExt, value)
+				}
+			}
+			if err != nil {
+				return
+			}
+			return gc.commit(fmt.Sprintf("Update %s", formatKey(key)), next, next + gitFileExt)
+		}
+
+		// The key is in the file named after this segment, which we
+		// may have to create
+		file := next + gitFileExt
+		holder := make(Map)
+		if gc.isFile(file) {
+			holder[segment.name], err = gc.readFile(file)
+			if err != nil {
+				return
+			}
+		}
+		err = setItem(holder, segments[i:], value)
+		if err != nil {
+			return fmt.Errorf("cannot put key %s: %w", key, err)
+		}
+		err = gc.writeFile(file, holder[segment.name])
+		if err != nil {
+			return
+		}
+		return gc.commit(fmt.Sprintf("Update %s", formatKey(key)), file)
+	}
+	return
+}
+
+// putDir writes m into dir, one file or directory per key. Unless keepOthers
+// is set, whatever else is in dir is removed.
+func (gc *GitSource) putDir(dir string, m Map, keepOthers bool) (err error) {
+	err = os.MkdirAll(filepath.Join(gc.path, dir), 0755)
+	if err != nil {
+		return
+	}
+	if !keepOthers {
+		var entries []os.FileInfo
+		entries, err = ioutil.ReadDir(filepath.Join(gc.path, dir))
+		if err != nil {
+			return
+		}
+		for _, entry := range entries {
+			name := entry.Name()
+			if strings.HasPrefix(name, ".") ||
+				(!entry.IsDir() && !strings.HasSuffix(name, gitFileExt)) {
+				continue
+			}
+			if _, ok := m[strings.TrimSuffix(name, gitFileExt)]; ok {
+				continue // rewritten below
+			}
+			err = os.RemoveAll(filepath.Join(gc.path, dir, name))
+			if err != nil {
+				return
+			}
+		}
+	}
+	for k, v := range m {
+		sub := path.Join(dir, k)
+		if v.Type() == IT_Map && gc.isDir(sub) {
+			if gc.isFile(sub + gitFileExt) {
+				err = os.Remove(filepath.Join(gc.path, sub + gitFileExt))
+				if err != nil {
+					return
+				}
+			}
+			err = gc.putDir(sub, v.Map(), false)
+		} else {
+			err = os.RemoveAll(filepath.Join(gc.path, sub))
+			if err == nil {
+				err = gc.writeFile(sub + gitFileExt, v)
+			}
+		}
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+// commit stages what changed at the given paths of the working copy, and
+// commits it unless there's nothing to commit.
+func (gc *GitSource) commit(message string, paths ...string) (err error) {
+	wt, err := gc.repo.Worktree()
+	if err != nil {
+		return
+	}
+	status, err := wt.Status()
+	if err != nil {
+		return
+	}
+	staged := false
+	for file, fileStatus := range status {
+		if !gitPathUnder(file, paths) || fileStatus.Worktree == git.Unmodified {
+			continue
+		}
+		if fileStatus.Worktree == git.Deleted {
+			_, err = wt.Remove(file)
+		} else {
+			_, err = wt.Add(file)
+		}
+		if err != nil {
+			return fmt.Errorf("cannot stage %s: %w", file, err)
+		}
+		staged = true
+	}
+	if !staged {
+		return
+	}
+	_, err = wt.Commit(message, &git.CommitOptions{Author: gc.signature()})
+	return
+}
+
+func gitPathUnder(file string, paths []string) bool {
+	for _, p := range paths {
+		if p == "." || file == p || strings.HasPrefix(file, p + "/") {
+			return true
+		}
+	}
+	return false
+}
+
+// signature is the git user of the repository, or a default one if none is
+// configured.
+func (gc *GitSource) signature() *object.Signature {
+	signature := &object.Signature{
+		Name:  gitDefaultAuthorName,
+		Email: gitDefaultAuthorEmail,
+		When:  time.Now(),
+	}
+	cfg, err := gc.repo.ConfigScoped(config.SystemScope)
+	if err == nil && len(cfg.User.Name) > 0 && len(cfg.User.Email) > 0 {
+		signature.Name = cfg.User.Name
+		signature.Email = cfg.User.Email
+	}
+	return signature
+}
+
+func (gc *GitSource) PutRecursiveYaml(key string, value []byte) (err error) {
+	var (
+		raw    interface{}
+		cooked Item
+	)
+	err = yaml.Unmarshal(value, &raw)
+	if err != nil {
+		return
+	}
+
+	cooked, err = intfToItem(raw)
+	if err != nil {
+		return
+	}
+
+	err = gc.PutRecursive(key, cooked)
+	return
+}
+
+// Watch polls the working copy and compares the leaves under prefix, so it
+// sees commits pulled in from elsewhere as well as our own.
+func (gc *GitSource) Watch(ctx context.Context, prefix string) (<-chan Change, error) {
+	known, err := gc.leavesUnder(prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan Change)
+	go func() {
+		defer close(ch)
+		ticker := time.NewTicker(gitWatchPollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			current, err := gc.leavesUnder(prefix)
+			if err != nil {
+				// Most likely a file being edited, we'll try again
+				continue
+			}
+			changed := diffLeaves(known, current)
+			known = current
+			if len(changed) == 0 {
+				continue
+			}
+			select {
+			case ch <- Change{Prefix: prefix, Keys: changed, Timestamp: time.Now()}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch, nil
+}
+
+func (gc *GitSource) leavesUnder(prefix string) (leaves map[string]string, err error) {
+	gc.mu.RLock()
+	tree, err := gc.readTree()
+	gc.mu.RUnlock()
+	if err != nil {
+		return
+	}
+	all := make(map[string]string)
+	flatten("", tree, all)
+
+	requestKey := formatKey(prefix)
+	leaves = make(map[string]string)
+	for k, v := range all {
+		if isUnderKey(requestKey, k) {
+			leaves[k] = v
+		}
+	}
+	return
+}
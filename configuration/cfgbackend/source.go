@@ -24,10 +24,11 @@
 
 // Package configuration defines the Source interface as the
 // main access point to O² Configuration backends.
-// Consul and YAML backends are also provided.
+// Consul, etcd, Git and YAML backends are also provided.
 package cfgbackend
 
 import (
+	"context"
 	"errors"
 	"strings"
 )
@@ -39,6 +40,9 @@ type ROSource interface {
 	GetRecursiveYaml(string) ([]byte, error)
 	Exists(string) (bool, error)
 	IsDir(string) bool
+	// Watch notifies of changes under a prefix until the context is
+	// cancelled, at which point the channel is closed.
+	Watch(context.Context, string) (<-chan Change, error)
 }
 
 type Source interface {
@@ -48,10 +52,51 @@ type Source interface {
 	PutRecursiveYaml(string, []byte) error
 }
 
+// KVSource is a Source which can also hold runtime data such as run numbers
+// and the inventory, i.e. Consul and etcd.
+type KVSource interface {
+	Source
+	// Delete removes a key and everything under it.
+	Delete(string) error
+	// GetNextUInt32 increments the counter at key, creating it if needed,
+	// and returns the new value. It fails rather than return the same value
+	// twice if the counter is incremented concurrently.
+	GetNextUInt32(string) (uint32, error)
+	// GetIndex returns the modify index of a key, 0 if it doesn't exist.
+	GetIndex(string) (uint64, error)
+	// Txn applies all ops in a single transaction. If any KVTxnCheckIndex
+	// fails, nothing is written and ok is false.
+	Txn([]KVTxnOp) (ok bool, err error)
+}
+
+type KVTxnVerb int
+
+const (
+	KVTxnSet        KVTxnVerb = iota
+	KVTxnDelete               // a key and everything under it, like Delete
+	KVTxnCheckIndex           // Key must have modify index Index, 0 means it must not exist
+)
+
+// KVTxnOp is an operation in a KVSource transaction. Checking the index of
+// a key read earlier and writing it back turns a read-modify-write into a
+// check-and-set.
+type KVTxnOp struct {
+	Verb  KVTxnVerb
+	Key   string
+	Value string
+	Index uint64
+}
+
 func NewSource(uri string) (configuration Source, err error) {
 	if strings.HasPrefix(uri, "consul://") {
 		configuration, err = NewConsulSource(strings.TrimPrefix(uri, "consul://"))
 		return
+	} else if strings.HasPrefix(uri, "etcd://") {
+		configuration, err = NewEtcdSource(strings.TrimPrefix(uri, "etcd://"))
+		return
+	} else if strings.HasPrefix(uri, "git://") {
+		configuration, err = newGitSource(uri)
+		return
 	} else if strings.HasPrefix(uri, "file://") &&
 		(strings.HasSuffix(uri, ".yaml") || strings.HasSuffix(uri, ".json")) {
 		configuration, err = newYamlSource(uri)
@@ -1,7 +1,12 @@
 package cfgbackend_test
 
 import (
+	"context"
+	"strconv"
+	"strings"
+
 	"github.com/AliceO2Group/Control/configuration/cfgbackend"
+	"github.com/go-git/go-git/v5"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"gopkg.in/yaml.v3"
@@ -205,12 +210,110 @@ var _ = Describe("Source", func() {
 				Expect(c.GetRecursive("o2/control/tasks[0]/wants")).To(BeEquivalentTo(recursivePutString))
 			})
 		})
+
+		Context("to watch a subtree", func() {
+			It("should notify of a changed value", func() {
+				ctx, cancel := context.WithCancel(context.Background())
+				defer cancel()
+				changes, watchErr := c.Watch(ctx, "o2/control/globals")
+				Expect(watchErr).NotTo(HaveOccurred())
+
+				putErr := c.Put("o2/control/globals/watchedValue", "foobar")
+				Expect(putErr).NotTo(HaveOccurred())
+				var change cfgbackend.Change
+				Eventually(changes, "5s").Should(Receive(&change))
+				Expect(change.Keys).To(ConsistOf("o2/control/globals/watchedValue"))
+			})
+		})
+	}
+
+	// DoKVTests covers what only the backends which implement KVSource can do
+	DoKVTests := func() {
+		var kv cfgbackend.KVSource
+
+		JustBeforeEach(func() {
+			var ok bool
+			kv, ok = c.(cfgbackend.KVSource)
+			Expect(ok).To(BeTrue())
+		})
+
+		It("should delete a subtree and leave its siblings alone", func() {
+			Expect(kv.PutRecursive("o2/deleteme", cfgbackend.Map{
+				"a": cfgbackend.String("1"),
+				"b": cfgbackend.Array{cfgbackend.String("2")},
+			})).To(Succeed())
+			Expect(kv.Put("o2/deleteme2", "3")).To(Succeed())
+			Expect(kv.Delete("o2/deleteme")).To(Succeed())
+			Expect(kv.Exists("o2/deleteme")).To(BeFalse())
+			Expect(kv.Get("o2/deleteme2")).To(Equal("3"))
+			Expect(kv.Delete("/")).NotTo(Succeed())
+		})
+
+		It("should create and increment a counter", func() {
+			Expect(kv.GetNextUInt32("o2/runtime/test/counter")).To(Equal(uint32(1)))
+			Expect(kv.GetNextUInt32("o2/runtime/test/counter")).To(Equal(uint32(2)))
+			Expect(kv.Get("o2/runtime/test/counter")).To(Equal("2"))
+			Expect(kv.Put("o2/runtime/test/counter", "41")).To(Succeed())
+			Expect(kv.GetNextUInt32("o2/runtime/test/counter")).To(Equal(uint32(42)))
+			Expect(kv.Delete("o2/runtime/test")).To(Succeed())
+		})
+
+		It("should refuse to increment a counter which is not a number", func() {
+			Expect(kv.Put("o2/runtime/test/bad", "x")).To(Succeed())
+			_, err := kv.GetNextUInt32("o2/runtime/test/bad")
+			Expect(err).To(HaveOccurred())
+			Expect(kv.Delete("o2/runtime/test")).To(Succeed())
+		})
+
+		It("should create a key only if it doesn't exist", func() {
+			create := []cfgbackend.KVTxnOp{
+				{Verb: cfgbackend.KVTxnCheckIndex, Key: "o2/runtime/test/created", Index: 0},
+				{Verb: cfgbackend.KVTxnSet, Key: "o2/runtime/test/created", Value: "1"},
+			}
+			Expect(kv.GetIndex("o2/runtime/test/created")).To(BeZero())
+			Expect(kv.Txn(create)).To(BeTrue())
+			Expect(kv.Get("o2/runtime/test/created")).To(Equal("1"))
+			Expect(kv.GetIndex("o2/runtime/test/created")).NotTo(BeZero())
+
+			create[1].Value = "2"
+			Expect(kv.Txn(create)).To(BeFalse())
+			Expect(kv.Get("o2/runtime/test/created")).To(Equal("1"))
+			Expect(kv.Delete("o2/runtime/test")).To(Succeed())
+		})
+
+		It("should apply a transaction only if the checked keys are unchanged", func() {
+			Expect(kv.Put("o2/runtime/test/version", "1")).To(Succeed())
+			Expect(kv.PutRecursive("o2/runtime/test/old", cfgbackend.Map{"a": cfgbackend.String("1")})).To(Succeed())
+			index, err := kv.GetIndex("o2/runtime/test/version")
+			Expect(err).NotTo(HaveOccurred())
+
+			ops := []cfgbackend.KVTxnOp{
+				{Verb: cfgbackend.KVTxnCheckIndex, Key: "o2/runtime/test/version", Index: index},
+				{Verb: cfgbackend.KVTxnSet, Key: "o2/runtime/test/version", Value: "2"},
+				{Verb: cfgbackend.KVTxnSet, Key: "o2/runtime/test/new", Value: "x"},
+				{Verb: cfgbackend.KVTxnDelete, Key: "o2/runtime/test/old"},
+			}
+			// somebody else writes the key after we read its index
+			Expect(kv.Put("o2/runtime/test/version", "1b")).To(Succeed())
+			Expect(kv.Txn(ops)).To(BeFalse())
+			Expect(kv.Get("o2/runtime/test/version")).To(Equal("1b"))
+			Expect(kv.Exists("o2/runtime/test/new")).To(BeFalse())
+			Expect(kv.Exists("o2/runtime/test/old/a")).To(BeTrue())
+
+			ops[0].Index, err = kv.GetIndex("o2/runtime/test/version")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(kv.Txn(ops)).To(BeTrue())
+			Expect(kv.Get("o2/runtime/test/version")).To(Equal("2"))
+			Expect(kv.Get("o2/runtime/test/new")).To(Equal("x"))
+			Expect(kv.Exists("o2/runtime/test/old/a")).To(BeFalse())
+			Expect(kv.Delete("o2/runtime/test")).To(Succeed())
+		})
 	}
 
 	Describe("when interacting with an instance", func() {
 		Context("with Consul backend", func() {
 			BeforeEach(func() {
-				c, err = cfgbackend.NewSource("consul://dummy")
+				c, err = cfgbackend.NewSource("consul://" + consulAddress())
 			})
 
 			It("should be of type *ConsulSource", func() {
@@ -218,7 +321,78 @@ var _ = Describe("Source", func() {
 				Expect(ok).To(Equal(true))
 			})
 
-			//DoConfigurationTests()
+			DoConfigurationTests()
+
+			DoKVTests()
+
+			It("should refuse a tree larger than one transaction and leave the key alone", func() {
+				big := cfgbackend.Map{}
+				for i := 0; i < 200; i++ {
+					big[strconv.Itoa(i)] = cfgbackend.String(strings.Repeat("x", 4096))
+				}
+				Expect(c.Put("o2/bigtree/stale", "1")).To(Succeed())
+				Expect(c.PutRecursive("o2/bigtree", big)).To(MatchError(ContainSubstring("cannot write o2/bigtree atomically")))
+				keys, keysErr := c.GetKeysByPrefix("o2/bigtree")
+				Expect(keysErr).NotTo(HaveOccurred())
+				Expect(keys).To(ConsistOf("o2/bigtree/stale"))
+				Expect(c.(cfgbackend.KVSource).Delete("o2/bigtree")).To(Succeed())
+			})
+		})
+
+		Context("with etcd backend", func() {
+			BeforeEach(func() {
+				c, err = cfgbackend.NewSource("etcd://" + etcdAddress())
+			})
+
+			It("should be of type *EtcdSource", func() {
+				_, ok := c.(*cfgbackend.EtcdSource)
+				Expect(ok).To(Equal(true))
+			})
+
+			DoConfigurationTests()
+			DoKVTests()
+
+			It("should refuse a tree larger than one transaction and leave the key alone", func() {
+				big := cfgbackend.Map{}
+				for i := 0; i < 200; i++ {
+					big[strconv.Itoa(i)] = cfgbackend.String("x")
+				}
+				Expect(c.Put("o2/bigtree/stale", "1")).To(Succeed())
+				Expect(c.PutRecursive("o2/bigtree", big)).To(MatchError(ContainSubstring("cannot write o2/bigtree atomically")))
+				keys, keysErr := c.GetKeysByPrefix("o2/bigtree")
+				Expect(keysErr).NotTo(HaveOccurred())
+				Expect(keys).To(ConsistOf("o2/bigtree/stale"))
+				Expect(c.(cfgbackend.KVSource).Delete("o2/bigtree")).To(Succeed())
+			})
+		})
+
+		Context("with Git backend", func() {
+			BeforeEach(func() {
+				c, err = cfgbackend.NewSource("git://" + gitRepoPath())
+			})
+
+			It("should be of type *GitSource", func() {
+				_, ok := c.(*cfgbackend.GitSource)
+				Expect(ok).To(Equal(true))
+			})
+
+			DoConfigurationTests()
+
+			It("should commit every change", func() {
+				repo, repoErr := git.PlainOpen(gitRepoPath())
+				Expect(repoErr).NotTo(HaveOccurred())
+				before, repoErr := repo.Head()
+				Expect(repoErr).NotTo(HaveOccurred())
+				Expect(c.Put("o2/control/globals/committedValue", "foobar")).To(Succeed())
+				after, repoErr := repo.Head()
+				Expect(repoErr).NotTo(HaveOccurred())
+				Expect(after.Hash()).NotTo(Equal(before.Hash()))
+				wt, repoErr := repo.Worktree()
+				Expect(repoErr).NotTo(HaveOccurred())
+				status, repoErr := wt.Status()
+				Expect(repoErr).NotTo(HaveOccurred())
+				Expect(status.IsClean()).To(BeTrue())
+			})
 		})
 
 		Context("with YAML file backend", func() {
@@ -235,3 +409,4 @@ var _ = Describe("Source", func() {
 		})
 	})
 })
+
@@ -0,0 +1,105 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2021 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+
+package cfgbackend
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/AliceO2Group/Control/common/logger"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logger.New(logrus.StandardLogger(), "confsys")
+
+const (
+	consulWatchWaitTime      = 5*time.Minute
+	consulWatchRetryInterval = 5*time.Second
+	yamlWatchSettleTime      = 100*time.Millisecond
+)
+
+// Change is sent by Watch whenever something under the watched prefix is
+// added, modified or removed.
+type Change struct {
+	Prefix    string
+	Keys      []string    // the leaves which changed, sorted
+	Timestamp time.Time
+}
+
+// isUnderKey returns whether key is requestKey itself, or anything below it
+// in the configuration tree, as opposed to a sibling which merely starts
+// with the same characters.
+func isUnderKey(requestKey string, key string) bool {
+	if len(requestKey) == 0 || strings.HasSuffix(requestKey, "/") {
+		return strings.HasPrefix(key, requestKey)
+	}
+	return key == requestKey ||
+		strings.HasPrefix(key, requestKey + "/") ||
+		strings.HasPrefix(key, requestKey + "[")
+}
+
+// flatten turns a configuration tree into its leaves, with keys spelled the
+// same way they are addressed in Get.
+func flatten(key string, item Item, leaves map[string]string) {
+	if item == nil {
+		return
+	}
+	switch item.Type() {
+	case IT_Map:
+		for k, v := range item.Map() {
+			if len(key) == 0 {
+				flatten(k, v, leaves)
+			} else {
+				flatten(key + "/" + k, v, leaves)
+			}
+		}
+	case IT_Array:
+		for i, v := range item.Array() {
+			flatten(fmt.Sprintf("%s[%d]", key, i), v, leaves)
+		}
+	default:
+		leaves[key] = item.Value()
+	}
+}
+
+// diffLeaves returns the keys which differ between two snapshots of leaves.
+func diffLeaves(before map[string]string, after map[string]string) (changed []string) {
+	changed = make([]string, 0)
+	for k, v := range after {
+		if previous, ok := before[k]; !ok || previous != v {
+			changed = append(changed, k)
+		}
+	}
+	for k := range before {
+		if _, ok := after[k]; !ok {
+			changed = append(changed, k)
+		}
+	}
+	sort.Strings(changed)
+	return
+}
@@ -25,13 +25,17 @@
 package cfgbackend
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"path/filepath"
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/fsnotify/fsnotify"
 	"gopkg.in/yaml.v3"
 )
 
@@ -468,6 +472,98 @@ func (yc *YamlSource) Exists(key string) (exists bool, err error) {
 	return
 }
 
+// Watch follows the configuration file with fsnotify, and compares the
+// leaves under prefix every time the file is written.
+func (yc *YamlSource) Watch(ctx context.Context, prefix string) (<-chan Change, error) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+	// Editors often replace the file rather than writing into it, so we
+	// watch the directory and filter on the file name
+	path := filepath.Clean(pathForUri(yc.uri))
+	err = watcher.Add(filepath.Dir(path))
+	if err != nil {
+		_ = watcher.Close()
+		return nil, err
+	}
+	known, err := yc.leavesUnder(prefix)
+	if err != nil {
+		_ = watcher.Close()
+		return nil, err
+	}
+
+	ch := make(chan Change)
+	go func() {
+		defer close(ch)
+		defer watcher.Close()
+
+		// A single save can produce several events, so we let the file
+		// settle before reading it
+		settle := time.NewTimer(yamlWatchSettleTime)
+		settle.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case ev, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				if filepath.Clean(ev.Name) != path || ev.Op == fsnotify.Chmod {
+					continue
+				}
+				settle.Reset(yamlWatchSettleTime)
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				log.WithError(err).
+					WithField("prefix", prefix).
+					Warn("configuration watch error")
+			case <-settle.C:
+				current, err := yc.leavesUnder(prefix)
+				if err != nil {
+					// Most likely a half-written file, we'll get another event
+					continue
+				}
+				changed := diffLeaves(known, current)
+				known = current
+				if len(changed) == 0 {
+					continue
+				}
+				select {
+				case ch <- Change{Prefix: prefix, Keys: changed, Timestamp: time.Now()}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return ch, nil
+}
+
+func (yc *YamlSource) leavesUnder(prefix string) (leaves map[string]string, err error) {
+	// We read into a private instance, so that the watcher never touches
+	// yc.data behind the back of the caller
+	private := &YamlSource{uri: yc.uri}
+	err = private.refresh()
+	if err != nil {
+		return
+	}
+	all := make(map[string]string)
+	flatten("", private.data, all)
+
+	requestKey := yamlFormatKey(prefix)
+	leaves = make(map[string]string)
+	for k, v := range all {
+		if isUnderKey(requestKey, k) {
+			leaves[k] = v
+		}
+	}
+	return
+}
+
 func yamlFormatKey(key string) (consulKey string) {
 	// Trim leading slashes
 	consulKey = strings.TrimLeft(key, "/")
@@ -0,0 +1,237 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2021 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+
+package componentcfg
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	DIFF_ADDED   = "added"
+	DIFF_REMOVED = "removed"
+	DIFF_CHANGED = "changed"
+)
+
+const (
+	FORMAT_JSON = "json"
+	FORMAT_YAML = "yaml"
+	FORMAT_INI  = "ini"
+	FORMAT_TEXT = "text"
+)
+
+// Difference is a single change between two versions of a component
+// configuration payload. For structured payloads Path addresses a leaf
+// e.g. section/key or list[2]/name, for plain text it is a line number.
+type Difference struct {
+	Path     string `json:"path" yaml:"path"`
+	Kind     string `json:"kind" yaml:"kind"`
+	OldValue string `json:"oldValue,omitempty" yaml:"oldValue,omitempty"`
+	NewValue string `json:"newValue,omitempty" yaml:"newValue,omitempty"`
+}
+
+// DiffPayloads compares two payloads, and if both parse as the same
+// structured format the comparison is done leaf by leaf, otherwise line by
+// line.
+func DiffPayloads(oldPayload string, newPayload string) (format string, differences []Difference) {
+	oldFormat, oldLeaves := parsePayload(oldPayload)
+	newFormat, newLeaves := parsePayload(newPayload)
+	if oldFormat != newFormat || oldFormat == FORMAT_TEXT {
+		return FORMAT_TEXT, diffLines(oldPayload, newPayload)
+	}
+
+	differences = make([]Difference, 0)
+	for path, newValue := range newLeaves {
+		oldValue, ok := oldLeaves[path]
+		if !ok {
+			differences = append(differences, Difference{Path: path, Kind: DIFF_ADDED, NewValue: newValue})
+		} else if oldValue != newValue {
+			differences = append(differences, Difference{Path: path, Kind: DIFF_CHANGED, OldValue: oldValue, NewValue: newValue})
+		}
+	}
+	for path, oldValue := range oldLeaves {
+		if _, ok := newLeaves[path]; !ok {
+			differences = append(differences, Difference{Path: path, Kind: DIFF_REMOVED, OldValue: oldValue})
+		}
+	}
+	sort.Slice(differences, func(i, j int) bool { return differences[i].Path < differences[j].Path })
+	return oldFormat, differences
+}
+
+// parsePayload figures out the format of a payload and flattens it into its
+// leaves. INI is tried before YAML, because a [section] line is also a valid
+// YAML list.
+func parsePayload(payload string) (format string, leaves map[string]string) {
+	trimmed := strings.TrimSpace(payload)
+	var tree interface{}
+	if strings.HasPrefix(trimmed, "{") || strings.HasPrefix(trimmed, "[") {
+		if err := json.Unmarshal([]byte(trimmed), &tree); err == nil {
+			leaves = make(map[string]string)
+			flattenTree("", tree, leaves)
+			return FORMAT_JSON, leaves
+		}
+	}
+	if leaves, ok := parseIni(trimmed); ok {
+		return FORMAT_INI, leaves
+	}
+	if err := yaml.Unmarshal([]byte(trimmed), &tree); err == nil {
+		switch tree.(type) {
+		case map[string]interface{}, []interface{}:
+			leaves = make(map[string]string)
+			flattenTree("", tree, leaves)
+			return FORMAT_YAML, leaves
+		}
+	}
+	return FORMAT_TEXT, nil
+}
+
+func parseIni(payload string) (leaves map[string]string, ok bool) {
+	sections, err := parseIniSections(payload)
+	// Without sections, "key: value" lines are better handled as YAML
+	if err != nil || len(sections) < 2 {
+		return nil, false
+	}
+	leaves = make(map[string]string)
+	for _, section := range sections {
+		for _, entry := range section.entries {
+			key := entry.key
+			if len(section.name) > 0 {
+				key = section.name + SEPARATOR + key
+			}
+			leaves[key] = entry.value
+		}
+	}
+	return leaves, true
+}
+
+func flattenTree(path string, tree interface{}, leaves map[string]string) {
+	switch typed := tree.(type) {
+	case map[string]interface{}:
+		if len(typed) == 0 {
+			leaves[path] = "{}"
+		}
+		for k, v := range typed {
+			if len(path) == 0 {
+				flattenTree(k, v, leaves)
+			} else {
+				flattenTree(path + SEPARATOR + k, v, leaves)
+			}
+		}
+	case []interface{}:
+		if len(typed) == 0 {
+			leaves[path] = "[]"
+		}
+		for i, v := range typed {
+			flattenTree(fmt.Sprintf("%s[%d]", path, i), v, leaves)
+		}
+	case nil:
+		leaves[path] = "null"
+	default:
+		leaves[path] = fmt.Sprintf("%v", typed)
+	}
+}
+
+// Above this many LCS table cells, i.e. changed old lines times changed new
+// lines once the common head and tail are left out, diffLines gives up on a
+// line by line comparison.
+const diffLinesMaxCells = 1 << 22
+
+// diffLines is a plain longest common subsequence diff of what remains once
+// the lines both payloads start and end with are left out. If that is still
+// too big, it only reports that the payloads differ.
+func diffLines(oldPayload string, newPayload string) (differences []Difference) {
+	oldLines := strings.Split(strings.TrimSuffix(oldPayload, "\n"), "\n")
+	newLines := strings.Split(strings.TrimSuffix(newPayload, "\n"), "\n")
+
+	head := 0
+	for head < len(oldLines) && head < len(newLines) && oldLines[head] == newLines[head] {
+		head++
+	}
+	tail := 0
+	for tail < len(oldLines) - head && tail < len(newLines) - head &&
+		oldLines[len(oldLines) - 1 - tail] == newLines[len(newLines) - 1 - tail] {
+		tail++
+	}
+	oldChanged := oldLines[head:len(oldLines) - tail]
+	newChanged := newLines[head:len(newLines) - tail]
+
+	differences = make([]Difference, 0)
+	if len(oldChanged) == 0 && len(newChanged) == 0 {
+		return
+	}
+	if int64(len(oldChanged) + 1) * int64(len(newChanged) + 1) > diffLinesMaxCells {
+		return append(differences, Difference{
+			Path:     "payload",
+			Kind:     DIFF_CHANGED,
+			OldValue: fmt.Sprintf("%d lines", len(oldLines)),
+			NewValue: fmt.Sprintf("%d lines, too many changes to compare line by line", len(newLines)),
+		})
+	}
+
+	n, m := len(oldChanged), len(newChanged)
+	stride := m + 1
+	lcs := make([]int32, (n + 1) * stride)
+	at := func(i, j int) int32 { return lcs[i * stride + j] }
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if oldChanged[i] == newChanged[j] {
+				lcs[i * stride + j] = at(i + 1, j + 1) + 1
+			} else if at(i + 1, j) >= at(i, j + 1) {
+				lcs[i * stride + j] = at(i + 1, j)
+			} else {
+				lcs[i * stride + j] = at(i, j + 1)
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < n || j < m {
+		switch {
+		case i < n && j < m && oldChanged[i] == newChanged[j]:
+			i++
+			j++
+		case i < n && (j == m || at(i + 1, j) >= at(i, j + 1)):
+			differences = append(differences, Difference{
+				Path:     fmt.Sprintf("line %d", head + i + 1),
+				Kind:     DIFF_REMOVED,
+				OldValue: oldChanged[i],
+			})
+			i++
+		default:
+			differences = append(differences, Difference{
+				Path:     fmt.Sprintf("line %d", head + j + 1),
+				Kind:     DIFF_ADDED,
+				NewValue: newChanged[j],
+			})
+			j++
+		}
+	}
+	return
+}
@@ -0,0 +1,258 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2021 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+
+package componentcfg
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDiffPayloads(t *testing.T) {
+	cases := []struct {
+		name       string
+		oldPayload string
+		newPayload string
+		format     string
+		want       []Difference
+	}{
+		{
+			name:       "identical text",
+			oldPayload: "one\ntwo\n",
+			newPayload: "one\ntwo\n",
+			format:     FORMAT_TEXT,
+			want:       []Difference{},
+		},
+		{
+			name:       "changed text line",
+			oldPayload: "one\ntwo\nthree\n",
+			newPayload: "one\n2\nthree\n",
+			format:     FORMAT_TEXT,
+			want: []Difference{
+				{Path: "line 2", Kind: DIFF_REMOVED, OldValue: "two"},
+				{Path: "line 2", Kind: DIFF_ADDED, NewValue: "2"},
+			},
+		},
+		{
+			name:       "identical INI",
+			oldPayload: "[a]\nx=1\n[b]\ny=2\n",
+			newPayload: "[a]\nx = 1\n[b]\ny=2\n",
+			format:     FORMAT_INI,
+			want:       []Difference{},
+		},
+		{
+			name:       "changed INI",
+			oldPayload: "[a]\nx=1\nz=3\n[b]\ny=2\n",
+			newPayload: "[a]\nx=10\n[b]\ny=2\nw=4\n",
+			format:     FORMAT_INI,
+			want: []Difference{
+				{Path: "a/x", Kind: DIFF_CHANGED, OldValue: "1", NewValue: "10"},
+				{Path: "a/z", Kind: DIFF_REMOVED, OldValue: "3"},
+				{Path: "b/w", Kind: DIFF_ADDED, NewValue: "4"},
+			},
+		},
+		{
+			name:       "identical JSON with different layout",
+			oldPayload: `{"a": {"b": 1}, "c": [1, 2]}`,
+			newPayload: "{\"c\":[1,2],\n\"a\":{\"b\":1}}",
+			format:     FORMAT_JSON,
+			want:       []Difference{},
+		},
+		{
+			name:       "changed JSON",
+			oldPayload: `{"a": {"b": 1}, "c": [1, 2], "d": null}`,
+			newPayload: `{"a": {"b": 2}, "c": [1], "e": {}}`,
+			format:     FORMAT_JSON,
+			want: []Difference{
+				{Path: "a/b", Kind: DIFF_CHANGED, OldValue: "1", NewValue: "2"},
+				{Path: "c[1]", Kind: DIFF_REMOVED, OldValue: "2"},
+				{Path: "d", Kind: DIFF_REMOVED, OldValue: "null"},
+				{Path: "e", Kind: DIFF_ADDED, NewValue: "{}"},
+			},
+		},
+		{
+			name:       "changed YAML",
+			oldPayload: "a:\n  b: 1\n",
+			newPayload: "a:\n  b: 1\n  c: x\n",
+			format:     FORMAT_YAML,
+			want: []Difference{
+				{Path: "a/c", Kind: DIFF_ADDED, NewValue: "x"},
+			},
+		},
+		{
+			name:       "different formats",
+			oldPayload: `{"a": 1}`,
+			newPayload: "a=1\n",
+			format:     FORMAT_TEXT,
+			want: []Difference{
+				{Path: "line 1", Kind: DIFF_REMOVED, OldValue: `{"a": 1}`},
+				{Path: "line 1", Kind: DIFF_ADDED, NewValue: "a=1"},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			format, differences := DiffPayloads(c.oldPayload, c.newPayload)
+			if format != c.format {
+				t.Errorf("expected format %s, got %s", c.format, format)
+			}
+			if !reflect.DeepEqual(differences, c.want) {
+				t.Errorf("expected %+v, got %+v", c.want, differences)
+			}
+		})
+	}
+}
+
+func TestParseIni(t *testing.T) {
+	cases := []struct {
+		name    string
+		payload string
+		want    map[string]string
+		ok      bool
+	}{
+		{
+			name:    "sections",
+			payload: "; comment\nglobal=1\n[readout]\nrate = 10\n# comment\n[equipment-1]\nenabled: 1\n",
+			want: map[string]string{
+				"global":              "1",
+				"readout/rate":        "10",
+				"equipment-1/enabled": "1",
+			},
+			ok: true,
+		},
+		{
+			name:    "empty values",
+			payload: "[a]\nx=\n",
+			want:    map[string]string{"a/x": ""},
+			ok:      true,
+		},
+		{
+			name:    "no section",
+			payload: "x=1\ny=2\n",
+			ok:      false,
+		},
+		{
+			name:    "malformed line",
+			payload: "[a]\nnot a key value\n",
+			ok:      false,
+		},
+		{
+			name:    "empty",
+			payload: "",
+			ok:      false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			leaves, ok := parseIni(c.payload)
+			if ok != c.ok {
+				t.Fatalf("expected ok %v, got %v", c.ok, ok)
+			}
+			if ok && !reflect.DeepEqual(leaves, c.want) {
+				t.Errorf("expected %v, got %v", c.want, leaves)
+			}
+		})
+	}
+}
+
+func TestDiffLines(t *testing.T) {
+	cases := []struct {
+		name string
+		old  string
+		new  string
+		want []Difference
+	}{
+		{
+			name: "identical",
+			old:  "a\nb\n",
+			new:  "a\nb",
+			want: []Difference{},
+		},
+		{
+			name: "appended",
+			old:  "a\nb",
+			new:  "a\nb\nc",
+			want: []Difference{{Path: "line 3", Kind: DIFF_ADDED, NewValue: "c"}},
+		},
+		{
+			name: "removed in the middle",
+			old:  "a\nb\nc\nd",
+			new:  "a\nd",
+			want: []Difference{
+				{Path: "line 2", Kind: DIFF_REMOVED, OldValue: "b"},
+				{Path: "line 3", Kind: DIFF_REMOVED, OldValue: "c"},
+			},
+		},
+		{
+			name: "inserted after common head",
+			old:  "a\nb\nz",
+			new:  "a\nx\nb\nz",
+			want: []Difference{{Path: "line 2", Kind: DIFF_ADDED, NewValue: "x"}},
+		},
+		{
+			name: "repeated lines",
+			old:  "x\nx\nx",
+			new:  "x\nx",
+			want: []Difference{{Path: "line 3", Kind: DIFF_REMOVED, OldValue: "x"}},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := diffLines(c.old, c.new); !reflect.DeepEqual(got, c.want) {
+				t.Errorf("expected %+v, got %+v", c.want, got)
+			}
+		})
+	}
+}
+
+func TestDiffLinesLargePayloads(t *testing.T) {
+	var oldLines, newLines []string
+	for i := 0; i < 5000; i++ {
+		oldLines = append(oldLines, fmt.Sprintf("old %d", i))
+		newLines = append(newLines, fmt.Sprintf("new %d", i))
+	}
+	common := strings.Repeat("same\n", 100000)
+
+	// A small change in a big payload is still compared line by line
+	got := diffLines(common + "a\n" + common, common + "b\n" + common)
+	want := []Difference{
+		{Path: "line 100001", Kind: DIFF_REMOVED, OldValue: "a"},
+		{Path: "line 100001", Kind: DIFF_ADDED, NewValue: "b"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %+v, got %+v", want, got)
+	}
+
+	// Too many changes and we only report that the payloads differ
+	got = diffLines(strings.Join(oldLines, "\n"), strings.Join(newLines, "\n"))
+	if len(got) != 1 || got[0].Kind != DIFF_CHANGED || got[0].Path != "payload" {
+		t.Errorf("expected a single payload difference, got %d differences", len(got))
+	}
+}
@@ -0,0 +1,83 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2021 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+
+package componentcfg
+
+import (
+	"bufio"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	iniSectionRegex  = regexp.MustCompile(`^\[([^\]]+)\]$`)
+	iniKeyValueRegex = regexp.MustCompile(`^([^=:]+?)\s*[=:]\s*(.*)$`)
+)
+
+type iniEntry struct {
+	key   string
+	value string
+	line  int
+}
+
+// iniSection holds the entries of a [section], or those before the first
+// section header if name is empty.
+type iniSection struct {
+	name    string
+	line    int
+	entries []iniEntry
+}
+
+func parseIniSections(payload string) (sections []*iniSection, err error) {
+	current := &iniSection{}
+	sections = []*iniSection{current}
+
+	scanner := bufio.NewScanner(strings.NewReader(payload))
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if len(line) == 0 || strings.HasPrefix(line, ";") || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if matches := iniSectionRegex.FindStringSubmatch(line); matches != nil {
+			current = &iniSection{name: strings.TrimSpace(matches[1]), line: lineNumber}
+			sections = append(sections, current)
+			continue
+		}
+		matches := iniKeyValueRegex.FindStringSubmatch(line)
+		if matches == nil {
+			return nil, fmt.Errorf("line %d: expected [section] or key=value, got %q", lineNumber, line)
+		}
+		current.entries = append(current.entries, iniEntry{
+			key:   strings.TrimSpace(matches[1]),
+			value: strings.TrimSpace(matches[2]),
+			line:  lineNumber,
+		})
+	}
+	err = scanner.Err()
+	return
+}
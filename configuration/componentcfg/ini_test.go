@@ -0,0 +1,78 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2021 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+
+package componentcfg
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseIniSections(t *testing.T) {
+	payload := "; leading comment\nglobal = 1\n\n[readout]\nrate=10\n# comment\n  exitTimeout : 5  \nurl = http://host:8080/x?a=b\n[ equipment-1 ]\nenabled:\n"
+	sections, err := parseIniSections(payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*iniSection{
+		{name: "", line: 0, entries: []iniEntry{{key: "global", value: "1", line: 2}}},
+		{name: "readout", line: 4, entries: []iniEntry{
+			{key: "rate", value: "10", line: 5},
+			{key: "exitTimeout", value: "5", line: 7},
+			{key: "url", value: "http://host:8080/x?a=b", line: 8},
+		}},
+		{name: "equipment-1", line: 9, entries: []iniEntry{{key: "enabled", value: "", line: 10}}},
+	}
+	if !reflect.DeepEqual(sections, want) {
+		for i := range sections {
+			t.Logf("section %d: %+v", i, *sections[i])
+		}
+		t.Fatal("unexpected sections")
+	}
+}
+
+func TestParseIniSectionsMalformed(t *testing.T) {
+	cases := []string{
+		"[readout]\nrate\n",
+		"[readout\nrate = 1\n",
+		"[readout]\n= 1\n",
+		"[]\n",
+	}
+	for _, payload := range cases {
+		if sections, err := parseIniSections(payload); err == nil {
+			t.Errorf("%q: expected error, got %d sections", payload, len(sections))
+		}
+	}
+}
+
+func TestParseIniSectionsEmpty(t *testing.T) {
+	sections, err := parseIniSections("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sections) != 1 || len(sections[0].name) != 0 || len(sections[0].entries) != 0 {
+		t.Errorf("expected a single empty unnamed section, got %d sections", len(sections))
+	}
+}
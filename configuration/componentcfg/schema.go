@@ -0,0 +1,308 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2021 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+
+package componentcfg
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gobwas/glob"
+	"github.com/xeipuuv/gojsonschema"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// Schemata live outside of ConfigComponentsPath, under
+	// <component>/<entry>, or <component>/any for all entries of a component
+	ConfigSchemataPath = "o2/schemata/components/"
+	SCHEMA_ANY_ENTRY   = "any"
+)
+
+const (
+	SCHEMA_JSONSCHEMA = "jsonschema"
+	SCHEMA_INI        = "ini"
+)
+
+// Schema is the record stored under ConfigSchemataPath. Schema holds either
+// a JSON Schema, which applies to JSON and YAML payloads, or an IniSpec.
+// SampleVars are used to render templated payloads before validation.
+type Schema struct {
+	Type       string            `yaml:"type"`
+	SampleVars map[string]string `yaml:"sampleVars"`
+	Schema     interface{}       `yaml:"schema"`
+
+	ini *IniSpec
+}
+
+type IniSpec struct {
+	Sections             map[string]IniSectionSpec `yaml:"sections"`
+	AllowUnknownSections bool                      `yaml:"allowUnknownSections"`
+}
+
+// IniSectionSpec applies to all sections whose name matches the glob it's
+// declared with, e.g. equipment-*.
+type IniSectionSpec struct {
+	Required         bool                  `yaml:"required"`
+	Keys             map[string]IniKeySpec `yaml:"keys"`
+	AllowUnknownKeys bool                  `yaml:"allowUnknownKeys"`
+}
+
+type IniKeySpec struct {
+	Required bool     `yaml:"required"`
+	Type     string   `yaml:"type"` // string (default), int, number or bool
+	Pattern  string   `yaml:"pattern"`
+	Enum     []string `yaml:"enum"`
+}
+
+// ValidationError lists everything that's wrong with a payload, rather than
+// stopping at the first problem.
+type ValidationError struct {
+	Entry    string
+	Problems []string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("configuration payload for %s does not validate against its schema:\n  - %s",
+		e.Entry, strings.Join(e.Problems, "\n  - "))
+}
+
+func SchemaKeysForQuery(query *Query) []string {
+	return []string{
+		ConfigSchemataPath + query.Component + SEPARATOR + query.EntryKey,
+		ConfigSchemataPath + query.Component + SEPARATOR + SCHEMA_ANY_ENTRY,
+	}
+}
+
+func ParseSchema(raw string) (schema *Schema, err error) {
+	schema = &Schema{}
+	err = yaml.Unmarshal([]byte(raw), schema)
+	if err != nil {
+		return nil, err
+	}
+	if schema.Schema == nil {
+		return nil, fmt.Errorf("no schema defined")
+	}
+	// A JSON Schema file can also be embedded as is, in a string
+	if embedded, ok := schema.Schema.(string); ok {
+		err = json.Unmarshal([]byte(embedded), &schema.Schema)
+		if err != nil {
+			return nil, fmt.Errorf("embedded schema is not valid JSON: %w", err)
+		}
+	}
+
+	switch schema.Type {
+	case SCHEMA_JSONSCHEMA:
+		// we make sure the JSON Schema itself is well formed
+		_, err = gojsonschema.NewSchema(gojsonschema.NewGoLoader(schema.Schema))
+	case SCHEMA_INI:
+		var specYaml []byte
+		specYaml, err = yaml.Marshal(schema.Schema)
+		if err != nil {
+			return nil, err
+		}
+		schema.ini = &IniSpec{}
+		err = yaml.Unmarshal(specYaml, schema.ini)
+		if err == nil {
+			err = schema.ini.check()
+		}
+	default:
+		err = fmt.Errorf("unknown schema type %q, expected %s or %s", schema.Type, SCHEMA_JSONSCHEMA, SCHEMA_INI)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
+// Validate checks an already rendered payload against the schema.
+func (s *Schema) Validate(entry string, payload string) error {
+	var problems []string
+	switch s.Type {
+	case SCHEMA_JSONSCHEMA:
+		problems = s.validateJsonSchema(payload)
+	case SCHEMA_INI:
+		problems = s.ini.validate(payload)
+	}
+	if len(problems) == 0 {
+		return nil
+	}
+	return &ValidationError{Entry: entry, Problems: problems}
+}
+
+func (s *Schema) validateJsonSchema(payload string) (problems []string) {
+	var document interface{}
+	trimmed := strings.TrimSpace(payload)
+	if strings.HasPrefix(trimmed, "{") || strings.HasPrefix(trimmed, "[") {
+		if err := json.Unmarshal([]byte(trimmed), &document); err != nil {
+			return []string{"payload is not valid JSON: " + err.Error()}
+		}
+	} else if err := yaml.Unmarshal([]byte(trimmed), &document); err != nil {
+		return []string{"payload is not valid YAML: " + err.Error()}
+	}
+
+	result, err := gojsonschema.Validate(gojsonschema.NewGoLoader(s.Schema), gojsonschema.NewGoLoader(document))
+	if err != nil {
+		return []string{err.Error()}
+	}
+	for _, resultErr := range result.Errors() {
+		problems = append(problems, fmt.Sprintf("%s: %s", resultErr.Field(), resultErr.Description()))
+	}
+	return
+}
+
+func (spec *IniSpec) check() error {
+	for name, section := range spec.Sections {
+		if _, err := glob.Compile(name); err != nil {
+			return fmt.Errorf("bad section name %q: %w", name, err)
+		}
+		for key, keySpec := range section.Keys {
+			switch keySpec.Type {
+			case "", "string", "int", "number", "bool":
+			default:
+				return fmt.Errorf("section %q, key %q: unknown type %q", name, key, keySpec.Type)
+			}
+			if _, err := regexp.Compile(keySpec.Pattern); err != nil {
+				return fmt.Errorf("section %q, key %q: bad pattern: %w", name, key, err)
+			}
+		}
+	}
+	return nil
+}
+
+func (spec *IniSpec) validate(payload string) (problems []string) {
+	sections, err := parseIniSections(payload)
+	if err != nil {
+		return []string{err.Error()}
+	}
+
+	// We go through the spec in a stable order, so that errors are too
+	specNames := make([]string, 0, len(spec.Sections))
+	for name := range spec.Sections {
+		specNames = append(specNames, name)
+	}
+	sort.Strings(specNames)
+	globs := make(map[string]glob.Glob)
+	for _, name := range specNames {
+		globs[name] = glob.MustCompile(name)
+	}
+
+	matched := make(map[string]bool)
+	for _, section := range sections {
+		if len(section.name) == 0 {
+			for _, entry := range section.entries {
+				problems = append(problems, fmt.Sprintf("line %d: key %q outside of any section", entry.line, entry.key))
+			}
+			continue
+		}
+		var sectionSpec *IniSectionSpec
+		for _, name := range specNames {
+			if globs[name].Match(section.name) {
+				matchedSpec := spec.Sections[name]
+				sectionSpec = &matchedSpec
+				matched[name] = true
+				break
+			}
+		}
+		if sectionSpec == nil {
+			if !spec.AllowUnknownSections {
+				problems = append(problems, fmt.Sprintf("line %d: unknown section [%s]", section.line, section.name))
+			}
+			continue
+		}
+		problems = append(problems, sectionSpec.validate(section)...)
+	}
+
+	for _, name := range specNames {
+		if spec.Sections[name].Required && !matched[name] {
+			problems = append(problems, fmt.Sprintf("missing required section [%s]", name))
+		}
+	}
+	return
+}
+
+func (spec *IniSectionSpec) validate(section *iniSection) (problems []string) {
+	seen := make(map[string]bool)
+	for _, entry := range section.entries {
+		seen[entry.key] = true
+		keySpec, ok := spec.Keys[entry.key]
+		if !ok {
+			if !spec.AllowUnknownKeys {
+				problems = append(problems, fmt.Sprintf("line %d: unknown key %q in section [%s]", entry.line, entry.key, section.name))
+			}
+			continue
+		}
+		if problem := keySpec.validate(entry.value); len(problem) > 0 {
+			problems = append(problems, fmt.Sprintf("line %d: key %q in section [%s] %s", entry.line, entry.key, section.name, problem))
+		}
+	}
+
+	keys := make([]string, 0, len(spec.Keys))
+	for key := range spec.Keys {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if spec.Keys[key].Required && !seen[key] {
+			problems = append(problems, fmt.Sprintf("line %d: missing required key %q in section [%s]", section.line, key, section.name))
+		}
+	}
+	return
+}
+
+func (spec IniKeySpec) validate(value string) string {
+	var err error
+	switch spec.Type {
+	case "int":
+		_, err = strconv.ParseInt(value, 0, 64)
+	case "number":
+		_, err = strconv.ParseFloat(value, 64)
+	case "bool":
+		switch strings.ToLower(value) {
+		case "true", "false", "1", "0", "yes", "no", "on", "off":
+		default:
+			err = fmt.Errorf("not a boolean")
+		}
+	}
+	if err != nil {
+		return fmt.Sprintf("should be of type %s, got %q", spec.Type, value)
+	}
+	if len(spec.Pattern) > 0 && !regexp.MustCompile(spec.Pattern).MatchString(value) {
+		return fmt.Sprintf("does not match pattern %s, got %q", spec.Pattern, value)
+	}
+	if len(spec.Enum) > 0 {
+		for _, allowed := range spec.Enum {
+			if value == allowed {
+				return ""
+			}
+		}
+		return fmt.Sprintf("should be one of %s, got %q", strings.Join(spec.Enum, ", "), value)
+	}
+	return ""
+}
@@ -0,0 +1,250 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2021 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+
+package componentcfg
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testIniSchema = `
+type: ini
+schema:
+  allowUnknownSections: false
+  sections:
+    readout:
+      required: true
+      keys:
+        rate:
+          required: true
+          type: number
+        exitTimeout:
+          type: int
+        verbose:
+          type: bool
+        mode:
+          enum: [continuous, triggered]
+        name:
+          pattern: "^[a-z]+$"
+    equipment-*:
+      allowUnknownKeys: true
+      keys:
+        enabled:
+          required: true
+          type: bool
+`
+
+func TestParseSchema(t *testing.T) {
+	cases := []struct {
+		name string
+		raw  string
+		ok   bool
+	}{
+		{"ini", testIniSchema, true},
+		{"jsonschema", "type: jsonschema\nschema:\n  type: object\n", true},
+		{"embedded jsonschema", "type: jsonschema\nschema: '{\"type\": \"object\"}'\n", true},
+		{"embedded bad json", "type: jsonschema\nschema: '{\"type\": '\n", false},
+		{"bad jsonschema", "type: jsonschema\nschema:\n  type: 12\n", false},
+		{"no schema", "type: ini\n", false},
+		{"unknown type", "type: xml\nschema:\n  a: b\n", false},
+		{"bad yaml", "type: [\n", false},
+		{"unknown key type", "type: ini\nschema:\n  sections:\n    a:\n      keys:\n        b:\n          type: float\n", false},
+		{"bad pattern", "type: ini\nschema:\n  sections:\n    a:\n      keys:\n        b:\n          pattern: \"[\"\n", false},
+		{"bad section glob", "type: ini\nschema:\n  sections:\n    \"a[\":\n      required: true\n", false},
+	}
+	for _, c := range cases {
+		schema, err := ParseSchema(c.raw)
+		if c.ok && err != nil {
+			t.Errorf("%s: unexpected error %s", c.name, err)
+		} else if !c.ok && err == nil {
+			t.Errorf("%s: expected error, got schema %+v", c.name, schema)
+		}
+	}
+}
+
+func TestIniSchemaValidate(t *testing.T) {
+	schema, err := ParseSchema(testIniSchema)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		name     string
+		payload  string
+		problems []string
+	}{
+		{
+			name:    "valid",
+			payload: "[readout]\nrate = 1.5\nexitTimeout = 0x10\nverbose = on\nmode = triggered\nname = flp\n[equipment-1]\nenabled = 1\nanything = goes\n",
+		},
+		{
+			name:    "type mismatches",
+			payload: "[readout]\nrate = fast\nexitTimeout = 1.5\nverbose = maybe\n",
+			problems: []string{
+				`line 2: key "rate" in section [readout] should be of type number, got "fast"`,
+				`line 3: key "exitTimeout" in section [readout] should be of type int, got "1.5"`,
+				`line 4: key "verbose" in section [readout] should be of type bool, got "maybe"`,
+			},
+		},
+		{
+			name:    "pattern and enum",
+			payload: "[readout]\nrate = 1\nname = FLP1\nmode = burst\n",
+			problems: []string{
+				`line 3: key "name" in section [readout] does not match pattern ^[a-z]+$, got "FLP1"`,
+				`line 4: key "mode" in section [readout] should be one of continuous, triggered, got "burst"`,
+			},
+		},
+		{
+			name:    "missing required keys",
+			payload: "[readout]\nverbose = true\n[equipment-2]\n",
+			problems: []string{
+				`line 1: missing required key "rate" in section [readout]`,
+				`line 3: missing required key "enabled" in section [equipment-2]`,
+			},
+		},
+		{
+			name:    "missing required section",
+			payload: "[equipment-1]\nenabled = true\n",
+			problems: []string{
+				`missing required section [readout]`,
+			},
+		},
+		{
+			name:    "unknown keys and sections",
+			payload: "global = 1\n[readout]\nrate = 1\nrte = 2\n[consumer]\nx = 1\n",
+			problems: []string{
+				`line 1: key "global" outside of any section`,
+				`line 4: unknown key "rte" in section [readout]`,
+				`line 5: unknown section [consumer]`,
+			},
+		},
+		{
+			name:    "malformed ini",
+			payload: "[readout]\nrate\n",
+			problems: []string{
+				`line 2: expected [section] or key=value, got "rate"`,
+			},
+		},
+	}
+	for _, c := range cases {
+		err := schema.Validate("readout/any/config", c.payload)
+		if len(c.problems) == 0 {
+			if err != nil {
+				t.Errorf("%s: unexpected error %s", c.name, err)
+			}
+			continue
+		}
+		var validationErr *ValidationError
+		if !errors.As(err, &validationErr) {
+			t.Errorf("%s: expected a ValidationError, got %v", c.name, err)
+			continue
+		}
+		if validationErr.Entry != "readout/any/config" {
+			t.Errorf("%s: unexpected entry %s", c.name, validationErr.Entry)
+		}
+		if !reflect.DeepEqual(validationErr.Problems, c.problems) {
+			t.Errorf("%s: expected problems\n%s\ngot\n%s", c.name,
+				strings.Join(c.problems, "\n"), strings.Join(validationErr.Problems, "\n"))
+		}
+	}
+}
+
+func TestIniSchemaAllowUnknownSections(t *testing.T) {
+	schema, err := ParseSchema("type: ini\nschema:\n  allowUnknownSections: true\n  sections:\n    readout:\n      keys:\n        rate:\n          type: int\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = schema.Validate("readout/any/config", "[consumer]\nx = 1\n[readout]\nrate = 1\n"); err != nil {
+		t.Errorf("unexpected error %s", err)
+	}
+}
+
+func TestJsonSchemaValidate(t *testing.T) {
+	schema, err := ParseSchema(`
+type: jsonschema
+schema:
+  type: object
+  required: [rate]
+  additionalProperties: false
+  properties:
+    rate:
+      type: integer
+    mode:
+      type: string
+      enum: [continuous, triggered]
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		name     string
+		payload  string
+		problems int
+	}{
+		{"valid json", `{"rate": 10, "mode": "continuous"}`, 0},
+		{"valid yaml", "rate: 10\nmode: triggered\n", 0},
+		{"type mismatch", `{"rate": "fast"}`, 1},
+		{"missing required key", "mode: continuous\n", 1},
+		{"unknown key", `{"rate": 1, "rte": 2}`, 1},
+		{"bad enum", `{"rate": 1, "mode": "burst"}`, 1},
+		{"bad json", `{"rate": `, 1},
+		{"bad yaml", "rate: [\n", 1},
+	}
+	for _, c := range cases {
+		err := schema.Validate("readout/any/config", c.payload)
+		if c.problems == 0 {
+			if err != nil {
+				t.Errorf("%s: unexpected error %s", c.name, err)
+			}
+			continue
+		}
+		var validationErr *ValidationError
+		if !errors.As(err, &validationErr) {
+			t.Errorf("%s: expected a ValidationError, got %v", c.name, err)
+			continue
+		}
+		if len(validationErr.Problems) != c.problems {
+			t.Errorf("%s: expected %d problems, got %v", c.name, c.problems, validationErr.Problems)
+		}
+	}
+}
+
+func TestSchemaKeysForQuery(t *testing.T) {
+	query, err := NewQuery("readout/PHYSICS/any/config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		ConfigSchemataPath + "readout/config",
+		ConfigSchemataPath + "readout/any",
+	}
+	if got := SchemaKeysForQuery(query); !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
@@ -0,0 +1,123 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2021 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+
+package componentcfg
+
+import (
+	"sort"
+	"strconv"
+)
+
+// Run snapshots live under <runNumber>/manifest and
+// <runNumber>/payloads/<role path>
+const RunSnapshotsPath = "o2/snapshots/runs/"
+
+// RunSnapshot records which component configuration entries were resolved
+// for a run, and the fully rendered property maps pushed to its tasks at
+// CONFIGURE time, keyed by the role path of each task.
+type RunSnapshot struct {
+	RunNumber     uint32
+	EnvironmentId string
+	Timestamp     int64
+	Entries       []*Query
+	Payloads      map[string]map[string]string
+}
+
+func RunSnapshotPrefix(runNumber uint32) string {
+	return RunSnapshotsPath + strconv.FormatUint(uint64(runNumber), 10)
+}
+
+// EntryPaths returns the resolved entries as sorted component/RUNTYPE/role/entry@timestamp
+// paths, which is also how they are stored in the manifest.
+func (s *RunSnapshot) EntryPaths() (paths []string) {
+	paths = make([]string, 0, len(s.Entries))
+	for _, entry := range s.Entries {
+		paths = append(paths, entry.Path())
+	}
+	sort.Strings(paths)
+	return
+}
+
+// Diff compares the configuration of two runs. Entries are matched without
+// their timestamp, so a different version of the same entry shows up as
+// changed. Rendered payloads are matched by role path and property key, and
+// structured payloads are compared leaf by leaf.
+func (s *RunSnapshot) Diff(other *RunSnapshot) (differences []Difference) {
+	differences = make([]Difference, 0)
+
+	oldEntries := s.entryTimestamps()
+	newEntries := other.entryTimestamps()
+	differences = append(differences, diffStringMaps("entries/", oldEntries, newEntries, false)...)
+
+	for role, newProps := range other.Payloads {
+		differences = append(differences, diffStringMaps("payloads/" + role + SEPARATOR, s.Payloads[role], newProps, true)...)
+	}
+	for role, oldProps := range s.Payloads {
+		if _, ok := other.Payloads[role]; !ok {
+			differences = append(differences, diffStringMaps("payloads/" + role + SEPARATOR, oldProps, nil, true)...)
+		}
+	}
+
+	sort.Slice(differences, func(i, j int) bool { return differences[i].Path < differences[j].Path })
+	return
+}
+
+func (s *RunSnapshot) entryTimestamps() map[string]string {
+	timestamps := make(map[string]string)
+	for _, entry := range s.Entries {
+		timestamps[entry.WithoutTimestamp()] = entry.Timestamp
+	}
+	return timestamps
+}
+
+func diffStringMaps(prefix string, oldMap map[string]string, newMap map[string]string, expandPayloads bool) (differences []Difference) {
+	differences = make([]Difference, 0)
+	for k, newValue := range newMap {
+		oldValue, ok := oldMap[k]
+		if !ok {
+			differences = append(differences, Difference{Path: prefix + k, Kind: DIFF_ADDED, NewValue: newValue})
+			continue
+		}
+		if oldValue == newValue {
+			continue
+		}
+		if expandPayloads {
+			if format, payloadDiffs := DiffPayloads(oldValue, newValue); format != FORMAT_TEXT {
+				for _, d := range payloadDiffs {
+					d.Path = prefix + k + SEPARATOR + d.Path
+					differences = append(differences, d)
+				}
+				continue
+			}
+		}
+		differences = append(differences, Difference{Path: prefix + k, Kind: DIFF_CHANGED, OldValue: oldValue, NewValue: newValue})
+	}
+	for k, oldValue := range oldMap {
+		if _, ok := newMap[k]; !ok {
+			differences = append(differences, Difference{Path: prefix + k, Kind: DIFF_REMOVED, OldValue: oldValue})
+		}
+	}
+	return
+}
@@ -0,0 +1,72 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2021 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+
+package configuration
+
+import (
+	"fmt"
+)
+
+const (
+	INVENTORY_HOST_MULTIPLE_DETECTORS = "host assigned to multiple detectors"
+	INVENTORY_HOST_UNKNOWN            = "host not in inventory"
+	INVENTORY_DETECTOR_UNKNOWN        = "unknown detector"
+	INVENTORY_CARDS_UNREADABLE        = "unreadable CRU card list"
+	INVENTORY_CARD_MISSING_ENDPOINT   = "CRU card without endpoint"
+)
+
+// CRUCard is one endpoint of a CRU card installed in an FLP. A card with two
+// endpoints appears twice in the inventory, with the same serial.
+type CRUCard struct {
+	Type             string `json:"type" yaml:"type"`
+	PciAddress       string `json:"pciAddress" yaml:"pciAddress"`
+	Serial           string `json:"serial" yaml:"serial"`
+	Endpoint         string `json:"endpoint" yaml:"endpoint"`
+	Numa             string `json:"numa" yaml:"numa"`
+	Firmware         string `json:"firmware" yaml:"firmware"`
+	UserLogicVersion string `json:"userLogicVersion" yaml:"userLogicVersion"`
+}
+
+// InventoryIssue is an inconsistency found by CheckInventory.
+type InventoryIssue struct {
+	Kind     string `json:"kind" yaml:"kind"`
+	Hostname string `json:"hostname,omitempty" yaml:"hostname,omitempty"`
+	Detail   string `json:"detail,omitempty" yaml:"detail,omitempty"`
+}
+
+// InventoryError is returned when an inventory change is rejected, e.g.
+// because of an unknown detector code or a host which is not in the
+// inventory.
+type InventoryError struct {
+	Hostname string
+	Reason   string
+}
+
+func (e *InventoryError) Error() string {
+	if len(e.Hostname) == 0 {
+		return fmt.Sprintf("inventory change rejected: %s", e.Reason)
+	}
+	return fmt.Sprintf("inventory change rejected for host %s: %s", e.Hostname, e.Reason)
+}
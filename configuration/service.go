@@ -25,6 +25,9 @@
 package configuration
 
 import (
+	"context"
+
+	"github.com/AliceO2Group/Control/configuration/cfgbackend"
 	"github.com/AliceO2Group/Control/configuration/componentcfg"
 )
 
@@ -35,11 +38,13 @@ type RuntimeService interface {
 
 type Service interface {
 	RuntimeService
-	NewRunNumber() (runNumber uint32, err error)
+	NewRunNumber(environmentId string) (runNumber uint32, err error)
+	ListRunNumbers() (records []RunNumberRecord, err error)
 	GetDefaults() map[string]string
 	GetVars() map[string]string
 	GetComponentConfiguration(query *componentcfg.Query) (payload string, err error)
 	GetAndProcessComponentConfiguration(query *componentcfg.Query, varStack map[string]string) (payload string, err error)
+	ResolveComponentConfiguration(query *componentcfg.Query, varStack map[string]string, processTemplate bool) (payload string, entries []*componentcfg.Query, err error)
 
 	GetHostInventory(detector string) (hosts []string, err error)
 	ListComponents() (components []string, err error)
@@ -47,10 +52,25 @@ type Service interface {
 	ListComponentEntryHistory(query *componentcfg.Query) (entries []string, err error)
 
 	ImportComponentConfiguration(query *componentcfg.Query, payload string, newComponent bool, useVersioning bool) (existingComponentUpdated bool, existingEntryUpdated bool, newTimestamp int64, err error)
+	ValidateComponentConfiguration(query *componentcfg.Query, payload string) error
+	DiffComponentConfiguration(from *componentcfg.Query, to *componentcfg.Query) (format string, differences []componentcfg.Difference, err error)
+	RollbackComponentConfiguration(query *componentcfg.Query) (newTimestamp int64, err error)
+
+	StoreRunSnapshot(snapshot *componentcfg.RunSnapshot) error
+	GetRunSnapshot(runNumber uint32, withPayloads bool) (snapshot *componentcfg.RunSnapshot, err error)
+	DiffRunSnapshots(fromRunNumber uint32, toRunNumber uint32) (differences []componentcfg.Difference, err error)
 
 	GetDetectorForHost(hostname string) (string, error)
 	GetCRUCardsForHost(hostname string) (string, error)
 	GetEndpointsForCRUCard(hostname, cardSerial string) (string, error)
 
+	AddHost(hostname string, detector string) error
+	RemoveHost(hostname string) error
+	AssignHostToDetector(hostname string, detector string) error
+	SetCRUCard(hostname string, card *CRUCard) error
+	RemoveCRUCard(hostname string, cardSerial string, endpoint string) error
+	CheckInventory() (issues []InventoryIssue, err error)
+
 	RawGetRecursive(path string) (string, error)
+	Watch(ctx context.Context, path string) (<-chan cfgbackend.Change, error)
 }
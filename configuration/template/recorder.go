@@ -0,0 +1,114 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2021 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+
+package template
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/AliceO2Group/Control/configuration/componentcfg"
+)
+
+type ResolvingConfigurationService interface {
+	ConfigurationService
+	ResolveComponentConfiguration(query *componentcfg.Query, varStack map[string]string, processTemplate bool) (payload string, entries []*componentcfg.Query, err error)
+}
+
+// Recorder is a ConfigurationService which keeps track of every component
+// configuration entry resolved through it, and of the payloads which were
+// eventually rendered with them, so that what a run used can be snapshotted.
+type Recorder struct {
+	ResolvingConfigurationService
+
+	mu       sync.Mutex
+	entries  map[string]*componentcfg.Query
+	payloads map[string]map[string]string
+}
+
+func NewRecorder(confSvc ResolvingConfigurationService) *Recorder {
+	return &Recorder{
+		ResolvingConfigurationService: confSvc,
+		entries:                       make(map[string]*componentcfg.Query),
+		payloads:                      make(map[string]map[string]string),
+	}
+}
+
+func (r *Recorder) GetComponentConfiguration(query *componentcfg.Query) (payload string, err error) {
+	var entries []*componentcfg.Query
+	payload, entries, err = r.ResolveComponentConfiguration(query, nil, false)
+	r.recordEntries(entries)
+	return
+}
+
+func (r *Recorder) GetAndProcessComponentConfiguration(query *componentcfg.Query, varStack map[string]string) (payload string, err error) {
+	var entries []*componentcfg.Query
+	payload, entries, err = r.ResolveComponentConfiguration(query, varStack, true)
+	r.recordEntries(entries)
+	return
+}
+
+// If an entry is resolved more than once, the last version wins.
+func (r *Recorder) recordEntries(entries []*componentcfg.Query) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, entry := range entries {
+		r.entries[entry.WithoutTimestamp()] = entry
+	}
+}
+
+// RecordPayload keeps a copy of the rendered properties pushed to a task.
+func (r *Recorder) RecordPayload(rolePath string, properties map[string]string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	copied := make(map[string]string, len(properties))
+	for k, v := range properties {
+		copied[k] = v
+	}
+	r.payloads[rolePath] = copied
+}
+
+// Snapshot returns everything recorded so far as the snapshot of a run.
+func (r *Recorder) Snapshot(runNumber uint32, environmentId string, timestamp int64) *componentcfg.RunSnapshot {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	snapshot := &componentcfg.RunSnapshot{
+		RunNumber:     runNumber,
+		EnvironmentId: environmentId,
+		Timestamp:     timestamp,
+		Entries:       make([]*componentcfg.Query, 0, len(r.entries)),
+		Payloads:      make(map[string]map[string]string, len(r.payloads)),
+	}
+	for _, entry := range r.entries {
+		snapshot.Entries = append(snapshot.Entries, entry)
+	}
+	sort.Slice(snapshot.Entries, func(i, j int) bool {
+		return snapshot.Entries[i].Path() < snapshot.Entries[j].Path()
+	})
+	for rolePath, props := range r.payloads {
+		snapshot.Payloads[rolePath] = props
+	}
+	return snapshot
+}
@@ -39,6 +39,7 @@ import (
 	"github.com/AliceO2Group/Control/common/utils"
 	"github.com/AliceO2Group/Control/configuration/componentcfg"
 	"github.com/AliceO2Group/Control/core/task/schedutil"
+	"github.com/AliceO2Group/Control/core/the"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
 	_ "github.com/spf13/viper/remote"
@@ -54,9 +55,11 @@ func setDefaults() error {
 
 	viper.Set("component", "core")
 	viper.SetDefault("controlPort", 47102)
+	viper.SetDefault("controlPortRange", "30000-65535")
 	viper.SetDefault("coreConfigurationUri", "")
 	viper.SetDefault("consulBasePath", "o2/components/aliecs/ANY/any")
 	viper.SetDefault("coreWorkingDir", "/var/lib/o2/aliecs")
+	viper.SetDefault("dataPortRange", "9000-65535")
 	viper.SetDefault("defaultRepo", "github.com/AliceO2Group/ControlWorkflows/")
 	viper.SetDefault("executor", env("EXEC_BINARY", filepath.Join(exeDir, "o2-aliecs-executor")))
 	viper.SetDefault("executorCPU", envFloat("EXEC_CPU", "0.01"))
@@ -85,6 +88,14 @@ func setDefaults() error {
 	viper.SetDefault("mesosReviveWait", envDuration("REVIVE_WAIT", "1s"))
 	viper.SetDefault("mesosResourceTypeMetrics", false)
 	viper.SetDefault("mesosUrl", env("MESOS_MASTER_HTTP", "http://:5050/api/v1/scheduler"))
+	viper.SetDefault("offerProcessingConcurrency", envInt("OFFER_PROCESSING_CONCURRENCY", "16"))
+	viper.SetDefault("portQuarantineDuration", "60s")
+	viper.SetDefault("taskResourceSamplingInterval", "15s")
+	viper.SetDefault("taskLogMaxSize", "10MB")
+	viper.SetDefault("taskLogMaxFiles", 5)
+	viper.SetDefault("taskAdoption", false)
+	viper.SetDefault("taskAdoptionGracePeriod", "60s")
+	viper.SetDefault("taskAdoptionStateDir", "/var/lib/o2/executor/taskstate")
 	viper.SetDefault("mesosCredentials.username", "")
 	viper.SetDefault("mesosCredentials.passwordFile", "")
 	viper.SetDefault("metrics.address", env("LIBPROCESS_IP", "127.0.0.1"))
@@ -95,6 +106,12 @@ func setDefaults() error {
 	viper.SetDefault("veryVerbose", false)
 	viper.SetDefault("dumpWorkflows", false)
 	viper.SetDefault("configServiceUri", "apricot://127.0.0.1:47101")
+	viper.SetDefault("configCache", false)
+	viper.SetDefault("configCacheTTL", "30s")
+	viper.SetDefault("configCacheMaxEntries", 4096)
+	viper.SetDefault("configCacheBypass", false)
+	viper.SetDefault("runNumberRange", "")
+	viper.SetDefault("runNumberPrefix", "")
 	viper.SetDefault("bookkeepingBaseUri", "http://127.0.0.1:4000")
 	viper.SetDefault("dcsServiceEndpoint", "//127.0.0.1:50051")
 	viper.SetDefault("dcsServiceUseSystemProxy", false)
@@ -111,8 +128,10 @@ func setDefaults() error {
 
 func setFlags() error {
 	pflag.Int("controlPort", viper.GetInt("controlPort"), "Port of control server")
+	pflag.String("controlPortRange", viper.GetString("controlPortRange"), "Comma-separated port ranges from which task control ports are allocated (e.g. `30000-40000,50000-65535`)")
 	pflag.String("coreConfigurationUri", viper.GetString("coreConfigurationUri"), "Consul URI or filesystem path to JSON/YAML configuration payload to initialize core settings [EXPERT SETTING]")
 	pflag.String("coreWorkingDir", viper.GetString("coreWorkingDir"), "Path to a writable directory for runtime AliECS data")
+	pflag.String("dataPortRange", viper.GetString("dataPortRange"), "Comma-separated port ranges from which ports for inbound data channels are allocated")
 	pflag.String("executor", viper.GetString("executor"), "Full path to executor binary on Mesos agents")
 	pflag.Float64("executorCPU", viper.GetFloat64("executorCPU"), "CPU resources to consume per-executor")
 	pflag.Float64("executorMemory", viper.GetFloat64("executorMemory"), "Memory resources (MB) to consume per-executor")
@@ -136,6 +155,14 @@ func setFlags() error {
 	pflag.Duration("mesosReviveWait", viper.GetDuration("mesosReviveWait"), "Wait this long to fully recharge revive-burst quota")
 	pflag.Bool("mesosResourceTypeMetrics", viper.GetBool("mesosResourceTypeMetrics"), "Collect scalar resource metrics per-type")
 	pflag.String("mesosUrl", viper.GetString("mesosUrl"), "Mesos scheduler API URL")
+	pflag.Int("offerProcessingConcurrency", viper.GetInt("offerProcessingConcurrency"), "Maximum number of Mesos offers processed in parallel during task deployment")
+	pflag.Duration("portQuarantineDuration", viper.GetDuration("portQuarantineDuration"), "How long ports released by killed or failed tasks are kept out of allocation (not preserved across core restarts)")
+	pflag.Duration("taskResourceSamplingInterval", viper.GetDuration("taskResourceSamplingInterval"), "How often executors report the resource usage of each task (0 disables sampling)")
+	pflag.String("taskLogMaxSize", viper.GetString("taskLogMaxSize"), "Size after which executors rotate the stdout and stderr log files of each task (e.g. `10MB`)")
+	pflag.Int("taskLogMaxFiles", viper.GetInt("taskLogMaxFiles"), "Number of rotated stdout and stderr log files executors keep for each task")
+	pflag.Bool("taskAdoption", viper.GetBool("taskAdoption"), "Let controllable tasks outlive a crashed executor, so that a restarted executor can adopt them")
+	pflag.Duration("taskAdoptionGracePeriod", viper.GetDuration("taskAdoptionGracePeriod"), "How long the loss of the executor of an adoptable task is held back, waiting for a restarted executor to adopt it")
+	pflag.String("taskAdoptionStateDir", viper.GetString("taskAdoptionStateDir"), "Directory on each agent, outside the Mesos sandbox, where executors keep the state of adoptable tasks")
 	pflag.String("mesosCredentials.username", viper.GetString("mesosCredentials.username"), "Username for Mesos authentication")
 	pflag.String("mesosCredentials.passwordFile", viper.GetString("mesosCredentials.passwordFile"), "Path to file that contains the password for Mesos authentication")
 	pflag.String("metrics.address", viper.GetString("metrics.address"), "IP of metrics server")
@@ -145,7 +172,13 @@ func setFlags() error {
 	pflag.Bool("verbose", viper.GetBool("verbose"), "Verbose logging")
 	pflag.Bool("veryVerbose", viper.GetBool("veryVerbose"), "Very verbose logging")
 	pflag.Bool("dumpWorkflows", viper.GetBool("dumpWorkflows"), "Dump unprocessed and processed workflow files (`$PWD/wf-{,un}processed-<timestamp>.json`)")
-	pflag.String("configServiceUri", viper.GetString("configServiceUri"), "URI of the Apricot instance (`apricot://host:port`), Consul server (`consul://`) or YAML configuration file, entry point for all configuration")
+	pflag.String("configServiceUri", viper.GetString("configServiceUri"), "URI of the Apricot instance (`apricot://host:port`), Consul server (`consul://`), etcd cluster (`etcd://`), Git working copy (`git://`) or YAML configuration file, entry point for all configuration")
+	pflag.Bool("configCache", viper.GetBool("configCache"), "Cache component configuration and hardware lookups from a remote configServiceUri")
+	pflag.Duration("configCacheTTL", viper.GetDuration("configCacheTTL"), "Lifetime of cached configuration which is not pinned to a timestamp")
+	pflag.Int("configCacheMaxEntries", viper.GetInt("configCacheMaxEntries"), "Maximum number of cached configuration responses")
+	pflag.Bool("configCacheBypass", viper.GetBool("configCacheBypass"), "Send all configuration requests to apricot even if configCache is set, for debugging")
+	pflag.String("runNumberRange", viper.GetString("runNumberRange"), "Range of run numbers issued with a file:// configServiceUri (`first-last`)")
+	pflag.String("runNumberPrefix", viper.GetString("runNumberPrefix"), "Digits prepended to run numbers issued with a file:// configServiceUri")
 	pflag.String("dcsServiceEndpoint", viper.GetString("dcsServiceEndpoint"), "Endpoint of the DCS gRPC service (`host:port`)")
 	pflag.Bool("dcsServiceUseSystemProxy", viper.GetBool("dcsServiceUseSystemProxy"), "When true the https_proxy, http_proxy and no_proxy environment variables are obeyed")
 	pflag.String("ddSchedulerEndpoint", viper.GetString("ddSchedulerEndpoint"), "Endpoint of the DD scheduler gRPC service (`host:port`)")
@@ -202,22 +235,49 @@ func parseCoreConfig() error {
 		}
 	}
 
-	// aliecs configuration is assumed to live in aliecs/ANY/any/settings
-	payload, err := apricot.Instance().GetComponentConfiguration(&componentcfg.Query{
+	return readCoreSettings()
+}
+
+// aliecs configuration is assumed to live in aliecs/ANY/any/settings
+func coreSettingsQuery() *componentcfg.Query {
+	return &componentcfg.Query{
 		Component: "aliecs",
 		RunType:   apricotpb.RunType_ANY,
 		RoleName:  "any",
 		EntryKey:  viper.GetString("coreConfigEntry"), // defaults to "settings"
 		Timestamp: "",
-	})
+	}
+}
+
+// readCoreSettings runs at startup, before anything else reads viper.
+func readCoreSettings() error {
+	payload, err := apricot.Instance().GetComponentConfiguration(coreSettingsQuery())
 	if err != nil {
-		return errors.New(viper.GetString("configServiceUri") + ": could not acquire core configuration (possibly bad configServiceUri, expecting apricot://*, consul://* or file://*)")
+		return errors.New(viper.GetString("configServiceUri") + ": could not acquire core configuration (possibly bad configServiceUri, expecting apricot://*, consul://*, etcd://*, git://* or file://*)")
 	}
 
 	viper.SetConfigType("yaml")
 	if err := viper.ReadConfig(bytes.NewBuffer([]byte(payload))); err != nil {
-		return errors.New(coreCfgUri + ": " + err.Error())
+		return errors.New(viper.GetString("coreConfigurationUri") + ": " + err.Error())
+	}
+	return nil
+}
+
+// reloadCoreSettings runs whenever the settings entry changes in the
+// configuration backend. The new values go to a separate viper instance,
+// see the.CoreSettingInt and friends.
+func reloadCoreSettings() error {
+	payload, err := apricot.Instance().GetComponentConfiguration(coreSettingsQuery())
+	if err != nil {
+		return err
+	}
+
+	settings := viper.New()
+	settings.SetConfigType("yaml")
+	if err := settings.ReadConfig(bytes.NewBuffer([]byte(payload))); err != nil {
+		return err
 	}
+	the.SetCoreSettings(settings)
 	return nil
 }
 
@@ -0,0 +1,106 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2021 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+
+package core
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/AliceO2Group/Control/apricot/remote"
+	"github.com/AliceO2Group/Control/configuration/cfgbackend"
+	"github.com/AliceO2Group/Control/core/the"
+)
+
+const (
+	// Runtime KV of the core, see GetRuntimeEntry
+	aliecsRuntimePath = "o2/runtime/aliecs"
+	configWatchRetryInterval = 10*time.Second
+)
+
+// watchConfiguration follows the parts of the configuration tree which are
+// only read once at startup or at environment creation, so that we can pick
+// up changes made while the core is running.
+func watchConfiguration(ctx context.Context, state *globalState) {
+	// The apricot client cache bypass follows the reloaded settings
+	if remoteSvc, ok := the.ConfSvc().(*remote.RemoteService); ok {
+		remoteSvc.SetCacheBypass(func() bool {
+			return the.CoreSettingBool("configCacheBypass")
+		})
+	}
+
+	go watchConfigurationPath(ctx, coreSettingsQuery().AbsoluteWithoutTimestamp(), func(change cfgbackend.Change) {
+		err := reloadCoreSettings()
+		if err != nil {
+			log.WithError(err).Warn("cannot reload core settings")
+			return
+		}
+		log.WithField("keys", strings.Join(change.Keys, ", ")).
+			Info("core settings reloaded")
+	})
+
+	go watchConfigurationPath(ctx, aliecsRuntimePath, func(change cfgbackend.Change) {
+		var globalsChanged, revisionsChanged bool
+		for _, key := range change.Keys {
+			runtimeKey := strings.TrimPrefix(key, aliecsRuntimePath + "/")
+			switch {
+			case strings.HasPrefix(runtimeKey, "defaults/") || strings.HasPrefix(runtimeKey, "vars/"):
+				globalsChanged = true
+			case runtimeKey == "default_revision" || runtimeKey == "default_revisions":
+				revisionsChanged = true
+			}
+		}
+		if globalsChanged {
+			state.environments.RefreshGlobals()
+		}
+		if revisionsChanged {
+			the.RepoManager().ReloadDefaultRevisions()
+		}
+	})
+}
+
+// watchConfigurationPath calls handler for every change under path until
+// ctx is done, and re-establishes the watch if it is lost.
+func watchConfigurationPath(ctx context.Context, path string, handler func(change cfgbackend.Change)) {
+	for {
+		changes, err := the.ConfSvc().Watch(ctx, path)
+		if err != nil {
+			log.WithError(err).
+				WithField("path", path).
+				Warn("cannot watch configuration")
+		} else {
+			for change := range changes {
+				handler(change)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(configWatchRetryInterval):
+		}
+	}
+}
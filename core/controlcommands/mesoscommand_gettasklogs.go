@@ -0,0 +1,92 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2021 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+
+package controlcommands
+
+import "time"
+
+const getTaskLogsResponseTimeout = 15*time.Second
+
+// MesosCommand_GetTaskLogs asks an executor for the stdout or stderr of one
+// of its tasks. If Tail > 0 the executor replies with the last Tail lines,
+// otherwise with whatever was written since Cursor.
+type MesosCommand_GetTaskLogs struct {
+	MesosCommandBase
+
+	Stream        string                `json:"stream"`
+	Tail          int                   `json:"tail"`
+	Cursor        int64                 `json:"cursor"`
+}
+
+func (m *MesosCommand_GetTaskLogs) MakeSingleTarget(target MesosCommandTarget) (cmd MesosCommand) {
+	if m == nil {
+		return
+	}
+	mc := m.MesosCommandBase.MakeSingleTarget(target)
+	mcb, ok := mc.(*MesosCommandBase)
+	if !ok {
+		return
+	}
+
+	cmd = &MesosCommand_GetTaskLogs{
+		MesosCommandBase: *mcb,
+		Stream:           m.Stream,
+		Tail:             m.Tail,
+		Cursor:           m.Cursor,
+	}
+	return
+}
+
+func (m *MesosCommand_GetTaskLogs) IsMutator() bool {
+	return false
+}
+
+func NewMesosCommand_GetTaskLogs(receiver MesosCommandTarget, stream string, tail int, cursor int64) (*MesosCommand_GetTaskLogs) {
+	cmd := &MesosCommand_GetTaskLogs{
+		MesosCommandBase: *NewMesosCommand("MesosCommand_GetTaskLogs", []MesosCommandTarget{receiver}, PropertyMapsMap{}),
+		Stream:           stream,
+		Tail:             tail,
+		Cursor:           cursor,
+	}
+	cmd.ResponseTimeout = getTaskLogsResponseTimeout
+	return cmd
+}
+
+type MesosCommandResponse_GetTaskLogs struct {
+	MesosCommandResponseBase
+
+	TaskId       string `json:"taskId"`
+	Data         string `json:"data"`
+	Cursor       int64  `json:"cursor"`
+}
+
+func NewMesosCommandResponse_GetTaskLogs(mesosCommand *MesosCommand_GetTaskLogs, err error, taskId string, data []byte, cursor int64) *MesosCommandResponse_GetTaskLogs {
+	return &MesosCommandResponse_GetTaskLogs{
+		MesosCommandResponseBase: *NewMesosCommandResponse(mesosCommand, err),
+		TaskId:                   taskId,
+		Data:                     string(data),
+		Cursor:                   cursor,
+	}
+}
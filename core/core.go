@@ -74,6 +74,9 @@ func Run() error {
 
 	state.taskman.Start(ctx)
 
+	// Settings, runtime defaults and vars can change while we're running
+	watchConfiguration(ctx, state)
+
 	// Plugins need to start after taskman is running, because taskman provides the FID
 	integration.PluginsInstance().InitAll(state.taskman.GetFrameworkID())
 
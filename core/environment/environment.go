@@ -29,6 +29,7 @@ package environment
 import (
 	"errors"
 	"fmt"
+	"reflect"
 	"strings"
 	"sync"
 	"time"
@@ -66,6 +67,8 @@ type Environment struct {
 	GlobalDefaults gera.StringMap // From Consul
 	GlobalVars     gera.StringMap // From Consul
 	UserVars       gera.StringMap // From user input
+
+	configurationChanged bool // Consul defaults/vars no longer match the frozen ones
 	stateChangedCh chan *event.TasksStateChangedEvent
 	unsubscribe    chan struct{}
 	eventStream    Subscription
@@ -423,6 +426,27 @@ func (env *Environment) GetPath() string {
 	return ""
 }
 
+// ConfigurationChanged returns whether the global defaults or vars in the
+// configuration backend were modified since this environment was created.
+func (env *Environment) ConfigurationChanged() bool {
+	env.Mu.RLock()
+	defer env.Mu.RUnlock()
+	return env.configurationChanged
+}
+
+// compareGlobals checks the frozen global defaults and vars against the
+// current ones, and returns whether the result differs from the last check.
+func (env *Environment) compareGlobals(defaults map[string]string, vars map[string]string) bool {
+	env.Mu.Lock()
+	defer env.Mu.Unlock()
+
+	changed := !reflect.DeepEqual(env.GlobalDefaults.Raw(), defaults) ||
+		!reflect.DeepEqual(env.GlobalVars.Raw(), vars)
+	flipped := env.configurationChanged != changed
+	env.configurationChanged = changed
+	return flipped
+}
+
 func (env *Environment) GetCurrentRunNumber() (rn uint32) {
 	env.Mu.RLock()
 	defer env.Mu.RUnlock()
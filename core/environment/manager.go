@@ -31,12 +31,14 @@ import (
 	"strings"
 	"sync"
 
+	"github.com/AliceO2Group/Control/common"
 	"github.com/AliceO2Group/Control/common/controlmode"
 	"github.com/AliceO2Group/Control/common/event"
 	"github.com/AliceO2Group/Control/common/utils"
 	"github.com/AliceO2Group/Control/common/utils/uid"
 	"github.com/AliceO2Group/Control/core/task"
 	"github.com/AliceO2Group/Control/core/task/taskop"
+	"github.com/AliceO2Group/Control/core/the"
 	"github.com/AliceO2Group/Control/core/workflow"
 	pb "github.com/AliceO2Group/Control/executor/protos"
 	"github.com/sirupsen/logrus"
@@ -125,6 +127,7 @@ func (envs *Manager) CreateEnvironment(workflowPath string, userVars map[string]
 	env.workflow, err = envs.loadWorkflow(workflowPath, env.wfAdapter, workflowUserVars)
 	if err != nil {
 		err = fmt.Errorf("cannot load workflow template: %w", err)
+		the.DiscardConfRecorder(env.id)
 
 		envs.mu.Unlock()
 		return env.id, err
@@ -162,6 +165,9 @@ func (envs *Manager) CreateEnvironment(workflowPath string, userVars map[string]
 	// with <nil> which results to server.go to report back
 	// cannot get newly created environment: no environment with id <env id>
 	_ = envs.TeardownEnvironment(env.Id(), true/*force*/)
+	// Teardown only discards the recorder once all tasks are released, but
+	// nothing will be recorded for this environment anymore
+	the.DiscardConfRecorder(env.Id())
 
 	killedTasks, _, rlsErr := envs.taskman.KillTasks(envTasks.GetTaskIds())
 	if rlsErr != nil {
@@ -294,6 +300,7 @@ func (envs *Manager) TeardownEnvironment(environmentId uid.ID, force bool) error
 
 	env.sendEnvironmentEvent(&event.EnvironmentEvent{EnvironmentID: env.Id().String(), Message: "teardown complete", State: "DONE"})
 	delete(envs.m, environmentId)
+	the.DiscardConfRecorder(environmentId)
 	env.unsubscribeFromWfState()
 	return err
 }
@@ -304,6 +311,24 @@ func (envs *Manager) TeardownEnvironment(environmentId uid.ID, force bool) error
 	return envs.m[environmentId.Array()].cfg
 }*/
 
+// RefreshGlobals runs when the runtime defaults or vars change in the
+// configuration backend. Environments keep the values they were created
+// with, but are marked as outdated so that the user can find out.
+func (envs *Manager) RefreshGlobals() {
+	defaults := the.ConfSvc().GetDefaults()
+	vars := the.ConfSvc().GetVars()
+
+	envs.mu.RLock()
+	defer envs.mu.RUnlock()
+	for envId, env := range envs.m {
+		if env.compareGlobals(defaults, vars) {
+			log.WithField("partition", envId.String()).
+				WithField("configurationChanged", env.ConfigurationChanged()).
+				Info("global defaults and vars changed since environment creation")
+		}
+	}
+}
+
 func (envs *Manager) Ids() (keys []uid.ID) {
 	envs.mu.RLock()
 	defer envs.mu.RUnlock()
@@ -347,6 +372,97 @@ func (envs *Manager) handleDeviceEvent(evt event.DeviceEvent) {
 	}
 
 	switch evt.GetType() {
+	case pb.DeviceEventType_PARTIAL_FAILURE:
+		pf, ok := evt.(*event.PartialFailure)
+		if !ok {
+			return
+		}
+		taskId := evt.GetOrigin().TaskId
+		t := envs.taskman.GetTask(taskId.Value)
+		if t == nil {
+			log.WithPrefix("scheduler").Debug("cannot find task for DeviceEvent PARTIAL_FAILURE")
+			return
+		}
+		logFields := logrus.Fields{
+			"task": t.GetName(),
+			"errorCount": pf.ErrorCount,
+			"deviceCount": pf.DeviceCount,
+		}
+		if pf.ErrorCount > 0 {
+			log.WithPrefix("scheduler").
+				WithFields(logFields).
+				Error(pf.Message)
+		} else {
+			log.WithPrefix("scheduler").
+				WithFields(logFields).
+				Info(pf.Message)
+		}
+
+		// Expose the counts to the workflow and to environment subscribers
+		if parentRole, ok := t.GetParentRole().(workflow.Role); ok {
+			parentRole.SetRuntimeVars(map[string]string{
+				"devices.errorCount": strconv.Itoa(pf.ErrorCount),
+				"devices.count": strconv.Itoa(pf.DeviceCount),
+			})
+		}
+		env, err := envs.environment(t.GetEnvironmentId())
+		if err != nil {
+			log.WithPrefix("scheduler").WithError(err).Error("cannot find environment for DeviceEvent")
+			return
+		}
+		env.sendEnvironmentEvent(&event.EnvironmentEvent{
+			EnvironmentID: env.Id().String(),
+			State: env.CurrentState(),
+			Message: fmt.Sprintf("task %s: %s", t.GetName(), pf.Message),
+		})
+	case pb.DeviceEventType_TASK_HEALTH:
+		th, ok := evt.(*event.TaskHealth)
+		if !ok {
+			return
+		}
+		taskId := evt.GetOrigin().TaskId
+		t := envs.taskman.GetTask(taskId.Value)
+		if t == nil {
+			log.WithPrefix("scheduler").Debug("cannot find task for DeviceEvent TASK_HEALTH")
+			return
+		}
+		logFields := logrus.Fields{
+			"task": t.GetName(),
+			"check": th.CheckKey(),
+			"policy": th.Policy,
+			"failures": th.Failures,
+		}
+		var message string
+		if th.Healthy {
+			message = fmt.Sprintf("task %s: %s health check passing again", t.GetName(), th.Check)
+			log.WithPrefix("scheduler").
+				WithFields(logFields).
+				Info(message)
+		} else {
+			message = fmt.Sprintf("task %s: %s health check failed %d times: %s", t.GetName(), th.Check, th.Failures, th.Message)
+			log.WithPrefix("scheduler").
+				WithFields(logFields).
+				Warning(message)
+		}
+		if th.Policy == common.HealthPolicyWarn {
+			return
+		}
+
+		// Under the error policy, a failing task goes to ERROR like any other,
+		// and the environment takes it from there
+		if !th.Healthy && th.Policy == common.HealthPolicyError {
+			envs.taskman.MessageChannel <- task.NewTaskStateMessage(taskId.Value, "ERROR")
+		}
+		env, err := envs.environment(t.GetEnvironmentId())
+		if err != nil {
+			log.WithPrefix("scheduler").WithError(err).Error("cannot find environment for DeviceEvent")
+			return
+		}
+		env.sendEnvironmentEvent(&event.EnvironmentEvent{
+			EnvironmentID: env.Id().String(),
+			State: env.CurrentState(),
+			Message: message,
+		})
 	case pb.DeviceEventType_BASIC_TASK_TERMINATED:
 		if btt, ok := evt.(*event.BasicTaskTerminated); ok {
 			log.WithPrefix("scheduler").
@@ -372,6 +488,15 @@ func (envs *Manager) handleDeviceEvent(evt event.DeviceEvent) {
 						"taskResult.finalStatus": btt.FinalMesosState.String(),
 						"taskResult.timestamp": utils.NewUnixTimestamp(),
 					})
+					// Structured outputs end up in the varStack of the parent
+					// role, like the return value of a call
+					if len(btt.Outputs) > 0 {
+						log.WithPrefix("scheduler").
+							WithField("task", t.GetName()).
+							WithField("outputs", btt.Outputs).
+							Debug("basic task outputs received")
+						parentRole.SetRuntimeVars(btt.Outputs)
+					}
 
 					// If it's an update following a HOOK execution
 					if t.GetControlMode() == controlmode.HOOK {
@@ -47,6 +47,8 @@ func MakeTransition(taskman *task.Manager, optype pb.ControlEnvironmentRequest_O
 		return NewStopActivityTransition(taskman)
 	case pb.ControlEnvironmentRequest_RESET:
 		return NewResetTransition(taskman)
+	case pb.ControlEnvironmentRequest_RECOVER:
+		return NewRecoverTransition(taskman)
 	case pb.ControlEnvironmentRequest_GO_ERROR:
 		fallthrough
 	case pb.ControlEnvironmentRequest_NOOP:
@@ -0,0 +1,87 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2021 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package environment
+
+import (
+	"errors"
+	"github.com/AliceO2Group/Control/common/event"
+	"github.com/AliceO2Group/Control/core/task"
+	"github.com/AliceO2Group/Control/core/workflow"
+)
+
+func NewRecoverTransition(taskman *task.Manager) Transition {
+	return &RecoverTransition{
+		baseTransition: baseTransition{
+			name:    "RECOVER",
+			taskman: taskman,
+		},
+	}
+}
+
+// RecoverTransition brings all tasks of an environment in ERROR back to
+// STANDBY, so that the environment can be configured again without being
+// torn down. Tasks which aren't in ERROR are left alone. Where each task
+// actually ends up after an error is up to its transitioner, e.g. a FairMQ
+// device is walked back from whichever state it is stuck in, unless the
+// device itself reached ERROR, in which case it must be relaunched.
+type RecoverTransition struct {
+	baseTransition
+}
+
+func (t RecoverTransition) do(env *Environment) (err error) {
+	if env == nil {
+		return errors.New("cannot transition in NIL environment")
+	}
+
+	tasks := make(task.Tasks, 0)
+	workflow.LeafWalk(env.Workflow(), func(role workflow.Role) {
+		if role.GetState() == task.ERROR {
+			tasks = append(tasks, role.GetTasks()...)
+		}
+	})
+	if len(tasks) == 0 {
+		env.sendEnvironmentEvent(&event.EnvironmentEvent{EnvironmentID: env.Id().String(), State: "RECOVER"})
+		return
+	}
+
+	taskmanMessage := task.NewTransitionTaskMessage(
+						tasks,
+						task.ERROR.String(),
+						task.RECOVER.String(),
+						task.STANDBY.String(),
+						nil,
+						env.Id(),
+					)
+	t.taskman.MessageChannel <- taskmanMessage
+
+	incomingEv := <-env.stateChangedCh
+	// If some tasks failed to transition
+	if tasksStateErrors := incomingEv.GetTasksStateChangedError();  tasksStateErrors != nil {
+		return tasksStateErrors
+	}
+
+	env.sendEnvironmentEvent(&event.EnvironmentEvent{EnvironmentID: env.Id().String(), State: "RECOVER"})
+	return
+}
@@ -55,13 +55,26 @@ func (t StartActivityTransition) do(env *Environment) (err error) {
 	}
 
 	var runNumber uint32
-	runNumber, err = the.ConfSvc().NewRunNumber()
+	runNumber, err = the.ConfSvc().NewRunNumber(env.Id().String())
 	if err != nil {
 		return
 	}
 
 	log.WithField(infologger.Run, runNumber).Info("starting new run")
 
+	// The configuration snapshot is a record, not a precondition, so it is
+	// taken now but stored in the background: neither the time it takes to
+	// write it nor a failure to do so may delay or prevent the run start
+	snapshot := the.ConfRecorder(env.Id()).Snapshot(runNumber, env.Id().String(), time.Now().UnixNano() / 1e6)
+	go func() {
+		storeErr := the.ConfSvc().StoreRunSnapshot(snapshot)
+		if storeErr != nil {
+			log.WithField(infologger.Run, runNumber).
+				WithError(storeErr).
+				Warn("cannot store configuration snapshot")
+		}
+	}()
+
 	env.currentRunNumber = runNumber
 	args := controlcommands.PropertyMap{
 		"runNumber": strconv.FormatUint(uint64(runNumber), 10),
@@ -36,8 +36,11 @@ import (
 
 const (
 	Subsystem = "o2control_scheduler"
+	TaskSubsystem = "o2control_task"
 )
 
+var taskLabels = []string{"environment", "role", "class"}
+
 // TODO(jdef) time in between offers
 
 var (
@@ -106,11 +109,51 @@ var (
 		Name:      "tasks_launched_per_cycle",
 		Help:      "Number of tasks launched per-offers cycle (event).",
 	})
+	DeploymentPhaseLatency = prometheus.NewSummaryVec(prometheus.SummaryOpts{
+		Subsystem: Subsystem,
+		Name:      "deployment_phase_latency",
+		Help:      "Time spent in each phase of task deployment, by phase.",
+	}, []string{"phase"})
 	ArtifactDownloads = prometheus.NewCounter(prometheus.CounterOpts{
 		Subsystem: Subsystem,
 		Name:      "artifact_downloads",
 		Help:      "The number of artifacts served by the built-in http server.",
 	})
+	TaskCpuSeconds = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Subsystem: TaskSubsystem,
+		Name:      "cpu_seconds",
+		Help:      "User and system CPU time used by the task process tree.",
+	}, taskLabels)
+	TaskCpuUsage = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Subsystem: TaskSubsystem,
+		Name:      "cpu_usage_percent",
+		Help:      "CPU usage of the task process tree over the last sampling interval, 100 is one core.",
+	}, taskLabels)
+	TaskRssBytes = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Subsystem: TaskSubsystem,
+		Name:      "rss_bytes",
+		Help:      "Resident memory of the task process tree.",
+	}, taskLabels)
+	TaskOpenFds = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Subsystem: TaskSubsystem,
+		Name:      "open_fds",
+		Help:      "Open file descriptors in the task process tree.",
+	}, taskLabels)
+	TaskThreads = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Subsystem: TaskSubsystem,
+		Name:      "threads",
+		Help:      "Threads in the task process tree.",
+	}, taskLabels)
+	TaskReadBytes = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Subsystem: TaskSubsystem,
+		Name:      "read_bytes",
+		Help:      "Bytes read from storage by the task process tree.",
+	}, taskLabels)
+	TaskWriteBytes = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Subsystem: TaskSubsystem,
+		Name:      "write_bytes",
+		Help:      "Bytes written to storage by the task process tree.",
+	}, taskLabels)
 )
 
 var registerMetrics sync.Once
@@ -131,5 +174,13 @@ func Register() {
 		prometheus.MustRegister(OfferedResources)
 		prometheus.MustRegister(TasksLaunchedPerOfferCycle)
 		prometheus.MustRegister(ArtifactDownloads)
+		prometheus.MustRegister(DeploymentPhaseLatency)
+		prometheus.MustRegister(TaskCpuSeconds)
+		prometheus.MustRegister(TaskCpuUsage)
+		prometheus.MustRegister(TaskRssBytes)
+		prometheus.MustRegister(TaskOpenFds)
+		prometheus.MustRegister(TaskThreads)
+		prometheus.MustRegister(TaskReadBytes)
+		prometheus.MustRegister(TaskWriteBytes)
 	})
 }
@@ -397,6 +397,36 @@ func (manager *RepoManager) RefreshRepos() error {
 	return nil
 }
 
+// ReloadDefaultRevisions picks up default revisions which were changed in
+// the configuration backend behind our back, e.g. by an operator.
+func (manager *RepoManager) ReloadDefaultRevisions() {
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+
+	defaultRevision, err := manager.rService.GetDefaultRevision()
+	if err == nil && defaultRevision != "" && defaultRevision != manager.defaultRevision {
+		log.WithField("revision", defaultRevision).
+			Info("global default revision changed in configuration backend")
+		manager.defaultRevision = defaultRevision
+	}
+
+	revsMap, err := manager.rService.GetRepoDefaultRevisions()
+	if err != nil {
+		return
+	}
+	manager.defaultRevisions = revsMap
+	for _, repo := range manager.repoList {
+		revision, ok := revsMap[repo.GetIdentifier()]
+		if !ok || revision == repo.DefaultRevision {
+			continue
+		}
+		log.WithField("repo", repo.GetIdentifier()).
+			WithField("revision", revision).
+			Info("default revision changed in configuration backend")
+		repo.DefaultRevision = revision
+	}
+}
+
 func (manager *RepoManager) RefreshRepo(repoPath string) error {
 	manager.mutex.Lock()
 	defer manager.mutex.Unlock()
@@ -522,7 +552,6 @@ func (manager *RepoManager) SetGlobalDefaultRevision(revision string) error {
 		return err
 	}
 
-	viper.Set("globalDefaultRevision", revision)
 	manager.defaultRevision = revision
 	return nil
 }
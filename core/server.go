@@ -195,6 +195,7 @@ func (m *RpcServer) GetEnvironments(context.Context, *pb.GetEnvironmentsRequest)
 			Defaults:         env.GlobalDefaults.Raw(),
 			Vars:             env.GlobalVars.Raw(),
 			UserVars:         env.UserVars.Raw(),
+			ConfigurationChanged: env.ConfigurationChanged(),
 		}
 
 		r.Environments = append(r.Environments, e)
@@ -285,6 +286,7 @@ func (m *RpcServer) GetEnvironment(cxt context.Context, req *pb.GetEnvironmentRe
 			Vars: env.GlobalVars.Raw(),
 			UserVars: env.UserVars.Raw(),
 			NumberOfFlps: int32(len(env.GetFLPs())),
+			ConfigurationChanged: env.ConfigurationChanged(),
 		},
 		Workflow: workflowToRoleTree(env.Workflow()),
 	}
@@ -476,11 +478,68 @@ func (m *RpcServer) GetTask(cxt context.Context, req *pb.GetTaskRequest) (*pb.Ge
 			TaskPath: taskPath,
 			EnvId: task.GetEnvironmentId().String(),
 			Properties: task.GetProperties(),
+			ResourceUsage: resourceUsageToPbResourceUsage(task.GetResourceUsage()),
+			OccCapabilities: occCapabilitiesToPbOccCapabilities(task.GetOccCapabilities()),
 		},
 	}
 	return rep, nil
 }
 
+const (
+	taskLogFollowInterval = 1*time.Second
+	// The executor also caps a tail at 1MB, so that it fits in a single
+	// Mesos message and gRPC reply
+	taskLogMaxTailLines   = 10000
+)
+
+func (m *RpcServer) GetTaskLogs(req *pb.GetTaskLogsRequest, srv pb.Control_GetTaskLogsServer) error {
+	m.logMethod()
+
+	if m.state.taskman.GetTask(req.GetTaskId()) == nil {
+		return status.New(codes.NotFound, "task not found").Err()
+	}
+
+	tail := int(req.GetLines())
+	if tail <= 0 || tail > taskLogMaxTailLines {
+		tail = taskLogMaxTailLines
+	}
+	data, cursor, err := m.state.taskman.GetTaskLogs(req.GetTaskId(), req.GetStream(), tail, 0)
+	if err != nil {
+		return status.New(codes.Unavailable, err.Error()).Err()
+	}
+	if len(data) > 0 {
+		if err = srv.Send(&pb.GetTaskLogsReply{Data: data}); err != nil {
+			return err
+		}
+	}
+	if !req.GetFollow() {
+		return nil
+	}
+
+	for {
+		select {
+		case <-srv.Context().Done():
+			return nil
+		case <-time.After(taskLogFollowInterval):
+		}
+
+		data, cursor, err = m.state.taskman.GetTaskLogs(req.GetTaskId(), req.GetStream(), 0, cursor)
+		if err != nil {
+			if m.state.taskman.GetTask(req.GetTaskId()) == nil {
+				// the task is gone, nothing more to follow
+				return nil
+			}
+			return status.New(codes.Unavailable, err.Error()).Err()
+		}
+		if len(data) == 0 {
+			continue
+		}
+		if err = srv.Send(&pb.GetTaskLogsReply{Data: data}); err != nil {
+			return err
+		}
+	}
+}
+
 func (m *RpcServer) CleanupTasks(cxt context.Context, req *pb.CleanupTasksRequest) (*pb.CleanupTasksReply, error) {
 	m.logMethod()
 	idsToKill := req.GetTaskIds()
@@ -585,7 +644,7 @@ func (m *RpcServer) ListRepos(cxt context.Context, req *pb.ListReposRequest) (*p
 		repoInfos[i] = &pb.RepoInfo{Name: repoName, Default: repo.Default, DefaultRevision: repo.DefaultRevision}
 	}
 
-	return &pb.ListReposReply{Repos: repoInfos, GlobalDefaultRevision: viper.GetString("globalDefaultRevision")}, nil
+	return &pb.ListReposReply{Repos: repoInfos, GlobalDefaultRevision: the.RepoManager().GetDefaultRevision()}, nil
 }
 
 func (m *RpcServer) AddRepo(cxt context.Context, req *pb.AddRepoRequest) (*pb.AddRepoReply, error) {
@@ -31,6 +31,7 @@ import (
 	"github.com/AliceO2Group/Control/core/repos"
 
 	"github.com/AliceO2Group/Control/common"
+	"github.com/AliceO2Group/Control/common/event"
 	"github.com/AliceO2Group/Control/core/protos"
 	"github.com/AliceO2Group/Control/core/task/channel"
 
@@ -54,6 +55,39 @@ func commandInfoToPbCommandInfo(c *common.TaskCommandInfo) (pci *pb.CommandInfo)
 	return
 }
 
+func resourceUsageToPbResourceUsage(u *event.ResourceUsage) (pru *pb.TaskResourceUsage) {
+	if u == nil {
+		return
+	}
+	pru = &pb.TaskResourceUsage{
+		SampledAt: u.SampledAt.UnixNano() / 1e6,
+		Processes: int32(u.Processes),
+		CpuSeconds: u.CpuSeconds,
+		CpuPercent: u.CpuPercent,
+		RssBytes: u.RssBytes,
+		OpenFds: int32(u.OpenFds),
+		Threads: int32(u.Threads),
+		ReadBytes: u.ReadBytes,
+		WriteBytes: u.WriteBytes,
+	}
+	return
+}
+
+func occCapabilitiesToPbOccCapabilities(c *event.OccCapabilities) (poc *pb.OccCapabilities) {
+	if c == nil {
+		return
+	}
+	poc = &pb.OccCapabilities{
+		Transport: c.Transport,
+		StateStream: c.StateStream,
+		Ptree: c.Ptree,
+		PropertyFormats: c.PropertyFormats,
+		Version: c.Version,
+		Negotiated: c.Negotiated,
+	}
+	return
+}
+
 func inboundChannelsToPbChannels(chs []channel.Inbound) (pchs []*pb.ChannelInfo) {
 	if chs == nil {
 		return
@@ -137,6 +171,14 @@ func taskToShortTaskInfo(t *task.Task, taskman *task.Manager) (sti *pb.ShortTask
 		sti.Status = parentRole.GetStatus().String()
 		sti.State = parentRole.GetState().String()
 	}
+	if phase, elapsed := t.GetStartupProgress(); len(phase) > 0 {
+		sti.StartupPhase = phase
+		sti.StartupElapsed = int64(elapsed.Seconds())
+	}
+	if healthy, message := t.GetHealth(); !healthy {
+		sti.Unhealthy = true
+		sti.HealthMessage = message
+	}
 	return
 }
 
@@ -0,0 +1,166 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2021 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+
+package task
+
+import (
+	"sync"
+	"time"
+
+	"github.com/AliceO2Group/Control/core/the"
+	"github.com/mesos/mesos-go/api/v1/lib"
+	"github.com/sirupsen/logrus"
+)
+
+// adoptionGrace holds back the terminal status updates Mesos sends for
+// adoptable tasks when their executor goes away, giving a restarted
+// executor the chance to adopt them and report them as running again.
+type adoptionGrace struct {
+	mu       sync.Mutex
+	pending  map[string]*time.Timer
+	expired  map[string]struct{}
+}
+
+func newAdoptionGrace() *adoptionGrace {
+	return &adoptionGrace{
+		pending: make(map[string]*time.Timer),
+		expired: make(map[string]struct{}),
+	}
+}
+
+// hold returns true if the status update for taskId must wait, in which
+// case onExpiry runs after grace unless the task is adopted in the meantime.
+// Once the grace period is over, the next call for taskId returns false so
+// that the update can go through.
+func (a *adoptionGrace) hold(taskId string, grace time.Duration, onExpiry func()) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if _, ok := a.expired[taskId]; ok {
+		delete(a.expired, taskId)
+		return false
+	}
+	if _, ok := a.pending[taskId]; ok {
+		// duplicate update, the first one is already waiting
+		return true
+	}
+	a.pending[taskId] = time.AfterFunc(grace, func() {
+		a.mu.Lock()
+		if _, ok := a.pending[taskId]; !ok {
+			a.mu.Unlock()
+			return
+		}
+		delete(a.pending, taskId)
+		a.expired[taskId] = struct{}{}
+		a.mu.Unlock()
+		onExpiry()
+	})
+	return true
+}
+
+// cancel drops the held update for taskId, if any, and tells whether there
+// was one.
+func (a *adoptionGrace) cancel(taskId string) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	timer, ok := a.pending[taskId]
+	if ok {
+		timer.Stop()
+		delete(a.pending, taskId)
+	}
+	return ok
+}
+
+// isExecutorLoss tells whether the status update was sent by the agent
+// because the task's executor is gone, rather than by the executor itself.
+func isExecutorLoss(status mesos.TaskStatus) bool {
+	switch status.GetState() {
+	case mesos.TASK_FAILED, mesos.TASK_LOST, mesos.TASK_GONE:
+	default:
+		return false
+	}
+	switch status.GetReason() {
+	case mesos.REASON_EXECUTOR_TERMINATED,
+		mesos.REASON_EXECUTOR_UNREGISTERED,
+		mesos.REASON_EXECUTOR_REREGISTRATION_TIMEOUT:
+		return true
+	}
+	return false
+}
+
+// holdForAdoption returns true if the status update is the loss of the
+// executor of an adoptable task, in which case it is processed only if the
+// task isn't adopted within taskAdoptionGracePeriod.
+func (m *Manager) holdForAdoption(status mesos.TaskStatus) bool {
+	if !isExecutorLoss(status) {
+		return false
+	}
+	taskId := status.GetTaskID().Value
+	t := m.GetTask(taskId)
+	if t == nil || t.GetTaskCommandInfo() == nil || !t.GetTaskCommandInfo().Adoptable {
+		return false
+	}
+	grace := the.CoreSettingDuration("taskAdoptionGracePeriod")
+	if grace <= 0 {
+		return false
+	}
+
+	held := m.adoptionGrace.hold(taskId, grace, func() {
+		log.WithPrefix("taskman").
+			WithField("taskId", taskId).
+			WithField("grace", grace.String()).
+			Warning("task not adopted by a restarted executor within grace period")
+		_ = m.handleMessage(NewTaskStatusMessage(status))
+	})
+	if held {
+		log.WithPrefix("taskman").
+			WithFields(logrus.Fields{
+				"taskId": taskId,
+				"state": status.GetState().String(),
+				"reason": status.GetReason().String(),
+				"grace": grace.String(),
+			}).
+			Info("executor of adoptable task lost, waiting for the task to be adopted")
+	}
+	return held
+}
+
+// taskAdopted must be called on TASK_RUNNING, and takes note of the new
+// executor if the task was adopted after its executor was lost.
+func (m *Manager) taskAdopted(status mesos.TaskStatus) {
+	taskId := status.GetTaskID().Value
+	if !m.adoptionGrace.cancel(taskId) {
+		return
+	}
+	t := m.GetTask(taskId)
+	if t != nil && status.GetExecutorID() != nil {
+		t.executorId = status.GetExecutorID().Value
+	}
+	log.WithPrefix("taskman").
+		WithField("taskId", taskId).
+		WithField("executorId", status.GetExecutorID().GetValue()).
+		Info("task adopted by restarted executor")
+}
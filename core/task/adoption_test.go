@@ -0,0 +1,102 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2021 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+
+package task
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mesos/mesos-go/api/v1/lib"
+)
+
+func TestIsExecutorLoss(t *testing.T) {
+	reason := func(r mesos.TaskStatus_Reason) *mesos.TaskStatus_Reason { return &r }
+	cases := []struct {
+		name   string
+		status mesos.TaskStatus
+		want   bool
+	}{
+		{"executor terminated", mesos.TaskStatus{State: mesos.TASK_FAILED.Enum(), Reason: reason(mesos.REASON_EXECUTOR_TERMINATED)}, true},
+		{"executor unregistered", mesos.TaskStatus{State: mesos.TASK_LOST.Enum(), Reason: reason(mesos.REASON_EXECUTOR_UNREGISTERED)}, true},
+		{"task failed", mesos.TaskStatus{State: mesos.TASK_FAILED.Enum(), Reason: reason(mesos.REASON_COMMAND_EXECUTOR_FAILED)}, false},
+		{"no reason", mesos.TaskStatus{State: mesos.TASK_FAILED.Enum()}, false},
+		{"killed", mesos.TaskStatus{State: mesos.TASK_KILLED.Enum(), Reason: reason(mesos.REASON_EXECUTOR_TERMINATED)}, false},
+	}
+	for _, c := range cases {
+		if got := isExecutorLoss(c.status); got != c.want {
+			t.Errorf("%s: expected %v, got %v", c.name, c.want, got)
+		}
+	}
+}
+
+func TestAdoptionGraceReleasesAfterExpiry(t *testing.T) {
+	a := newAdoptionGrace()
+	expired := make(chan struct{}, 2)
+	onExpiry := func() { expired <- struct{}{} }
+
+	if !a.hold("task", 10*time.Millisecond, onExpiry) {
+		t.Fatal("status not held")
+	}
+	if !a.hold("task", 10*time.Millisecond, onExpiry) {
+		t.Error("duplicate status not held")
+	}
+	select {
+	case <-expired:
+	case <-time.After(time.Second):
+		t.Fatal("grace period did not expire")
+	}
+	// the replayed update must go through
+	if a.hold("task", 10*time.Millisecond, onExpiry) {
+		t.Error("status held again after grace period")
+	}
+	select {
+	case <-expired:
+		t.Error("expiry ran more than once")
+	case <-time.After(50*time.Millisecond):
+	}
+}
+
+func TestAdoptionGraceCancel(t *testing.T) {
+	a := newAdoptionGrace()
+	expired := make(chan struct{}, 1)
+
+	if a.cancel("task") {
+		t.Error("cancelled a status which was not held")
+	}
+	a.hold("task", 20*time.Millisecond, func() { expired <- struct{}{} })
+	if !a.cancel("task") {
+		t.Error("held status not cancelled")
+	}
+	select {
+	case <-expired:
+		t.Error("expiry ran for an adopted task")
+	case <-time.After(50*time.Millisecond):
+	}
+	if !a.hold("task", 20*time.Millisecond, func() {}) {
+		t.Error("new executor loss not held after adoption")
+	}
+	a.cancel("task")
+}
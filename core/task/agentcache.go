@@ -27,14 +27,29 @@ package task
 import (
 	"github.com/mesos/mesos-go/api/v1/lib"
 	"sync"
+	"time"
 	"github.com/AliceO2Group/Control/core/task/constraint"
 )
 
 type AgentCache struct {
 	mu sync.RWMutex
 	store map[mesos.AgentID]AgentCacheInfo
+	ports map[mesos.AgentID]portLedger
 }
 
+// portLease records which task was given a port on an agent.
+// While the task is alive releasedAt is zero, after the task is gone the
+// lease is kept until its quarantine expires, because the process might
+// still be holding on to the port.
+// The ledger lives in memory only: after a core restart it starts out empty,
+// so ports released shortly before the restart are no longer quarantined.
+type portLease struct {
+	taskId     string
+	releasedAt time.Time
+}
+
+type portLedger map[uint64]portLease
+
 type AgentCacheInfo struct{
 	AgentId    mesos.AgentID
 	Attributes constraint.Attributes
@@ -76,4 +91,80 @@ func (ac *AgentCache) Count() (count int) {
 		return 0
 	}
 	return len(ac.store)
-}
\ No newline at end of file
+}
+
+// ReservePorts records in the agent's port ledger that the given ports were
+// allocated to a task.
+func (ac *AgentCache) ReservePorts(id mesos.AgentID, taskId string, ports ...uint64) {
+	if ac == nil {
+		return
+	}
+	ac.mu.Lock()
+	defer ac.mu.Unlock()
+
+	if ac.ports == nil {
+		ac.ports = make(map[mesos.AgentID]portLedger)
+	}
+	ledger, ok := ac.ports[id]
+	if !ok {
+		ledger = make(portLedger)
+		ac.ports[id] = ledger
+	}
+	for _, port := range ports {
+		ledger[port] = portLease{taskId: taskId}
+	}
+}
+
+// ReleasePorts frees all the ports held by a task on an agent.
+// If quarantine is true, the ports stay unavailable until the quarantine
+// period passed to UnavailablePorts has elapsed.
+func (ac *AgentCache) ReleasePorts(id mesos.AgentID, taskId string, quarantine bool) {
+	if ac == nil {
+		return
+	}
+	ac.mu.Lock()
+	defer ac.mu.Unlock()
+
+	ledger, ok := ac.ports[id]
+	if !ok {
+		return
+	}
+	now := time.Now()
+	for port, lease := range ledger {
+		if lease.taskId != taskId || !lease.releasedAt.IsZero() {
+			continue
+		}
+		if quarantine {
+			lease.releasedAt = now
+			ledger[port] = lease
+		} else {
+			delete(ledger, port)
+		}
+	}
+}
+
+// UnavailablePorts returns the ports on an agent that are either held by a
+// live task or still in quarantine. Leases whose quarantine has expired are
+// dropped from the ledger.
+func (ac *AgentCache) UnavailablePorts(id mesos.AgentID, quarantine time.Duration) (ports Ranges) {
+	ports = make(Ranges, 0)
+	if ac == nil {
+		return
+	}
+	ac.mu.Lock()
+	defer ac.mu.Unlock()
+
+	ledger, ok := ac.ports[id]
+	if !ok {
+		return
+	}
+	now := time.Now()
+	for port, lease := range ledger {
+		if !lease.releasedAt.IsZero() && now.Sub(lease.releasedAt) >= quarantine {
+			delete(ledger, port)
+			continue
+		}
+		ports = append(ports, Range{Begin: port, End: port})
+	}
+	return
+}
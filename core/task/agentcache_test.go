@@ -0,0 +1,71 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2021 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+
+package task
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/mesos/mesos-go/api/v1/lib"
+)
+
+func TestAgentCachePortLedgerConcurrentAccess(t *testing.T) {
+	ac := &AgentCache{}
+	agent := mesos.AgentID{Value: "agent-1"}
+
+	// release and query on a fresh cache while another goroutine creates
+	// the ledger must not race on it
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(3)
+		port := uint64(47000 + i)
+		go func() {
+			defer wg.Done()
+			ac.ReservePorts(agent, "task", port)
+		}()
+		go func() {
+			defer wg.Done()
+			ac.ReleasePorts(agent, "other-task", true)
+		}()
+		go func() {
+			defer wg.Done()
+			_ = ac.UnavailablePorts(agent, time.Minute)
+		}()
+	}
+	wg.Wait()
+
+	if ports := ac.UnavailablePorts(agent, time.Minute); len(ports) != 8 {
+		t.Fatalf("expected 8 unavailable ports, got %d", len(ports))
+	}
+	ac.ReleasePorts(agent, "task", true)
+	if ports := ac.UnavailablePorts(agent, time.Minute); len(ports) != 8 {
+		t.Fatalf("expected 8 quarantined ports, got %d", len(ports))
+	}
+	if ports := ac.UnavailablePorts(agent, 0); len(ports) != 0 {
+		t.Fatalf("expected expired quarantine to free all ports, got %d", len(ports))
+	}
+}
@@ -44,8 +44,12 @@ type Class struct {
 	Defaults    gera.StringMap          `yaml:"defaults"`
 	Control     struct {
 		Mode    controlmode.ControlMode `yaml:"mode"`
+		Startup common.StartupProbe     `yaml:"startup"`
+		Kill    common.KillSequence     `yaml:"kill"`
+		Health  common.HealthChecks     `yaml:"health"`
 	}                                   `yaml:"control"`
 	Command     *common.CommandInfo     `yaml:"command"`
+	Isolation   common.Isolation        `yaml:"isolation"`
 	Wants       ResourceWants           `yaml:"wants"`
 	Bind        []channel.Inbound       `yaml:"bind"`
 	Properties  gera.StringMap          `yaml:"properties"`
@@ -61,8 +65,12 @@ func (c *Class) UnmarshalYAML(unmarshal func(interface{}) error) (err error) {
 		Defaults    map[string]string       `yaml:"defaults"`
 		Control     struct {
 			Mode    controlmode.ControlMode `yaml:"mode"`
+			Startup common.StartupProbe     `yaml:"startup"`
+			Kill    common.KillSequence     `yaml:"kill"`
+			Health  common.HealthChecks     `yaml:"health"`
 		}                                   `yaml:"control"`
 		Command     *common.CommandInfo     `yaml:"command"`
+		Isolation   common.Isolation        `yaml:"isolation"`
 		Wants       ResourceWants           `yaml:"wants"`
 		Bind        []channel.Inbound       `yaml:"bind"`
 		Properties  map[string]string       `yaml:"properties"`
@@ -87,6 +95,7 @@ func (c *Class) UnmarshalYAML(unmarshal func(interface{}) error) (err error) {
 			Defaults:   gera.MakeStringMapWithMap(aux.Defaults),
 			Control:    aux.Control,
 			Command:    aux.Command,
+			Isolation:  aux.Isolation,
 			Wants:      aux.Wants,
 			Bind:       aux.Bind,
 			Properties: gera.MakeStringMapWithMap(aux.Properties),
@@ -104,12 +113,16 @@ func (c *Class) MarshalYAML() (interface{}, error) {
 		Defaults    map[string]string       `yaml:"defaults,omitempty"`
 		Control  struct {
 			Mode    string                  `yaml:"mode"`
+			Startup *common.StartupProbe    `yaml:"startup,omitempty"`
+			Kill    *common.KillSequence    `yaml:"kill,omitempty"`
+			Health  common.HealthChecks     `yaml:"health,omitempty"`
 		}                                   `yaml:"control"`
 		Wants       ResourceWants           `yaml:"wants"`
 		Bind        []channel.Inbound       `yaml:"bind,omitempty"`
 		Properties  map[string]string       `yaml:"properties,omitempty"`
 		Constraints []constraint.Constraint `yaml:"constraints,omitempty"`
 		Command     *common.CommandInfo     `yaml:"command"`
+		Isolation   *common.Isolation       `yaml:"isolation,omitempty"`
 	}
 
 	aux := _class{
@@ -122,6 +135,20 @@ func (c *Class) MarshalYAML() (interface{}, error) {
 		Command:     c.Command,
 	}
 
+	if !c.Control.Startup.Equals(&common.StartupProbe{}) {
+		startup := c.Control.Startup
+		aux.Control.Startup = &startup
+	}
+	if !c.Control.Kill.Equals(&common.KillSequence{}) {
+		kill := c.Control.Kill
+		aux.Control.Kill = &kill
+	}
+	aux.Control.Health = c.Control.Health
+	if c.Isolation.IsEnabled() {
+		isolation := c.Isolation
+		aux.Isolation = &isolation
+	}
+
 	if c.Control.Mode == controlmode.FAIRMQ {
 		aux.Control.Mode = "fairmq"
 	} else if c.Control.Mode == controlmode.BASIC {
@@ -152,6 +179,10 @@ type ResourceWants struct {
 	Cpu     *float64                `yaml:"cpu"`
 	Memory  *float64                `yaml:"memory"`
 	Ports   Ranges                  `yaml:"ports,omitempty"`
+	// Any other named Mesos resource, i.e. an amount for scalar resources
+	// (e.g. gpus, hugepages-1Gi) or an item count for set resources
+	// (e.g. cru_endpoints)
+	Resources map[string]float64    `yaml:"resources,omitempty"`
 }
 
 func (rw *ResourceWants) UnmarshalYAML(unmarshal func(interface{}) error) (err error) {
@@ -159,6 +190,7 @@ func (rw *ResourceWants) UnmarshalYAML(unmarshal func(interface{}) error) (err e
 		Cpu     *string                 `yaml:"cpu"`
 		Memory  *string                 `yaml:"memory"`
 		Ports   *string                 `yaml:"ports"`
+		Resources map[string]string     `yaml:"resources"`
 	}
 	aux := _resourceWants{}
 	err = unmarshal(&aux)
@@ -190,17 +222,48 @@ func (rw *ResourceWants) UnmarshalYAML(unmarshal func(interface{}) error) (err e
 		}
 		rw.Ports = ranges
 	}
+	if len(aux.Resources) > 0 {
+		rw.Resources = make(map[string]float64, len(aux.Resources))
+		for name, value := range aux.Resources {
+			var amount float64
+			amount, err = strconv.ParseFloat(value, 64)
+			if err != nil {
+				return fmt.Errorf("bad amount for wanted resource %s: %w", name, err)
+			}
+			if amount < 0 {
+				return fmt.Errorf("bad amount for wanted resource %s: %s", name, value)
+			}
+			rw.Resources[name] = amount
+		}
+	}
 	return
 }
 
+func (rw ResourceWants) resourcesEqual(other ResourceWants) bool {
+	if len(rw.Resources) != len(other.Resources) {
+		return false
+	}
+	for name, amount := range rw.Resources {
+		if otherAmount, ok := other.Resources[name]; !ok || otherAmount != amount {
+			return false
+		}
+	}
+	return true
+}
+
 func (c *Class) Equals(other *Class) (response bool) {
 	if c == nil || other == nil {
 		return false
 	}
 	response = c.Command.Equals(other.Command) &&
+		c.Control.Startup.Equals(&other.Control.Startup) &&
+		c.Control.Kill.Equals(&other.Control.Kill) &&
+		c.Control.Health.Equals(other.Control.Health) &&
+		c.Isolation.Equals(&other.Isolation) &&
 		*c.Wants.Cpu == *other.Wants.Cpu &&
 		*c.Wants.Memory == *other.Wants.Memory &&
-		c.Wants.Ports.Equals(other.Wants.Ports)
+		c.Wants.Ports.Equals(other.Wants.Ports) &&
+		c.Wants.resourcesEqual(other.Wants)
 	return
 }
 
@@ -31,6 +31,7 @@ import (
 	"io/ioutil"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/AliceO2Group/Control/common/event"
 	"github.com/AliceO2Group/Control/common/gera"
@@ -79,6 +80,7 @@ type Manager struct {
 	schedulerState     *schedulerState
 	internalEventCh    chan<- event.Event
 	ackKilledTasks     *safeAcks
+	adoptionGrace      *adoptionGrace
 }
 
 func NewManager(shutdown func(),
@@ -122,6 +124,7 @@ func NewManager(shutdown func(),
 	taskman.tasksToDeploy = taskman.schedulerState.tasksToDeploy
 	taskman.reviveOffersTrg = taskman.schedulerState.reviveOffersTrg
 	taskman.ackKilledTasks = newAcks()
+	taskman.adoptionGrace = newAdoptionGrace()
 
 	schedulerState.setupCli()
 
@@ -352,8 +355,13 @@ func (m *Manager) acquireTasks(envId uid.ID, taskDescriptors Descriptors) (err e
 		// First we ask Mesos to revive offers and block until done, then upon receiving
 		// the offers, we ask Mesos to run the required roles - if any.
 
+		deployStart := time.Now()
 		m.reviveOffersTrg <- struct{}{} // signal scheduler to revive offers
 		<- m.reviveOffersTrg            // we only continue when it's done
+		m.schedulerState.metricsAPI.deploymentPhaseLatency(time.Since(deployStart).Seconds(), "revive")
+
+		// the deploy phase starts once the revive is done
+		deployStart = time.Now()
 
 		m.tasksToDeploy <- tasksToRun // blocks until received
 		log.WithField("environmentId", envId).
@@ -363,6 +371,7 @@ func (m *Manager) acquireTasks(envId uid.ID, taskDescriptors Descriptors) (err e
 		//       reservation for that mesos-role on behalf of our scheduler
 
 		deployedTasks = <- m.resourceOffersDone
+		m.schedulerState.metricsAPI.deploymentPhaseLatency(time.Since(deployStart).Seconds(), "deploy")
 		log.WithField("tasks", deployedTasks).
 			Debug("resourceOffers is done, new tasks running")
 
@@ -447,6 +456,7 @@ func (m *Manager) releaseTask(envId uid.ID, task *Task) error {
 		return TaskLockedError{taskErrorBase: taskErrorBase{taskId: task.name}, envId: envId}
 	}
 
+	task.forgetResourceUsage()
 	task.SetParent(nil)
 
 	return nil
@@ -496,6 +506,10 @@ func (m *Manager) configureTasks(envId uid.ID, tasks Tasks) error {
 	if err != nil {
 		return err
 	}
+	recorder := the.ConfRecorder(envId)
+	for _, task := range tasks {
+		recorder.RecordPayload(task.GetParent().GetPath(), args[task.GetMesosCommandTarget()])
+	}
 	log.WithField("map", pp.Sprint(args)).Debug("pushing configuration to tasks")
 
 	cmd := controlcommands.NewMesosCommand_Transition(receivers, src, event, dest, args)
@@ -592,6 +606,34 @@ func (m *Manager) TriggerHooks(tasks Tasks) error {
 	return nil
 }
 
+// GetTaskLogs fetches the stdout or stderr of a task from its executor.
+// If tail > 0 the last tail lines are returned, otherwise whatever the task
+// wrote since cursor. The returned cursor can be passed to subsequent calls
+// to follow the output.
+// Log retrieval doesn't change the state of any task, so it goes straight
+// to the servent instead of waiting in the command queue.
+func (m *Manager) GetTaskLogs(taskId string, stream string, tail int, cursor int64) (data string, next int64, err error) {
+	t := m.GetTask(taskId)
+	if t == nil {
+		return "", 0, fmt.Errorf("task %s not found", taskId)
+	}
+	receiver := t.GetMesosCommandTarget()
+	cmd := controlcommands.NewMesosCommand_GetTaskLogs(receiver, stream, tail, cursor)
+
+	response, err := m.schedulerState.servent.RunCommand(cmd, receiver)
+	if err != nil {
+		return "", 0, err
+	}
+	res, ok := response.(*controlcommands.MesosCommandResponse_GetTaskLogs)
+	if !ok {
+		return "", 0, errors.New("unexpected response to task log request")
+	}
+	if errText := res.Err().Error(); len(strings.TrimSpace(errText)) != 0 {
+		return "", 0, errors.New(errText)
+	}
+	return res.Data, res.Cursor, nil
+}
+
 func (m *Manager) GetTaskClass(name string) (b *Class) {
 	if m == nil {
 		return
@@ -664,6 +706,7 @@ func (m *Manager) updateTaskStatus(status *mesos.TaskStatus) {
 		log.WithField("taskId", taskId).
 			WithField("name", taskPtr.GetName()).
 			Debug("task running")
+		taskPtr.clearStartupProgress()
 		taskPtr.status = ACTIVE
 		if taskPtr.GetParent() != nil {
 			taskPtr.GetParent().UpdateStatus(ACTIVE)
@@ -673,6 +716,11 @@ func (m *Manager) updateTaskStatus(status *mesos.TaskStatus) {
 		if taskPtr.GetParent() != nil {
 			taskPtr.GetParent().UpdateStatus(INACTIVE)
 		}
+		taskPtr.clearStartupProgress()
+		taskPtr.forgetResourceUsage()
+	case mesos.TASK_FINISHED:
+		taskPtr.clearStartupProgress()
+		taskPtr.forgetResourceUsage()
 	}
 	taskPtr.SendEvent(&event.TaskEvent{Name: taskPtr.GetName(), TaskID: taskId, Status: taskPtr.status.String(), Hostname: taskPtr.hostname , ClassName: taskPtr.GetClassName()})
 }
@@ -814,10 +862,26 @@ func (m *Manager) handleMessage(tm *TaskmanMessage) (error) {
 	case taskop.TaskStatusMessage:
 		mesosStatus := tm.status
 		mesosState := mesosStatus.GetState()
+		if m.holdForAdoption(mesosStatus) {
+			return nil
+		}
+		if mesosState == mesos.TASK_RUNNING {
+			m.taskAdopted(mesosStatus)
+		}
+		agentId := mesos.AgentID{}
+		if mesosStatus.GetAgentID() != nil {
+			agentId = *mesosStatus.GetAgentID()
+		}
 		switch mesosState {
 		case mesos.TASK_FINISHED:
 			m.tasksFinished++
+			m.AgentCache.ReleasePorts(agentId, mesosStatus.GetTaskID().Value, false)
+		case mesos.TASK_DROPPED:
+			m.AgentCache.ReleasePorts(agentId, mesosStatus.GetTaskID().Value, false)
 		case mesos.TASK_LOST, mesos.TASK_KILLED, mesos.TASK_FAILED, mesos.TASK_ERROR:
+			// The process might still be around and holding on to its ports,
+			// so we don't hand them out again right away
+			m.AgentCache.ReleasePorts(agentId, mesosStatus.GetTaskID().Value, true)
 			log.WithPrefix("taskman").
 				WithFields(logrus.Fields{
 					"taskId": mesosStatus.GetTaskID().Value,
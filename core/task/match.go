@@ -25,6 +25,11 @@
 package task
 
 import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
 	"github.com/mesos/mesos-go/api/v1/lib"
 	"github.com/AliceO2Group/Control/core/task/constraint"
 	"github.com/mesos/mesos-go/api/v1/lib/resources"
@@ -36,6 +41,7 @@ type Wants struct {
 	Memory          float64
 	StaticPorts     Ranges
 	InboundChannels []channel.Inbound
+	Resources       map[string]float64
 }
 
 func (m *Manager) GetWantsForDescriptor(descriptor *Descriptor) (r *Wants) {
@@ -53,6 +59,12 @@ func (m *Manager) GetWantsForDescriptor(descriptor *Descriptor) (r *Wants) {
 			r.StaticPorts = make(Ranges, len(wants.Ports))
 			copy(r.StaticPorts, wants.Ports)
 		}
+		if len(wants.Resources) > 0 {
+			r.Resources = make(map[string]float64, len(wants.Resources))
+			for name, amount := range wants.Resources {
+				r.Resources[name] = amount
+			}
+		}
 		r.InboundChannels = channel.MergeInbound(descriptor.RoleBind, taskClass.Bind)
 	}
 	return
@@ -89,10 +101,121 @@ func (r Resources) Satisfy(wants *Wants) (bool) {
 		return false
 	}
 
+	for name, amount := range wants.Resources {
+		if availableAmount(r, name) < amount {
+			return false
+		}
+	}
+
 	// good job surviving til here, a winrar is you
 	return true
 }
 
+// availableAmount returns how much of a named resource is in r: the total for
+// a scalar resource or the number of items for a set resource.
+func availableAmount(r Resources, name string) float64 {
+	total, ok := resources.Name(name).Sum(r...)
+	if !ok || total == nil {
+		return 0
+	}
+	// the aggregate returned by Sum carries no type, only the value
+	switch {
+	case total.Scalar != nil:
+		return total.GetScalar().GetValue()
+	case total.Set != nil:
+		return float64(len(total.GetSet().GetItem()))
+	}
+	return 0
+}
+
+// ResourceAllocation is the share of a named scalar or set resource given to
+// a task.
+type ResourceAllocation struct {
+	Name   string
+	Scalar float64
+	Items  []string
+}
+
+func (ra ResourceAllocation) String() string {
+	if ra.Items != nil {
+		return strings.Join(ra.Items, ",")
+	}
+	return strconv.FormatFloat(ra.Scalar, 'f', -1, 64)
+}
+
+func (ra ResourceAllocation) resource() mesos.Resource {
+	if ra.Items != nil {
+		return resources.Build().Name(resources.Name(ra.Name)).Set(ra.Items...).Resource
+	}
+	return resources.Build().Name(resources.Name(ra.Name)).Scalar(ra.Scalar).Resource
+}
+
+// EnvVarName returns the name of the environment variable through which the
+// allocation is passed to the task, e.g. O2_RESOURCE_HUGEPAGES_1GI.
+func (ra ResourceAllocation) EnvVarName() string {
+	return "O2_RESOURCE_" + strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			return r
+		}
+		return '_'
+	}, strings.ToUpper(ra.Name))
+}
+
+// PropertyKey returns the key under which the allocation appears in the task's
+// property map.
+func (ra ResourceAllocation) PropertyKey() string {
+	return "resources." + ra.Name
+}
+
+type ResourceAllocations []ResourceAllocation
+
+// allocateResources claims the named scalar and set resources in wants out of
+// remaining. Set items are picked in sorted order so that the same offer
+// always yields the same allocation. If anything cannot be claimed, ok is false
+// and remaining is left untouched.
+func allocateResources(remaining *mesos.Resources, wants *Wants) (allocations ResourceAllocations, ok bool) {
+	names := make([]string, 0, len(wants.Resources))
+	for name := range wants.Resources {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	claimed := remaining.Clone()
+	allocations = make(ResourceAllocations, 0, len(names))
+	for _, name := range names {
+		amount := wants.Resources[name]
+		total, found := resources.Name(name).Sum(claimed...)
+		if !found || total == nil {
+			return nil, false
+		}
+
+		var allocation ResourceAllocation
+		switch {
+		case total.Scalar != nil:
+			if total.GetScalar().GetValue() < amount {
+				return nil, false
+			}
+			allocation = ResourceAllocation{Name: name, Scalar: amount}
+		case total.Set != nil:
+			count := int(math.Ceil(amount))
+			items := append([]string{}, total.GetSet().GetItem()...)
+			if len(items) < count {
+				return nil, false
+			}
+			sort.Strings(items)
+			allocation = ResourceAllocation{Name: name, Items: items[:count]}
+		default:
+			return nil, false
+		}
+
+		claimed.Subtract(allocation.resource())
+		allocations = append(allocations, allocation)
+	}
+
+	*remaining = claimed
+	return allocations, true
+}
+
 func (m *Manager) BuildDescriptorConstraints(descriptors Descriptors) (cm map[*Descriptor]constraint.Constraints) {
 	cm = make(map[*Descriptor]constraint.Constraints)
 	for _, descriptor := range descriptors {
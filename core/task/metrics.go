@@ -32,6 +32,7 @@ import (
 	"net/http"
 	"strconv"
 
+	"github.com/AliceO2Group/Control/common/event"
 	schedmetrics "github.com/AliceO2Group/Control/core/metrics"
 	"github.com/AliceO2Group/Control/core/task/schedutil"
 	xmetrics "github.com/mesos/mesos-go/api/v1/lib/extras/metrics"
@@ -85,6 +86,7 @@ type metricsAPI struct {
 	offeredResources      xmetrics.Watcher
 	jobStartCount         xmetrics.Counter
 	artifactDownloads     xmetrics.Counter
+	deploymentPhaseLatency xmetrics.Watcher
 }
 
 func newMetricsAPI() *metricsAPI {
@@ -103,5 +105,28 @@ func newMetricsAPI() *metricsAPI {
 		offeredResources:      newMetricWatchers(schedmetrics.OfferedResources),
 		jobStartCount:         newMetricCounters(schedmetrics.JobStartCount),
 		artifactDownloads:     newMetricCounter(schedmetrics.ArtifactDownloads),
+		deploymentPhaseLatency: newMetricWatchers(schedmetrics.DeploymentPhaseLatency),
 	}
 }
+
+// Task resource usage metrics are gauges labeled by environment, role and
+// class, so they don't fit the xmetrics types above.
+func recordTaskResourceUsage(labels []string, usage event.ResourceUsage) {
+	schedmetrics.TaskCpuSeconds.WithLabelValues(labels...).Set(usage.CpuSeconds)
+	schedmetrics.TaskCpuUsage.WithLabelValues(labels...).Set(usage.CpuPercent)
+	schedmetrics.TaskRssBytes.WithLabelValues(labels...).Set(float64(usage.RssBytes))
+	schedmetrics.TaskOpenFds.WithLabelValues(labels...).Set(float64(usage.OpenFds))
+	schedmetrics.TaskThreads.WithLabelValues(labels...).Set(float64(usage.Threads))
+	schedmetrics.TaskReadBytes.WithLabelValues(labels...).Set(float64(usage.ReadBytes))
+	schedmetrics.TaskWriteBytes.WithLabelValues(labels...).Set(float64(usage.WriteBytes))
+}
+
+func forgetTaskResourceUsage(labels []string) {
+	schedmetrics.TaskCpuSeconds.DeleteLabelValues(labels...)
+	schedmetrics.TaskCpuUsage.DeleteLabelValues(labels...)
+	schedmetrics.TaskRssBytes.DeleteLabelValues(labels...)
+	schedmetrics.TaskOpenFds.DeleteLabelValues(labels...)
+	schedmetrics.TaskThreads.DeleteLabelValues(labels...)
+	schedmetrics.TaskReadBytes.DeleteLabelValues(labels...)
+	schedmetrics.TaskWriteBytes.DeleteLabelValues(labels...)
+}
@@ -0,0 +1,331 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2021 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+
+package task
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/AliceO2Group/Control/core/task/channel"
+	"github.com/AliceO2Group/Control/core/task/constraint"
+	"github.com/AliceO2Group/Control/core/the"
+	"github.com/mesos/mesos-go/api/v1/lib"
+	"github.com/mesos/mesos-go/api/v1/lib/resources"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+)
+
+// portPools are the port ranges from which dynamic ports are allocated, by
+// purpose, along with the quarantine period for ports released by dead tasks.
+type portPools struct {
+	control    mesos.Ranges
+	data       mesos.Ranges
+	quarantine time.Duration
+}
+
+func parsePortPool(key string) (pool mesos.Ranges, err error) {
+	var ranges Ranges
+	ranges, err = parsePortRanges(strings.ReplaceAll(viper.GetString(key), " ", ""))
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse %s: %w", key, err)
+	}
+	if len(ranges) == 0 {
+		return nil, fmt.Errorf("%s cannot be empty", key)
+	}
+	for _, rng := range ranges {
+		if rng.Begin > rng.End || rng.End > 65535 {
+			return nil, fmt.Errorf("%s contains invalid port range %d-%d", key, rng.Begin, rng.End)
+		}
+	}
+	return ranges.toMesosRanges(), nil
+}
+
+func newPortPoolsFromConfig() (pools portPools, err error) {
+	pools.control, err = parsePortPool("controlPortRange")
+	if err != nil {
+		return
+	}
+	pools.data, err = parsePortPool("dataPortRange")
+	if err != nil {
+		return
+	}
+	pools.quarantine = the.CoreSettingDuration("portQuarantineDuration")
+	return
+}
+
+// offerMatch is a single Descriptor placed on an offer, with all the ports
+// it needs already claimed from that offer's resources.
+type offerMatch struct {
+	descriptor  *Descriptor
+	wants       *Wants
+	bindMap     channel.BindMap
+	controlPort uint64
+	allocations ResourceAllocations
+}
+
+// ports returns all the TCP ports claimed for this match, including the
+// control port.
+func (om offerMatch) ports() []uint64 {
+	ports := []uint64{om.controlPort}
+	for _, endpoint := range om.bindMap {
+		if tcpEndpoint, ok := endpoint.(channel.TcpEndpoint); ok {
+			ports = append(ports, tcpEndpoint.Port)
+		}
+	}
+	return ports
+}
+
+// offerPlan is everything we intend to launch with a single offer.
+type offerPlan struct {
+	offer   *mesos.Offer
+	matches []offerMatch
+}
+
+type offerPlans []offerPlan
+
+// sortedOffers returns a copy of the offers slice, ordered by hostname, agent ID
+// and offer ID, so that placement does not depend on the order in which Mesos
+// sends us offers.
+func sortedOffers(offers []mesos.Offer) []mesos.Offer {
+	sorted := make([]mesos.Offer, len(offers))
+	copy(sorted, offers)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Hostname != sorted[j].Hostname {
+			return sorted[i].Hostname < sorted[j].Hostname
+		}
+		if sorted[i].AgentID.Value != sorted[j].AgentID.Value {
+			return sorted[i].AgentID.Value < sorted[j].AgentID.Value
+		}
+		return sorted[i].ID.Value < sorted[j].ID.Value
+	})
+	return sorted
+}
+
+// forEachParallel calls fn for every index in [0, n), with at most concurrency
+// calls running at the same time, and returns when all of them are done.
+func forEachParallel(n int, concurrency int, fn func(i int)) {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, concurrency)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			fn(i)
+		}(i)
+	}
+	wg.Wait()
+}
+
+// claimPort takes the lowest available port in pool which is not in
+// unavailable out of remaining, and returns it. If no such port exists, ok is
+// false and remaining is left untouched.
+func claimPort(remaining *mesos.Resources, pool mesos.Ranges, unavailable mesos.Ranges) (port uint64, ok bool) {
+	availPorts, ok := resources.Ports(*remaining...)
+	if !ok {
+		return
+	}
+	candidates := make(mesos.Ranges, 0)
+	for _, rng := range pool {
+		inPool := availPorts.Clone()
+		if rng.Begin > 0 {
+			inPool = inPool.Remove(mesos.Value_Range{Begin: 0, End: rng.Begin - 1})
+		}
+		if rng.End < math.MaxUint64 {
+			inPool = inPool.Remove(mesos.Value_Range{Begin: rng.End + 1, End: math.MaxUint64})
+		}
+		candidates = append(candidates, inPool...)
+	}
+	candidates = candidates.Sort().Squash()
+	for _, rng := range unavailable {
+		candidates = candidates.Remove(rng)
+	}
+	if candidates.Size() == 0 {
+		ok = false
+		return
+	}
+	port = candidates.Min()
+	builder := resources.Build().
+		Name(resources.Name("ports")).
+		Ranges(resources.BuildRanges().Span(port, port).Ranges)
+	remaining.Subtract(builder.Resource)
+	return
+}
+
+// planOffers matches descriptors to offers and returns one offerPlan per offer
+// that received at least one descriptor, along with the descriptors that could
+// not be placed.
+//
+// Constraint checking is done for all offers concurrently, with at most
+// concurrency goroutines. The actual assignment of descriptors and ports is then
+// done in a single pass over the sorted offers, so that the same inputs always
+// yield the same placement regardless of concurrency.
+func planOffers(offers []mesos.Offer,
+                descriptors Descriptors,
+                descriptorConstraints map[*Descriptor]constraint.Constraints,
+                wantsForDescriptor func(*Descriptor) *Wants,
+                pools portPools,
+                agentCache *AgentCache,
+                concurrency int) (plans offerPlans, descriptorsLeft Descriptors) {
+	sorted := sortedOffers(offers)
+
+	wants := make([]*Wants, len(descriptors))
+	for j, descriptor := range descriptors {
+		wants[j] = wantsForDescriptor(descriptor)
+		if wants[j] == nil {
+			log.WithPrefix("scheduler").WithField("class", descriptor.TaskClassName).
+				Warning("no resource demands for descriptor, invalid class perhaps?")
+		}
+	}
+
+	veryVerbose := viper.GetBool("veryVerbose")
+
+	// eligible[i][j] is true if offer i satisfies the constraints of descriptor j
+	eligible := make([][]bool, len(sorted))
+	forEachParallel(len(sorted), concurrency, func(i int) {
+		eligible[i] = make([]bool, len(descriptors))
+		offerAttributes := constraint.Attributes(sorted[i].Attributes)
+		for j, descriptor := range descriptors {
+			if wants[j] == nil {
+				continue
+			}
+			eligible[i][j] = offerAttributes.Satisfy(descriptorConstraints[descriptor])
+			if !eligible[i][j] && veryVerbose {
+				log.WithPrefix("scheduler").
+					WithFields(logrus.Fields{
+						"taskClass":   descriptor.TaskClassName,
+						"constraints": descriptorConstraints[descriptor],
+						"offerId":     sorted[i].ID.Value,
+						"attributes":  offerAttributes.String(),
+					}).
+					Trace("descriptor constraints not satisfied by offer attributes")
+			}
+		}
+	})
+
+	placed := make([]bool, len(descriptors))
+	plans = make(offerPlans, 0)
+	for i := range sorted {
+		offer := &sorted[i]
+		plan := offerPlan{offer: offer, matches: make([]offerMatch, 0)}
+		remainingResourcesInOffer := mesos.Resources(offer.Resources).Clone()
+		// Ports still held by live tasks or in quarantine on this agent
+		unavailablePorts := agentCache.UnavailablePorts(offer.AgentID, pools.quarantine).toMesosRanges()
+
+		// We iterate down over the descriptors, like we always did
+		for j := len(descriptors) - 1; j >= 0; j-- {
+			if placed[j] || !eligible[i][j] {
+				continue
+			}
+			descriptor := descriptors[j]
+			if !Resources(remainingResourcesInOffer).Satisfy(wants[j]) {
+				if veryVerbose {
+					log.WithPrefix("scheduler").
+						WithFields(logrus.Fields{
+							"taskClass": descriptor.TaskClassName,
+							"wants":     *wants[j],
+							"offerId":   offer.ID.Value,
+							"resources": remainingResourcesInOffer.String(),
+						}).
+						Trace("descriptor wants not satisfied by offer resources")
+				}
+				continue
+			}
+
+			// We claim ports on a copy, and only keep it if all claims succeed
+			candidateResources := remainingResourcesInOffer.Clone()
+			match, ok := claimPortsForDescriptor(&candidateResources, descriptor, wants[j], pools, unavailablePorts)
+			if !ok {
+				continue
+			}
+			match.allocations, ok = allocateResources(&candidateResources, wants[j])
+			if !ok {
+				continue
+			}
+			remainingResourcesInOffer = candidateResources
+
+			plan.matches = append(plan.matches, match)
+			placed[j] = true
+		}
+
+		if len(plan.matches) > 0 {
+			plans = append(plans, plan)
+		}
+	}
+
+	descriptorsLeft = make(Descriptors, 0)
+	for j, descriptor := range descriptors {
+		if !placed[j] {
+			descriptorsLeft = append(descriptorsLeft, descriptor)
+		}
+	}
+	return
+}
+
+// claimPortsForDescriptor claims a port for each inbound TCP channel and for the
+// control port of a descriptor, each from its own pool.
+func claimPortsForDescriptor(remaining *mesos.Resources, descriptor *Descriptor, wants *Wants, pools portPools, unavailable mesos.Ranges) (match offerMatch, ok bool) {
+	bindMap := make(channel.BindMap)
+	for _, ch := range wants.InboundChannels {
+		if ch.Addressing == channel.IPC {
+			bindMap[ch.Name] = channel.NewBoundIpcEndpoint(ch.Transport)
+		} else {
+			var port uint64
+			port, ok = claimPort(remaining, pools.data, unavailable)
+			if !ok {
+				return
+			}
+			bindMap[ch.Name] = channel.NewBoundTcpEndpoint(port, ch.Transport)
+		}
+		// global channel alias processing
+		if len(ch.Global) != 0 {
+			bindMap["::" + ch.Global] = bindMap[ch.Name]
+		}
+	}
+
+	controlPort, ok := claimPort(remaining, pools.control, unavailable)
+	if !ok {
+		return
+	}
+
+	match = offerMatch{
+		descriptor:  descriptor,
+		wants:       wants,
+		bindMap:     bindMap,
+		controlPort: controlPort,
+	}
+	return
+}
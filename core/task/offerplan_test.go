@@ -0,0 +1,244 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2021 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+
+package task
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/AliceO2Group/Control/core/task/channel"
+	"github.com/AliceO2Group/Control/core/task/constraint"
+	"github.com/mesos/mesos-go/api/v1/lib"
+	"github.com/mesos/mesos-go/api/v1/lib/resources"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+)
+
+var testPortPools = portPools{
+	control:    mesos.Ranges{{Begin: 30000, End: 65535}},
+	data:       mesos.Ranges{{Begin: 9000, End: 65535}},
+	quarantine: time.Minute,
+}
+
+// simulatedDeployment builds an offer for each of hostCount agents, and
+// tasksPerHost descriptors constrained to each host, each with two inbound
+// TCP channels.
+func simulatedDeployment(hostCount int, tasksPerHost int) (offers []mesos.Offer, descriptors Descriptors, cm map[*Descriptor]constraint.Constraints, wants map[*Descriptor]*Wants) {
+	offers = make([]mesos.Offer, hostCount)
+	descriptors = make(Descriptors, 0, hostCount*tasksPerHost)
+	cm = make(map[*Descriptor]constraint.Constraints)
+	wants = make(map[*Descriptor]*Wants)
+
+	for i := 0; i < hostCount; i++ {
+		hostname := fmt.Sprintf("flp%03d", i)
+		offerResources := mesos.Resources{}
+		offerResources.Add(
+			resources.NewCPUs(64).Resource,
+			resources.NewMemory(65536).Resource,
+			resources.Build().Name(resources.Name("ports")).
+				Ranges(resources.BuildRanges().Span(1, 65000).Ranges).Resource,
+		)
+		offers[i] = mesos.Offer{
+			ID:        mesos.OfferID{Value: fmt.Sprintf("offer-%03d", i)},
+			AgentID:   mesos.AgentID{Value: fmt.Sprintf("agent-%03d", i)},
+			Hostname:  hostname,
+			Resources: offerResources,
+			Attributes: []mesos.Attribute{{
+				Name: "machine_id",
+				Type: mesos.TEXT,
+				Text: &mesos.Value_Text{Value: hostname},
+			}},
+		}
+
+		for j := 0; j < tasksPerHost; j++ {
+			d := &Descriptor{TaskClassName: fmt.Sprintf("class%d", j)}
+			cm[d] = constraint.Constraints{{Attribute: "machine_id", Value: hostname}}
+			wants[d] = &Wants{
+				Cpu:    1,
+				Memory: 128,
+				InboundChannels: []channel.Inbound{
+					{Channel: channel.Channel{Name: "data-in"}, Addressing: channel.TCP},
+					{Channel: channel.Channel{Name: "data-out"}, Addressing: channel.TCP},
+				},
+			}
+			descriptors = append(descriptors, d)
+		}
+	}
+	return
+}
+
+// placement renders the result of planOffers in a comparable form.
+func placement(plans offerPlans) map[*Descriptor]string {
+	p := make(map[*Descriptor]string)
+	for _, plan := range plans {
+		for _, match := range plan.matches {
+			p[match.descriptor] = fmt.Sprintf("%s:%d:%v", plan.offer.Hostname, match.controlPort, match.bindMap)
+		}
+	}
+	return p
+}
+
+func TestPlanOffersIsDeterministic(t *testing.T) {
+	logrus.SetLevel(logrus.InfoLevel)
+	viper.Set("veryVerbose", false)
+
+	offers, descriptors, cm, wants := simulatedDeployment(20, 10)
+	wantsFor := func(d *Descriptor) *Wants { return wants[d] }
+
+	reference, left := planOffers(offers, descriptors, cm, wantsFor, testPortPools, nil, 1)
+	if len(left) != 0 {
+		t.Fatalf("expected all descriptors to be placed, %d left", len(left))
+	}
+	expected := placement(reference)
+
+	for _, concurrency := range []int{2, 8, 64} {
+		shuffled := make([]mesos.Offer, len(offers))
+		copy(shuffled, offers)
+		rand.Shuffle(len(shuffled), func(i, j int) { shuffled[i], shuffled[j] = shuffled[j], shuffled[i] })
+
+		plans, _ := planOffers(shuffled, descriptors, cm, wantsFor, testPortPools, nil, concurrency)
+		actual := placement(plans)
+		if len(actual) != len(expected) {
+			t.Fatalf("concurrency %d: expected %d placements, got %d", concurrency, len(expected), len(actual))
+		}
+		for d, where := range expected {
+			if actual[d] != where {
+				t.Errorf("concurrency %d: descriptor %s placed at %s, expected %s", concurrency, d.TaskClassName, actual[d], where)
+			}
+		}
+	}
+}
+
+func TestPlanOffersClaimsDistinctPorts(t *testing.T) {
+	logrus.SetLevel(logrus.InfoLevel)
+
+	offers, descriptors, cm, wants := simulatedDeployment(1, 50)
+	plans, _ := planOffers(offers, descriptors, cm, func(d *Descriptor) *Wants { return wants[d] }, testPortPools, nil, 4)
+
+	seen := make(map[uint64]bool)
+	claim := func(port uint64) {
+		if seen[port] {
+			t.Errorf("port %d claimed more than once", port)
+		}
+		seen[port] = true
+	}
+	for _, plan := range plans {
+		for _, match := range plan.matches {
+			if match.controlPort < 30000 {
+				t.Errorf("control port %d outside of control port pool", match.controlPort)
+			}
+			claim(match.controlPort)
+			for _, endpoint := range match.bindMap {
+				if tcpEndpoint, ok := endpoint.(channel.TcpEndpoint); ok {
+					claim(tcpEndpoint.Port)
+				}
+			}
+		}
+	}
+}
+
+func TestPlanOffersSkipsQuarantinedPorts(t *testing.T) {
+	logrus.SetLevel(logrus.InfoLevel)
+
+	offers, descriptors, cm, wants := simulatedDeployment(1, 1)
+	wantsFor := func(d *Descriptor) *Wants { return wants[d] }
+	agentCache := &AgentCache{}
+
+	plans, _ := planOffers(offers, descriptors, cm, wantsFor, testPortPools, agentCache, 1)
+	if len(plans) != 1 || len(plans[0].matches) != 1 {
+		t.Fatalf("expected exactly one match")
+	}
+	firstPorts := plans[0].matches[0].ports()
+	agentCache.ReservePorts(offers[0].AgentID, "killed-task", firstPorts...)
+	agentCache.ReleasePorts(offers[0].AgentID, "killed-task", true)
+
+	plans, _ = planOffers(offers, descriptors, cm, wantsFor, testPortPools, agentCache, 1)
+	for _, port := range plans[0].matches[0].ports() {
+		for _, quarantined := range firstPorts {
+			if port == quarantined {
+				t.Errorf("port %d allocated while still in quarantine", port)
+			}
+		}
+	}
+
+	expired := testPortPools
+	expired.quarantine = 0
+	plans, _ = planOffers(offers, descriptors, cm, wantsFor, expired, agentCache, 1)
+	if plans[0].matches[0].controlPort != firstPorts[0] {
+		t.Errorf("expected control port %d to be reused after quarantine, got %d", firstPorts[0], plans[0].matches[0].controlPort)
+	}
+}
+
+func TestPlanOffersAllocatesCustomResources(t *testing.T) {
+	logrus.SetLevel(logrus.InfoLevel)
+
+	offers, descriptors, cm, wants := simulatedDeployment(1, 2)
+	offers[0].Resources = append(offers[0].Resources,
+		resources.Build().Name(resources.Name("gpus")).Scalar(4).Resource,
+		resources.Build().Name(resources.Name("cru_endpoints")).Set("cru1:1", "cru0:0", "cru0:1").Resource,
+	)
+	// descriptors are matched from the last one down
+	wants[descriptors[1]].Resources = map[string]float64{"gpus": 2, "cru_endpoints": 2}
+	wants[descriptors[0]].Resources = map[string]float64{"gpus": 3}
+
+	plans, left := planOffers(offers, descriptors, cm, func(d *Descriptor) *Wants { return wants[d] }, testPortPools, nil, 1)
+	if len(left) != 1 || left[0] != descriptors[0] {
+		t.Fatalf("expected only the descriptor wanting 3 GPUs to be left over")
+	}
+	allocations := plans[0].matches[0].allocations
+	if len(allocations) != 2 {
+		t.Fatalf("expected 2 allocations, got %d", len(allocations))
+	}
+	if allocations[0].Name != "cru_endpoints" || allocations[0].String() != "cru0:0,cru0:1" {
+		t.Errorf("unexpected set allocation %s=%s", allocations[0].Name, allocations[0].String())
+	}
+	if allocations[1].Name != "gpus" || allocations[1].String() != "2" {
+		t.Errorf("unexpected scalar allocation %s=%s", allocations[1].Name, allocations[1].String())
+	}
+	if allocations[1].EnvVarName() != "O2_RESOURCE_GPUS" {
+		t.Errorf("unexpected env var name %s", allocations[1].EnvVarName())
+	}
+}
+
+func benchmarkPlanOffers(b *testing.B, hostCount int, tasksPerHost int, concurrency int) {
+	logrus.SetLevel(logrus.InfoLevel)
+	viper.Set("veryVerbose", false)
+
+	offers, descriptors, cm, wants := simulatedDeployment(hostCount, tasksPerHost)
+	wantsFor := func(d *Descriptor) *Wants { return wants[d] }
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		planOffers(offers, descriptors, cm, wantsFor, testPortPools, nil, concurrency)
+	}
+}
+
+func BenchmarkPlanOffers_200FLPs_Sequential(b *testing.B) { benchmarkPlanOffers(b, 200, 20, 1) }
+func BenchmarkPlanOffers_200FLPs_Parallel16(b *testing.B) { benchmarkPlanOffers(b, 200, 20, 16) }
+func BenchmarkPlanOffers_500EPNs_Sequential(b *testing.B) { benchmarkPlanOffers(b, 500, 8, 1) }
+func BenchmarkPlanOffers_500EPNs_Parallel16(b *testing.B) { benchmarkPlanOffers(b, 500, 8, 16) }
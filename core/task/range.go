@@ -28,6 +28,8 @@ import (
 	"errors"
 	"strconv"
 	"strings"
+
+	"github.com/mesos/mesos-go/api/v1/lib"
 )
 
 type Range struct {
@@ -80,6 +82,14 @@ func (this Ranges) Equals(other Ranges) (response bool) {
 	return
 }
 
+func (this Ranges) toMesosRanges() mesos.Ranges {
+	r := make(mesos.Ranges, len(this))
+	for i, rng := range this {
+		r[i] = mesos.Value_Range{Begin: rng.Begin, End: rng.End}
+	}
+	return r.Sort().Squash()
+}
+
 func parsePortRanges(str string) (ranges Ranges, err error) {
 	r := make(Ranges, 0)
 	if len(strings.TrimSpace(str)) == 0 {
@@ -104,7 +114,7 @@ func parsePortRanges(str string) (ranges Ranges, err error) {
 			if err != nil {
 				return
 			}
-			end, err = strconv.ParseUint(rangeSplit[0], 10, 64)
+			end, err = strconv.ParseUint(rangeSplit[1], 10, 64)
 			if err != nil {
 				return
 			}
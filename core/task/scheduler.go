@@ -35,17 +35,18 @@ import (
 	"io"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/AliceO2Group/Control/common/controlmode"
 	"github.com/AliceO2Group/Control/common/utils/uid"
 	"github.com/AliceO2Group/Control/core/task/channel"
+	"github.com/AliceO2Group/Control/core/task/constraint"
 	"github.com/AliceO2Group/Control/core/task/schedutil"
 	"github.com/spf13/viper"
 
 	"github.com/AliceO2Group/Control/common/event"
 	"github.com/AliceO2Group/Control/core/controlcommands"
-	"github.com/AliceO2Group/Control/core/task/constraint"
 	"github.com/AliceO2Group/Control/executor/protos"
 	"github.com/mesos/mesos-go/api/v1/lib"
 	"github.com/mesos/mesos-go/api/v1/lib/backoff"
@@ -280,6 +281,21 @@ func (state *schedulerState) incomingMessageHandler() events.HandlerFunc {
 				if err != nil {
 					return
 				}
+				// Resource usage samples only concern the task itself, so we
+				// don't bother the environment manager with them
+				if tru, ok := ev.(*event.TaskResourceUsage); ok {
+					t := state.taskman.GetTask(tru.GetOrigin().TaskId.Value)
+					if t != nil {
+						t.setResourceUsage(tru.Usage)
+					}
+					return
+				}
+				if th, ok := ev.(*event.TaskHealth); ok {
+					t := state.taskman.GetTask(th.GetOrigin().TaskId.Value)
+					if t != nil {
+						t.setHealth(th)
+					}
+				}
 				state.taskman.internalEventCh <- ev
 				//state.handleDeviceEvent(ev)
 			} else {
@@ -356,6 +372,30 @@ func (state *schedulerState) incomingMessageHandler() events.HandlerFunc {
 					state.servent.ProcessResponse(&res, sender)
 				}()
 				return
+			case "MesosCommand_GetTaskLogs":
+				var res controlcommands.MesosCommandResponse_GetTaskLogs
+				err = json.Unmarshal(data, &res)
+				if err != nil {
+					log.WithPrefix("scheduler").WithFields(logrus.Fields{
+							"commandName": incomingCommand.CommandName,
+							"agentId":     agentId.GetValue(),
+							"executorId":  executorId.GetValue(),
+							"message":     string(data[:]),
+							"error":       err.Error(),
+						}).
+						Error("cannot unmarshal incoming MESSAGE")
+					return
+				}
+				sender := controlcommands.MesosCommandTarget{
+					AgentId: agentId,
+					ExecutorId: executorId,
+					TaskId: mesos.TaskID{Value: res.TaskId},
+				}
+
+				go func() {
+					state.servent.ProcessResponse(&res, sender)
+				}()
+				return
 			default:
 				return errors.New(fmt.Sprintf("unrecognized response for controlcommand %s", incomingCommand.CommandName))
 			}
@@ -370,6 +410,28 @@ func (state *schedulerState) incomingMessageHandler() events.HandlerFunc {
 			if t != nil {
 				t.setTaskPID(taskMessage.GetTaskPID())
 			}
+		case "TaskStartupProgressEvent":
+			var taskMessage event.TaskStartupProgressEvent
+			err = json.Unmarshal(data, &taskMessage)
+			if err != nil {
+				return
+			}
+
+			t := state.taskman.GetTask(taskMessage.TaskId)
+			if t != nil {
+				t.setStartupProgress(taskMessage.Phase, time.Duration(taskMessage.ElapsedMs)*time.Millisecond)
+			}
+		case "TaskCapabilitiesEvent":
+			var taskMessage event.TaskCapabilitiesEvent
+			err = json.Unmarshal(data, &taskMessage)
+			if err != nil {
+				return
+			}
+
+			t := state.taskman.GetTask(taskMessage.GetTaskId())
+			if t != nil {
+				t.setOccCapabilities(taskMessage.Capabilities)
+			}
 		}
 		return
 	}
@@ -382,7 +444,6 @@ func (state *schedulerState) resourceOffers(fidStore store.Singleton) events.Han
 			offers                 = e.GetOffers().GetOffers()
 			callOption             = calls.RefuseSeconds(time.Second)//calls.RefuseSecondsWithJitter(state.random, state.config.maxRefuseSeconds)
 			tasksLaunchedThisCycle = 0
-			offersDeclined         = 0
 		)
 
 		if viper.GetBool("veryVerbose") {
@@ -445,337 +506,75 @@ func (state *schedulerState) resourceOffers(fidStore store.Singleton) events.Han
 
 			log.WithPrefix("scheduler").Debug("about to deploy workflow tasks")
 
-			var	err error
+			concurrency := viper.GetInt("offerProcessingConcurrency")
 
-			// We make a map[Descriptor]constraint.Constraints and for each descriptor to deploy we
-			// fill it with the pre-computed total constraints for that Descriptor.
-			descriptorConstraints := state.taskman.BuildDescriptorConstraints(descriptorsStillToDeploy)
-
-			// NOTE: 1 offer per host
-			// FIXME: this for should be parallelized with a sync.WaitGroup
-			//        for a likely significant multinode launch performance increase
-			for _, offer := range offers {
-				var (
-					remainingResourcesInOffer = mesos.Resources(offer.Resources)
-					taskInfosToLaunchForCurrentOffer = make([]mesos.TaskInfo, 0)
-					tasksDeployedForCurrentOffer = make(DeploymentMap)
-					targetExecutorId = mesos.ExecutorID{}
-				)
-
-				// If there are no executors provided by the offer,
-				// we start a new one by generating a new ID
-				if len(offer.ExecutorIDs) == 0 {
-					targetExecutorId.Value = uid.New().String()
-				} else {
-					targetExecutorId.Value = offer.ExecutorIDs[0].Value
-				}
-
-				log.WithPrefix("scheduler").
-					WithFields(logrus.Fields{
-					"offerId":   offer.ID.Value,
-					"resources": remainingResourcesInOffer.String(),
-				}).Debug("processing offer")
-
-				remainingResourcesFlattened := resources.Flatten(remainingResourcesInOffer)
-
-				// avoid the expense of computing these if we can...
-				if viper.GetBool("summaryMetrics") && viper.GetBool("mesosResourceTypeMetrics") {
-					for name, resType := range resources.TypesOf(remainingResourcesFlattened...) {
+			// avoid the expense of computing these if we can...
+			if viper.GetBool("summaryMetrics") && viper.GetBool("mesosResourceTypeMetrics") {
+				for _, offer := range offers {
+					offerResourcesFlattened := resources.Flatten(mesos.Resources(offer.Resources))
+					for name, resType := range resources.TypesOf(offerResourcesFlattened...) {
 						if resType == mesos.SCALAR {
-							sum, _ := name.Sum(remainingResourcesFlattened...)
+							sum, _ := name.Sum(offerResourcesFlattened...)
 							state.metricsAPI.offeredResources(sum.GetScalar().GetValue(), name.String())
 						}
 					}
 				}
+			}
 
-				log.WithPrefix("scheduler").Debug("state lock to process descriptors to deploy")
-				state.Lock()
-
-				// We iterate down over the descriptors, and we remove them as we match
-				FOR_DESCRIPTORS:
-				for i := len(descriptorsStillToDeploy)-1; i >= 0; i-- {
-					descriptor := descriptorsStillToDeploy[i]
-					log.WithPrefix("scheduler").
-						WithField("taskClass", descriptor.TaskClassName).
-						Debug("processing descriptor")
-					offerAttributes := constraint.Attributes(offer.Attributes)
-					if !offerAttributes.Satisfy(descriptorConstraints[descriptor]) {
-						if viper.GetBool("veryVerbose") {
-							log.WithPrefix("scheduler").
-								WithFields(logrus.Fields{
-								    "taskClass":   descriptor.TaskClassName,
-								    "constraints": descriptorConstraints[descriptor],
-								    "offerId":     offer.ID.Value,
-								    "resources":   remainingResourcesInOffer.String(),
-								    "attributes":  offerAttributes.String(),
-								}).
-								Trace("descriptor constraints not satisfied by offer attributes")
-						}
-						continue
-					}
-					log.WithPrefix("scheduler").Debug("offer attributes satisfy constraints")
-
-					wants := state.taskman.GetWantsForDescriptor(descriptor)
-					if wants == nil {
-						log.WithPrefix("scheduler").WithField("class", descriptor.TaskClassName).
-							Warning("no resource demands for descriptor, invalid class perhaps?")
-						continue
-					}
-					if !Resources(remainingResourcesInOffer).Satisfy(wants) {
-						if viper.GetBool("veryVerbose") {
-							log.WithPrefix("scheduler").
-								WithFields(logrus.Fields{
-								    "taskClass": descriptor.TaskClassName,
-								    "wants":     *wants,
-								    "offerId":   offer.ID.Value,
-								    "resources": remainingResourcesInOffer.String(),
-								}).
-								Trace("descriptor wants not satisfied by offer resources")
-						}
-						continue
-					}
-
-					// Point of no return, we start subtracting resources
-
-					bindMap := make(channel.BindMap)
-					for _, ch := range wants.InboundChannels {
-						if ch.Addressing == channel.IPC {
-							bindMap[ch.Name] = channel.NewBoundIpcEndpoint(ch.Transport)
-						} else {
-							availPorts, ok := resources.Ports(remainingResourcesInOffer...)
-							if !ok {
-								continue FOR_DESCRIPTORS
-							}
-							// TODO: this can be optimized by excluding the base range outside the loop
-							availPorts = availPorts.Remove(mesos.Value_Range{Begin: 0, End: 8999})
-							port := availPorts.Min()
-							builder := resources.Build().
-								Name(resources.Name("ports")).
-								Ranges(resources.BuildRanges().Span(port, port).Ranges)
-							remainingResourcesInOffer.Subtract(builder.Resource)
-							bindMap[ch.Name] = channel.NewBoundTcpEndpoint(port, ch.Transport)
-						}
-						// global channel alias processing
-						if len(ch.Global) != 0 {
-							bindMap["::" + ch.Global] = bindMap[ch.Name]
-						}
-					}
-
-					agentForCache := AgentCacheInfo{
-						AgentId: offer.AgentID,
-						Attributes: offer.Attributes,
-						Hostname: offer.Hostname,
-					}
-					state.taskman.AgentCache.Update(agentForCache) //thread safe
-
-					taskPtr := state.taskman.newTaskForMesosOffer(&offer, descriptor, bindMap, targetExecutorId)
-					if taskPtr == nil {
-						log.WithPrefix("scheduler").
-							WithField("offerId", offer.ID.Value).
-							Error("cannot get task for offer+descriptor, this should never happen")
-						log.Debug("state unlock")
-						continue
-					}
-
-					// Do not decline this offer
-					_, contains := offerIDsToDecline[offer.ID]
-					if contains {
-						delete(offerIDsToDecline, offer.ID)
-					}
-
-					// Build the O² process to run as a mesos.CommandInfo, which we'll then JSON-serialize
-					err := taskPtr.BuildTaskCommand(descriptor.TaskRole)
-					if err != nil {
-						log.WithPrefix("scheduler").
-							WithField("offerId", offer.ID.Value).
-							WithError(err).
-							Error("cannot build task command")
-						continue
-					}
-					cmd := taskPtr.GetTaskCommandInfo()
-
-					// Claim the control port
-					availPorts, ok := resources.Ports(remainingResourcesInOffer...)
-					if !ok {
-						continue FOR_DESCRIPTORS
-					}
-					// The control port range starts at 47101
-					// FIXME: make the control ports cutoff configurable
-					availPorts = availPorts.Remove(mesos.Value_Range{Begin: 0, End: 29999})
-					controlPort := availPorts.Min()
-					builder := resources.Build().
-						Name(resources.Name("ports")).
-						Ranges(resources.BuildRanges().Span(controlPort, controlPort).Ranges)
-					remainingResourcesInOffer.Subtract(builder.Resource)
-
-					// Append control port to arguments
-					// For the control port parameter and/or environment variable, see occ/OccGlobals.h
-					if cmd.ControlMode != controlmode.BASIC &&
-						cmd.ControlMode != controlmode.HOOK {
-						cmd.ControlPort = controlPort
-						cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%d", "OCC_CONTROL_PORT", controlPort))
-					}
-
-					if cmd.ControlMode == controlmode.FAIRMQ {
-						cmd.Arguments = append(cmd.Arguments, "--control-port", strconv.FormatUint(controlPort, 10))
-					}
-
-					// Convenience function that scans through cmd.Env and appends the
-					// given key-value pair if the given variable isn't already
-					// provided by the user.
-					fillEnvDefault := func(varName string, defaultValue string) {
-						varIsUserProvided := false
-						for _, envVar := range cmd.Env {
-							if strings.HasPrefix(envVar, varName) {
-								varIsUserProvided = true
-								break
-							}
-						}
-						if !varIsUserProvided {
-							cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%s", varName, defaultValue))
-						}
-					}
-
-					// Iterated call of the above function for the given kv-map of
-					// env var defaults
-					for varName, defaultValue := range map[string]string{
-						"O2_ROLE": offer.Hostname,
-						"O2_SYSTEM": "FLP",
-					} {
-						fillEnvDefault(varName, defaultValue)
-					}
-
-					runCommand := *cmd
-
-					// Serialize the actual command to be passed to the executor
-					var jsonCommand []byte
-					jsonCommand, err = json.Marshal(&runCommand)
-					if err != nil {
-						log.WithPrefix("scheduler").
-							WithFields(logrus.Fields{
-								"error": err.Error(),
-								"value": *runCommand.Value,
-								"args":  runCommand.Arguments,
-								"shell": *runCommand.Shell,
-								"json":  jsonCommand,
-							}).
-							Error("cannot serialize mesos.CommandInfo for executor")
-						state.Unlock()
-						log.WithPrefix("scheduler").
-							Debug("state unlock")
-						continue
-					}
+			// We make a map[Descriptor]constraint.Constraints and for each descriptor to deploy we
+			// fill it with the pre-computed total constraints for that Descriptor.
+			descriptorConstraints := state.taskman.BuildDescriptorConstraints(descriptorsStillToDeploy)
 
-					// Build resources request
-					resourcesRequest := make(mesos.Resources, 0)
-					resourcesRequest.Add1(resources.NewCPUs(wants.Cpu).Resource)
-					resourcesRequest.Add1(resources.NewMemory(wants.Memory).Resource)
-					portsBuilder := resources.BuildRanges()
-					for _, rng := range wants.StaticPorts {
-						portsBuilder = portsBuilder.Span(rng.Begin, rng.End)
-					}
-					for _, endpoint := range bindMap {
-						// We only add the endpoint to the portsBuilder if it has a port
-						if tcpEndpoint, ok := endpoint.(channel.TcpEndpoint); ok {
-							portsBuilder = portsBuilder.Span(tcpEndpoint.Port, tcpEndpoint.Port)
-						}
-					}
-					portsBuilder = portsBuilder.Span(controlPort, controlPort)
-
-					portRanges := portsBuilder.Ranges.Sort().Squash()
-					portsResources := resources.Build().Name(resources.Name("ports")).Ranges(portRanges)
-					resourcesRequest.Add1(portsResources.Resource)
-
-					// Append executor resources to request
-					executorResources := mesos.Resources(state.executor.Resources)
-					log.WithPrefix("scheduler").
-						WithField("taskResources", resourcesRequest).
-						WithField("executorResources", executorResources).
-						Debug("creating Mesos task")
-					resourcesRequest.Add(executorResources...)
-
-					newTaskId := taskPtr.GetTaskId()
-
-					executor := state.executor
-					executor.ExecutorID.Value = taskPtr.GetExecutorId()
-
-					mesosTaskInfo := mesos.TaskInfo{
-						Name:      taskPtr.GetName(),
-						TaskID:    mesos.TaskID{Value: newTaskId},
-						AgentID:   offer.AgentID,
-						Executor:  executor,
-						Resources: resourcesRequest,
-						Data:      jsonCommand, // this ends up in LAUNCH for the executor
-					}
+			// NOTE: 1 offer per host
+			// Matching descriptors to offers is deterministic for a given set of offers and
+			// descriptors, then building and launching the tasks runs in parallel for all offers.
+			log.WithPrefix("scheduler").Debug("state lock to process descriptors to deploy")
+			state.Lock()
+			matchStart := time.Now()
+			var plans offerPlans
+			plans, descriptorsStillToDeploy = planOffers(offers,
+				descriptorsStillToDeploy,
+				descriptorConstraints,
+				state.taskman.GetWantsForDescriptor,
+				state.portPools,
+				&state.taskman.AgentCache,
+				concurrency)
+			state.metricsAPI.deploymentPhaseLatency(time.Since(matchStart).Seconds(), "match")
+			state.Unlock()
+			log.WithPrefix("scheduler").
+				WithFields(logrus.Fields{
+					"offersUsed": len(plans),
+					"descriptorsLeft": len(descriptorsStillToDeploy),
+				}).
+				Debug("state unlock")
 
-					// We must run the executor with a special LD_LIBRARY_PATH because
-					// its InfoLogger binding is built with GCC-Toolchain
-					ldLibPath, ok := agentForCache.Attributes.Get("executor_env_LD_LIBRARY_PATH")
-					mesosTaskInfo.Executor.Command.Environment = &mesos.Environment{}
-					if ok {
-						mesosTaskInfo.Executor.Command.Environment.Variables =
-						append(mesosTaskInfo.Executor.Command.Environment.Variables,
-							mesos.Environment_Variable{
-								Name: "LD_LIBRARY_PATH",
-								Value: proto.String(ldLibPath),
-							})
-					}
+			var mu sync.Mutex
+			launchStart := time.Now()
+			forEachParallel(len(plans), concurrency, func(i int) {
+				deployedForOffer, launchErr := state.launchOfferPlan(ctx, plans[i], callOption)
 
-					log.WithPrefix("scheduler").
-						WithFields(logrus.Fields{
-						"taskId":     newTaskId,
-						"offerId":    offer.ID.Value,
-						"executorId": state.executor.ExecutorID.Value,
-						"command":    mesosTaskInfo.Command.GetValue(),
-						"arguments":  mesosTaskInfo.Command.GetArguments(),
-						"shenv":      mesosTaskInfo.Command.GetEnvironment().String(),
-						"user":       mesosTaskInfo.Command.GetUser(),
-					}).Debug("launching task")
-					taskPtr.SendEvent(&event.TaskEvent{Name: taskPtr.GetName(), TaskID: newTaskId, State:"LAUNCHED", Hostname: taskPtr.hostname , ClassName: taskPtr.GetClassName()})
-
-					taskInfosToLaunchForCurrentOffer = append(taskInfosToLaunchForCurrentOffer, mesosTaskInfo)
-					descriptorsStillToDeploy = append(descriptorsStillToDeploy[:i], descriptorsStillToDeploy[i+1:]...)
-					tasksDeployedForCurrentOffer[taskPtr] = descriptor
-				} // end FOR_DESCRIPTORS
-				state.Unlock()
-				log.WithPrefix("scheduler").Debug("state unlock")
-
-				// build ACCEPT call to launch all of the tasks we've assembled
-				accept := calls.Accept(
-					calls.OfferOperations{calls.OpLaunch(taskInfosToLaunchForCurrentOffer...)}.WithOffers(offer.ID),
-				).With(callOption) // handles refuseSeconds etc.
-
-				// send ACCEPT call to mesos
-				err = calls.CallNoData(ctx, state.cli, accept)
-				if err != nil {
-					log.WithPrefix("scheduler").WithField("error", err.Error()).
+				mu.Lock()
+				defer mu.Unlock()
+				if launchErr != nil {
+					log.WithPrefix("scheduler").WithField("error", launchErr.Error()).
 						Error("failed to launch tasks")
 					// FIXME: we probably need to react to a failed ACCEPT here
-				} else {
-					if n := len(taskInfosToLaunchForCurrentOffer); n > 0 {
-						tasksLaunchedThisCycle += n
-						log.WithPrefix("scheduler").WithField("tasks", n).
-							Info("tasks launched")
-						for _, taskInfo := range taskInfosToLaunchForCurrentOffer {
-							log.WithPrefix("scheduler").
-								WithFields(logrus.Fields{
-									"executorId": taskInfo.GetExecutor().ExecutorID.Value,
-									"executorName": taskInfo.GetExecutor().GetName(),
-									"agentId": taskInfo.GetAgentID().Value,
-									"taskId": taskInfo.GetTaskID().Value,
-								}).
-								Debug("launched")
-						}
+					return
+				}
+				if len(deployedForOffer) == 0 {
+					return
+				}
 
-						// update deployment map
-						for k, v := range tasksDeployedForCurrentOffer {
-							tasksDeployed[k] = v
-						}
-					} else {
-						offersDeclined++
-					}
+				// The offer was accepted, so we must not decline it
+				delete(offerIDsToDecline, plans[i].offer.ID)
+
+				tasksLaunchedThisCycle += len(deployedForOffer)
+				// update deployment map
+				for k, v := range deployedForOffer {
+					tasksDeployed[k] = v
 				}
-			} // end for _, offerUsed := range offersUsed
+			})
+			state.metricsAPI.deploymentPhaseLatency(time.Since(launchStart).Seconds(), "launch")
 		} // end if len(descriptorsStillToDeploy) > 0
 
 		// build DECLINE call to reject offers we don't need any more
@@ -814,7 +613,7 @@ func (state *schedulerState) resourceOffers(fidStore store.Singleton) events.Han
 		}
 
 		// Update metrics...
-		state.metricsAPI.offersDeclined.Int(offersDeclined)
+		state.metricsAPI.offersDeclined.Int(len(offerIDsToDecline))
 		state.metricsAPI.tasksLaunched.Int(tasksLaunchedThisCycle)
 		if viper.GetBool("summaryMetrics") {
 			state.metricsAPI.launchesPerOfferCycle(float64(tasksLaunchedThisCycle))
@@ -830,6 +629,229 @@ func (state *schedulerState) resourceOffers(fidStore store.Singleton) events.Han
 	}
 }
 
+// launchOfferPlan builds the Tasks for all the descriptors matched to a single
+// offer, and sends the ACCEPT call that launches them.
+// It is safe to run for multiple offers at the same time.
+func (state *schedulerState) launchOfferPlan(ctx context.Context, plan offerPlan, callOption scheduler.CallOpt) (tasksDeployedForCurrentOffer DeploymentMap, err error) {
+	var (
+		offer = plan.offer
+		taskInfosToLaunchForCurrentOffer = make([]mesos.TaskInfo, 0)
+		targetExecutorId = mesos.ExecutorID{}
+		portsForTaskId = make(map[string][]uint64)
+	)
+	tasksDeployedForCurrentOffer = make(DeploymentMap)
+
+	// If there are no executors provided by the offer,
+	// we start a new one by generating a new ID
+	if len(offer.ExecutorIDs) == 0 {
+		targetExecutorId.Value = uid.New().String()
+	} else {
+		targetExecutorId.Value = offer.ExecutorIDs[0].Value
+	}
+
+	log.WithPrefix("scheduler").
+		WithFields(logrus.Fields{
+		"offerId":   offer.ID.Value,
+		"resources": mesos.Resources(offer.Resources).String(),
+		"matches":   len(plan.matches),
+	}).Debug("processing offer")
+
+	agentForCache := AgentCacheInfo{
+		AgentId: offer.AgentID,
+		Attributes: offer.Attributes,
+		Hostname: offer.Hostname,
+	}
+	state.taskman.AgentCache.Update(agentForCache) //thread safe
+
+	buildStart := time.Now()
+	for _, match := range plan.matches {
+		descriptor := match.descriptor
+		wants := match.wants
+		bindMap := match.bindMap
+		controlPort := match.controlPort
+
+		taskPtr := state.taskman.newTaskForMesosOffer(offer, descriptor, bindMap, targetExecutorId)
+		if taskPtr == nil {
+			log.WithPrefix("scheduler").
+				WithField("offerId", offer.ID.Value).
+				Error("cannot get task for offer+descriptor, this should never happen")
+			continue
+		}
+		taskPtr.resourceAllocations = match.allocations
+
+		// Build the O² process to run as a mesos.CommandInfo, which we'll then JSON-serialize
+		err = taskPtr.BuildTaskCommand(descriptor.TaskRole)
+		if err != nil {
+			log.WithPrefix("scheduler").
+				WithField("offerId", offer.ID.Value).
+				WithError(err).
+				Error("cannot build task command")
+			err = nil
+			continue
+		}
+		cmd := taskPtr.GetTaskCommandInfo()
+
+		// Append control port to arguments
+		// For the control port parameter and/or environment variable, see occ/OccGlobals.h
+		if cmd.ControlMode != controlmode.BASIC &&
+			cmd.ControlMode != controlmode.HOOK {
+			cmd.ControlPort = controlPort
+			cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%d", "OCC_CONTROL_PORT", controlPort))
+		}
+
+		if cmd.ControlMode == controlmode.FAIRMQ {
+			cmd.Arguments = append(cmd.Arguments, "--control-port", strconv.FormatUint(controlPort, 10))
+		}
+
+		// Pass any custom resources allocated to this task, e.g. O2_RESOURCE_GPUS=1
+		for _, allocation := range match.allocations {
+			cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%s", allocation.EnvVarName(), allocation.String()))
+		}
+
+		// Convenience function that scans through cmd.Env and appends the
+		// given key-value pair if the given variable isn't already
+		// provided by the user.
+		fillEnvDefault := func(varName string, defaultValue string) {
+			varIsUserProvided := false
+			for _, envVar := range cmd.Env {
+				if strings.HasPrefix(envVar, varName) {
+					varIsUserProvided = true
+					break
+				}
+			}
+			if !varIsUserProvided {
+				cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%s", varName, defaultValue))
+			}
+		}
+
+		// Iterated call of the above function for the given kv-map of
+		// env var defaults
+		for varName, defaultValue := range map[string]string{
+			"O2_ROLE": offer.Hostname,
+			"O2_SYSTEM": "FLP",
+		} {
+			fillEnvDefault(varName, defaultValue)
+		}
+
+		runCommand := *cmd
+
+		// Serialize the actual command to be passed to the executor
+		var jsonCommand []byte
+		jsonCommand, err = json.Marshal(&runCommand)
+		if err != nil {
+			log.WithPrefix("scheduler").
+				WithFields(logrus.Fields{
+					"error": err.Error(),
+					"value": *runCommand.Value,
+					"args":  runCommand.Arguments,
+					"shell": *runCommand.Shell,
+					"json":  jsonCommand,
+				}).
+				Error("cannot serialize mesos.CommandInfo for executor")
+			err = nil
+			continue
+		}
+
+		// Build resources request
+		resourcesRequest := make(mesos.Resources, 0)
+		resourcesRequest.Add1(resources.NewCPUs(wants.Cpu).Resource)
+		resourcesRequest.Add1(resources.NewMemory(wants.Memory).Resource)
+		portsBuilder := resources.BuildRanges()
+		for _, rng := range wants.StaticPorts {
+			portsBuilder = portsBuilder.Span(rng.Begin, rng.End)
+		}
+		for _, endpoint := range bindMap {
+			// We only add the endpoint to the portsBuilder if it has a port
+			if tcpEndpoint, ok := endpoint.(channel.TcpEndpoint); ok {
+				portsBuilder = portsBuilder.Span(tcpEndpoint.Port, tcpEndpoint.Port)
+			}
+		}
+		portsBuilder = portsBuilder.Span(controlPort, controlPort)
+
+		portRanges := portsBuilder.Ranges.Sort().Squash()
+		portsResources := resources.Build().Name(resources.Name("ports")).Ranges(portRanges)
+		resourcesRequest.Add1(portsResources.Resource)
+		for _, allocation := range match.allocations {
+			resourcesRequest.Add1(allocation.resource())
+		}
+
+		// Append executor resources to request
+		executorResources := mesos.Resources(state.executor.Resources)
+		log.WithPrefix("scheduler").
+			WithField("taskResources", resourcesRequest).
+			WithField("executorResources", executorResources).
+			Debug("creating Mesos task")
+		resourcesRequest.Add(executorResources...)
+
+		newTaskId := taskPtr.GetTaskId()
+
+		executor := executorInfoForTask(state.executor, taskPtr.GetExecutorId(), agentForCache.Attributes)
+
+		mesosTaskInfo := mesos.TaskInfo{
+			Name:      taskPtr.GetName(),
+			TaskID:    mesos.TaskID{Value: newTaskId},
+			AgentID:   offer.AgentID,
+			Executor:  executor,
+			Resources: resourcesRequest,
+			Data:      jsonCommand, // this ends up in LAUNCH for the executor
+		}
+
+		log.WithPrefix("scheduler").
+			WithFields(logrus.Fields{
+			"taskId":     newTaskId,
+			"offerId":    offer.ID.Value,
+			"executorId": executor.ExecutorID.Value,
+			"command":    mesosTaskInfo.Command.GetValue(),
+			"arguments":  mesosTaskInfo.Command.GetArguments(),
+			"shenv":      mesosTaskInfo.Command.GetEnvironment().String(),
+			"user":       mesosTaskInfo.Command.GetUser(),
+		}).Debug("launching task")
+		taskPtr.SendEvent(&event.TaskEvent{Name: taskPtr.GetName(), TaskID: newTaskId, State:"LAUNCHED", Hostname: taskPtr.hostname , ClassName: taskPtr.GetClassName()})
+
+		taskInfosToLaunchForCurrentOffer = append(taskInfosToLaunchForCurrentOffer, mesosTaskInfo)
+		tasksDeployedForCurrentOffer[taskPtr] = descriptor
+		portsForTaskId[newTaskId] = match.ports()
+	}
+	state.metricsAPI.deploymentPhaseLatency(time.Since(buildStart).Seconds(), "build")
+
+	// If nothing could be built, we leave this offer to be declined
+	if len(taskInfosToLaunchForCurrentOffer) == 0 {
+		return
+	}
+
+	// build ACCEPT call to launch all of the tasks we've assembled
+	accept := calls.Accept(
+		calls.OfferOperations{calls.OpLaunch(taskInfosToLaunchForCurrentOffer...)}.WithOffers(offer.ID),
+	).With(callOption) // handles refuseSeconds etc.
+
+	// send ACCEPT call to mesos
+	acceptStart := time.Now()
+	err = calls.CallNoData(ctx, state.cli, accept)
+	state.metricsAPI.deploymentPhaseLatency(time.Since(acceptStart).Seconds(), "accept")
+	if err != nil {
+		return nil, err
+	}
+
+	// Record the ports we just gave out in this agent's port ledger
+	for taskId, ports := range portsForTaskId {
+		state.taskman.AgentCache.ReservePorts(offer.AgentID, taskId, ports...)
+	}
+
+	log.WithPrefix("scheduler").WithField("tasks", len(taskInfosToLaunchForCurrentOffer)).
+		Info("tasks launched")
+	for _, taskInfo := range taskInfosToLaunchForCurrentOffer {
+		log.WithPrefix("scheduler").
+			WithFields(logrus.Fields{
+				"executorId": taskInfo.GetExecutor().ExecutorID.Value,
+				"executorName": taskInfo.GetExecutor().GetName(),
+				"agentId": taskInfo.GetAgentID().Value,
+				"taskId": taskInfo.GetTaskID().Value,
+			}).
+			Debug("launched")
+	}
+	return
+}
+
 // statusUpdate handles an incoming UPDATE event.
 // This func runs after acknowledgement.
 func (state *schedulerState) statusUpdate() events.HandlerFunc {
@@ -1000,3 +1022,31 @@ func logCalls(messages map[scheduler.Call_Type]string) callrules.Rule {
 		return ch(ctx, c, r, err)
 	}
 }
+
+// executorInfoForTask makes our own copy of the ExecutorInfo for a task,
+// since other offers are being processed at the same time. The environment
+// prepared by NewScheduler is kept, and if the agent asks for it, the
+// executor also gets a special LD_LIBRARY_PATH because its InfoLogger
+// binding is built with GCC-Toolchain.
+func executorInfoForTask(base *mesos.ExecutorInfo, executorId string, agentAttributes constraint.Attributes) *mesos.ExecutorInfo {
+	executor := *base
+	executor.ExecutorID.Value = executorId
+	if base.Command == nil {
+		return &executor
+	}
+	executorCommand := *base.Command
+	executor.Command = &executorCommand
+
+	var variables []mesos.Environment_Variable
+	if base.Command.Environment != nil {
+		variables = append(variables, base.Command.Environment.Variables...)
+	}
+	if ldLibPath, ok := agentAttributes.Get("executor_env_LD_LIBRARY_PATH"); ok {
+		variables = append(variables, mesos.Environment_Variable{
+			Name:  "LD_LIBRARY_PATH",
+			Value: proto.String(ldLibPath),
+		})
+	}
+	executorCommand.Environment = &mesos.Environment{Variables: variables}
+	return &executor
+}
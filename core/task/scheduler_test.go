@@ -0,0 +1,82 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2021 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+
+package task
+
+import (
+	"testing"
+
+	"github.com/AliceO2Group/Control/core/task/constraint"
+	"github.com/mesos/mesos-go/api/v1/lib"
+	"google.golang.org/protobuf/proto"
+)
+
+func executorEnvironment(executor *mesos.ExecutorInfo) map[string]string {
+	env := make(map[string]string)
+	for _, v := range executor.GetCommand().GetEnvironment().GetVariables() {
+		env[v.Name] = v.GetValue()
+	}
+	return env
+}
+
+func TestExecutorInfoForTaskKeepsStateDir(t *testing.T) {
+	base := &mesos.ExecutorInfo{
+		ExecutorID: mesos.ExecutorID{Value: "base"},
+		Command:    &mesos.CommandInfo{Value: proto.String("/opt/o2control-executor")},
+	}
+	setExecutorStateDir(base, "/var/lib/o2/executor/taskstate")
+
+	attributes := constraint.Attributes{{
+		Name: "executor_env_LD_LIBRARY_PATH",
+		Type: mesos.TEXT,
+		Text: &mesos.Value_Text{Value: "/opt/gcc/lib64"},
+	}}
+	executor := executorInfoForTask(base, "executor-1", attributes)
+
+	if executor.ExecutorID.Value != "executor-1" {
+		t.Errorf("expected executor id executor-1, got %s", executor.ExecutorID.Value)
+	}
+	env := executorEnvironment(executor)
+	if env["O2_EXECUTOR_STATE_DIR"] != "/var/lib/o2/executor/taskstate" {
+		t.Errorf("expected the state dir to be passed to the executor, got %v", env)
+	}
+	if env["LD_LIBRARY_PATH"] != "/opt/gcc/lib64" {
+		t.Errorf("expected LD_LIBRARY_PATH from the agent attributes, got %v", env)
+	}
+
+	// the shared ExecutorInfo must not be modified
+	if base.ExecutorID.Value != "base" {
+		t.Errorf("base executor id changed to %s", base.ExecutorID.Value)
+	}
+	if baseEnv := executorEnvironment(base); len(baseEnv) != 1 {
+		t.Errorf("base executor environment changed to %v", baseEnv)
+	}
+
+	// without the agent attribute, only the state dir is set
+	executor = executorInfoForTask(base, "executor-2", nil)
+	if env = executorEnvironment(executor); len(env) != 1 || env["O2_EXECUTOR_STATE_DIR"] == "" {
+		t.Errorf("unexpected executor environment %v", env)
+	}
+}
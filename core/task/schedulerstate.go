@@ -44,6 +44,7 @@ import (
 	"github.com/mesos/mesos-go/api/v1/lib/scheduler/calls"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/viper"
+	proto "google.golang.org/protobuf/proto"
 )
 
 
@@ -70,6 +71,7 @@ type schedulerState struct {
 	role               string
 	cli                calls.Caller
 	shutdown           func()
+	portPools          portPools
 
 	// uses prometheus counters, so thread safe
 	metricsAPI         *metricsAPI
@@ -92,6 +94,13 @@ func NewScheduler(taskman *Manager, fidStore store.Singleton, shutdown func()) (
 		viper.GetDuration("mesosJobRestartDelay"),
 	)
 
+	if err != nil {
+		return nil, err
+	}
+	if viper.GetBool("taskAdoption") {
+		setExecutorStateDir(executorInfo, viper.GetString("taskAdoptionStateDir"))
+	}
+	portPools, err := newPortPoolsFromConfig()
 	if err != nil {
 		return nil, err
 	}
@@ -122,6 +131,7 @@ func NewScheduler(taskman *Manager, fidStore store.Singleton, shutdown func()) (
 		cli:                schedutil.BuildHTTPSched(creds),
 		random:             rand.New(rand.NewSource(time.Now().Unix())),
 		shutdown:           shutdown,
+		portPools:          portPools,
 	}
 
 	state.servent = controlcommands.NewServent(
@@ -212,3 +222,20 @@ func (state *schedulerState) Start(ctx context.Context) {
 		}
 	}()
 }
+
+// setExecutorStateDir tells the executor where to keep the state of its
+// tasks. A restarted executor gets a new sandbox, so it must find the state
+// of the tasks to adopt elsewhere.
+func setExecutorStateDir(executorInfo *mesos.ExecutorInfo, dir string) {
+	if executorInfo.Command == nil {
+		return
+	}
+	if executorInfo.Command.Environment == nil {
+		executorInfo.Command.Environment = &mesos.Environment{}
+	}
+	executorInfo.Command.Environment.Variables = append(executorInfo.Command.Environment.Variables,
+		mesos.Environment_Variable{
+			Name:  "O2_EXECUTOR_STATE_DIR",
+			Value: proto.String(dir),
+		})
+}
@@ -32,6 +32,7 @@ package task
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -129,6 +130,18 @@ type Task struct {
 
 	commandInfo  *common.TaskCommandInfo
 	pid          string
+
+	resourceAllocations ResourceAllocations
+
+	resourceUsage       *event.ResourceUsage
+	resourceUsageLabels []string
+
+	startupPhase        string
+	startupBegan        time.Time
+
+	unhealthy           map[string]string // failing health check (type#index) -> last failure message
+
+	occCapabilities     *event.OccCapabilities
 }
 
 func (t *Task) IsSafeToStop() bool {
@@ -236,6 +249,7 @@ func (t *Task) BuildTaskCommand(role parentRole) (err error) {
 	if class := t.GetTaskClass(); class != nil {
 		cmd := &common.TaskCommandInfo{}
 		cmd.CommandInfo = *class.Command.Copy()
+		cmd.Health = class.Control.Health.Copy()
 
 		// If it's a basic task, we parametrize its arguments
 		// TODO: the task payload should be shipped on CONFIGURE and not on deployment,
@@ -268,7 +282,7 @@ func (t *Task) BuildTaskCommand(role parentRole) (err error) {
 			// We resolve any template expressions in Defaults
 			defaultFields := template.WrapMapItems(localDefaults)
 
-			err = defaultFields.Execute(the.ConfSvc(), t.name, varStack, nil, make(map[string]texttemplate.Template))
+			err = defaultFields.Execute(the.ConfRecorder(role.GetEnvironmentId()), t.name, varStack, nil, make(map[string]texttemplate.Template))
 			if err != nil {
 				t.commandInfo = &common.TaskCommandInfo{}
 				log.WithError(err).Error("cannot resolve templates for task defaults")
@@ -303,7 +317,15 @@ func (t *Task) BuildTaskCommand(role parentRole) (err error) {
 			if cmd.Log != nil { // we only template it if it's defined
 				fields = append(fields, template.WrapPointer(cmd.Log))
 			}
-			err = fields.Execute(the.ConfSvc(), t.name, varStack, nil, make(map[string]texttemplate.Template))
+			for i := range cmd.Health {
+				if cmd.Health[i].Url != nil {
+					fields = append(fields, template.WrapPointer(cmd.Health[i].Url))
+				}
+				if cmd.Health[i].Command != nil {
+					fields = append(fields, template.WrapPointer(cmd.Health[i].Command))
+				}
+			}
+			err = fields.Execute(the.ConfRecorder(role.GetEnvironmentId()), t.name, varStack, nil, make(map[string]texttemplate.Template))
 			if err != nil {
 				t.commandInfo = &common.TaskCommandInfo{}
 				log.WithError(err).Error("cannot resolve templates for task command info")
@@ -323,6 +345,14 @@ func (t *Task) BuildTaskCommand(role parentRole) (err error) {
 		}
 
 		cmd.ControlMode = t.GetControlMode() // This might change BASIC->HOOK
+		cmd.Startup = class.Control.Startup
+		cmd.Kill = class.Control.Kill
+		cmd.Isolation = class.Isolation
+		cmd.ResourceSamplingInterval = the.CoreSettingDuration("taskResourceSamplingInterval")
+		cmd.LogMaxSize = int64(the.CoreSettingSizeInBytes("taskLogMaxSize"))
+		cmd.LogMaxFiles = the.CoreSettingInt("taskLogMaxFiles")
+		cmd.Adoptable = viper.GetBool("taskAdoption") &&
+			(cmd.ControlMode == controlmode.DIRECT || cmd.ControlMode == controlmode.FAIRMQ)
 
 		// If it's a HOOK, we must pass the Timeout to the TCI for
 		// executor-side timeout enforcement
@@ -434,6 +464,14 @@ func (t *Task) SendEvent(ev event.Event) {
 	t.parent.SendEvent(ev)
 }
 
+// GetResourceAllocations returns the named scalar and set resources (other
+// than cpu, memory and ports) which were allocated to this task on launch.
+func (t *Task) GetResourceAllocations() ResourceAllocations {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.resourceAllocations
+}
+
 func (t *Task) GetLocalBindMap() channel.BindMap {
 	t.mu.RLock()
 	defer t.mu.RUnlock()
@@ -443,6 +481,9 @@ func (t *Task) GetLocalBindMap() channel.BindMap {
 func fillCommonProperties(t *Task, propMap controlcommands.PropertyMap) {
 	envId := t.GetEnvironmentId()
 	propMap["environment_id"] = envId.String()
+	for _, allocation := range t.GetResourceAllocations() {
+		propMap[allocation.PropertyKey()] = allocation.String()
+	}
 }
 
 func (t *Task) BuildPropertyMap(bindMap channel.BindMap) (propMap controlcommands.PropertyMap, err error) {
@@ -529,7 +570,7 @@ func (t *Task) BuildPropertyMap(bindMap channel.BindMap) (propMap controlcommand
 
 			fields := template.WrapMapItems(propMap)
 
-			err = fields.Execute(the.ConfSvc(), t.name, varStack, objStack, make(map[string]texttemplate.Template))
+			err = fields.Execute(the.ConfRecorder(t.GetEnvironmentId()), t.name, varStack, objStack, make(map[string]texttemplate.Template))
 			if err != nil {
 				log.WithError(err).Error("cannot resolve templates for property map")
 				return
@@ -611,6 +652,139 @@ func (t *Task) GetTaskPID() string {
 	return t.pid
 }
 
+func (t *Task) setResourceUsage(usage event.ResourceUsage) {
+	rolePath := ""
+	if parent := t.GetParent(); parent != nil {
+		rolePath = parent.GetPath()
+	}
+	labels := []string{t.GetEnvironmentId().String(), rolePath, t.GetClassName()}
+
+	t.mu.Lock()
+	previousLabels := t.resourceUsageLabels
+	t.resourceUsage = &usage
+	t.resourceUsageLabels = labels
+	t.mu.Unlock()
+
+	if previousLabels != nil && strings.Join(previousLabels, "/") != strings.Join(labels, "/") {
+		forgetTaskResourceUsage(previousLabels)
+	}
+	recordTaskResourceUsage(labels, usage)
+}
+
+// forgetResourceUsage drops the last known resource usage sample, and
+// removes the corresponding metrics, once the task is gone or released.
+func (t *Task) forgetResourceUsage() {
+	t.mu.Lock()
+	labels := t.resourceUsageLabels
+	t.resourceUsage = nil
+	t.resourceUsageLabels = nil
+	t.mu.Unlock()
+
+	if labels != nil {
+		forgetTaskResourceUsage(labels)
+	}
+}
+
+// GetResourceUsage returns the last resource usage sample reported by the
+// executor, or nil if none was received yet.
+func (t *Task) GetResourceUsage() *event.ResourceUsage {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	if t.resourceUsage == nil {
+		return nil
+	}
+	usage := *t.resourceUsage
+	return &usage
+}
+
+func (t *Task) setStartupProgress(phase string, elapsed time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.startupPhase = phase
+	t.startupBegan = time.Now().Add(-elapsed)
+}
+
+func (t *Task) clearStartupProgress() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.startupPhase = ""
+	t.startupBegan = time.Time{}
+}
+
+// GetStartupProgress returns the startup phase last reported by the executor
+// and the time elapsed since the task was launched, or an empty phase if the
+// task is not starting up.
+func (t *Task) GetStartupProgress() (phase string, elapsed time.Duration) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	if len(t.startupPhase) == 0 {
+		return "", 0
+	}
+	return t.startupPhase, time.Since(t.startupBegan)
+}
+
+func (t *Task) setOccCapabilities(capabilities event.OccCapabilities) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.occCapabilities = &capabilities
+}
+
+// GetOccCapabilities returns what the executor negotiated with the OCC server
+// of the task, or nil for tasks which aren't controlled through OCC or
+// haven't been reached yet.
+func (t *Task) GetOccCapabilities() *event.OccCapabilities {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	if t.occCapabilities == nil {
+		return nil
+	}
+	capabilities := *t.occCapabilities
+	return &capabilities
+}
+
+// setHealth records the outcome of a health check threshold crossing. Checks
+// with the warn policy don't affect the health of the task.
+func (t *Task) setHealth(th *event.TaskHealth) {
+	if th.Policy == common.HealthPolicyWarn {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if th.Healthy {
+		delete(t.unhealthy, th.CheckKey())
+		return
+	}
+	if t.unhealthy == nil {
+		t.unhealthy = make(map[string]string)
+	}
+	t.unhealthy[th.CheckKey()] = th.Message
+}
+
+// GetHealth returns whether all health checks of the task are passing, and
+// if not, a summary of the failing ones.
+func (t *Task) GetHealth() (healthy bool, message string) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	if len(t.unhealthy) == 0 {
+		return true, ""
+	}
+	failing := make([]string, 0, len(t.unhealthy))
+	for check, msg := range t.unhealthy {
+		failing = append(failing, check + ": " + msg)
+	}
+	sort.Strings(failing)
+	return false, strings.Join(failing, "; ")
+}
+
 func (t *Task) GetParent() parentRole {
 	t.mu.RLock()
 	defer t.mu.RUnlock()
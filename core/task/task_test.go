@@ -0,0 +1,77 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2021 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+
+package task
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/AliceO2Group/Control/common"
+	"github.com/AliceO2Group/Control/common/event"
+)
+
+func TestSetHealthTracksChecksOfTheSameType(t *testing.T) {
+	task := &Task{}
+	health := func(index int, healthy bool, message string) *event.TaskHealth {
+		return &event.TaskHealth{
+			Check:   common.HealthCheckHttp,
+			Index:   index,
+			Policy:  common.HealthPolicyUnhealthy,
+			Healthy: healthy,
+			Message: message,
+		}
+	}
+
+	task.setHealth(health(0, false, "first down"))
+	task.setHealth(health(1, false, "second down"))
+	healthy, message := task.GetHealth()
+	if healthy || message != "http#0: first down; http#1: second down" {
+		t.Fatalf("expected both checks failing, got healthy=%v message=%q", healthy, message)
+	}
+
+	// one check recovering must not hide the other one
+	task.setHealth(health(0, true, ""))
+	healthy, message = task.GetHealth()
+	if healthy || !strings.Contains(message, "second down") || strings.Contains(message, "first down") {
+		t.Fatalf("expected only the second check failing, got healthy=%v message=%q", healthy, message)
+	}
+
+	task.setHealth(health(1, true, ""))
+	if healthy, message = task.GetHealth(); !healthy || message != "" {
+		t.Fatalf("expected healthy task, got message=%q", message)
+	}
+}
+
+func TestSetHealthIgnoresWarnPolicy(t *testing.T) {
+	task := &Task{}
+	task.setHealth(&event.TaskHealth{
+		Check:  common.HealthCheckHttp,
+		Policy: common.HealthPolicyWarn,
+	})
+	if healthy, _ := task.GetHealth(); !healthy {
+		t.Fatal("expected a warn check not to affect task health")
+	}
+}
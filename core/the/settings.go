@@ -0,0 +1,87 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2021 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+
+package the
+
+import (
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+// The core settings entry is read into viper at startup. Viper is not safe
+// for concurrent use, so when the entry changes while the core is running
+// we keep the new values here instead, and the settings which may change
+// at runtime are read through the CoreSetting* functions.
+var (
+	coreSettingsMu sync.RWMutex
+	coreSettings   *viper.Viper
+)
+
+// SetCoreSettings replaces the reloaded settings. The instance must not be
+// modified afterwards.
+func SetCoreSettings(settings *viper.Viper) {
+	coreSettingsMu.Lock()
+	defer coreSettingsMu.Unlock()
+	coreSettings = settings
+}
+
+// coreSettingsFor returns where the current value of key lives: the
+// reloaded settings, unless the key was overridden on the command line or
+// in the environment, since those always take precedence over the entry.
+func coreSettingsFor(key string) *viper.Viper {
+	if flag := pflag.Lookup(key); flag != nil && flag.Changed {
+		return viper.GetViper()
+	}
+	if _, ok := os.LookupEnv("ALIECS_" + strings.ToUpper(key)); ok {
+		return viper.GetViper()
+	}
+	coreSettingsMu.RLock()
+	defer coreSettingsMu.RUnlock()
+	if coreSettings != nil && coreSettings.IsSet(key) {
+		return coreSettings
+	}
+	return viper.GetViper()
+}
+
+func CoreSettingBool(key string) bool {
+	return coreSettingsFor(key).GetBool(key)
+}
+
+func CoreSettingInt(key string) int {
+	return coreSettingsFor(key).GetInt(key)
+}
+
+func CoreSettingDuration(key string) time.Duration {
+	return coreSettingsFor(key).GetDuration(key)
+}
+
+func CoreSettingSizeInBytes(key string) uint {
+	return coreSettingsFor(key).GetSizeInBytes(key)
+}
@@ -25,8 +25,12 @@
 package the
 
 import (
+	"sync"
+
 	"github.com/AliceO2Group/Control/apricot"
+	"github.com/AliceO2Group/Control/common/utils/uid"
 	"github.com/AliceO2Group/Control/configuration"
+	"github.com/AliceO2Group/Control/configuration/template"
 	"github.com/AliceO2Group/Control/core/bookkeeping"
 	"github.com/AliceO2Group/Control/core/repos"
 )
@@ -35,6 +39,31 @@ func ConfSvc() configuration.Service {
 	return apricot.Instance()
 }
 
+var (
+	confRecordersMu sync.Mutex
+	confRecorders   = make(map[uid.ID]*template.Recorder)
+)
+
+// ConfRecorder returns the configuration service through which everything
+// templated for an environment should go, so that the configuration used by
+// each of its runs can be snapshotted.
+func ConfRecorder(envId uid.ID) *template.Recorder {
+	confRecordersMu.Lock()
+	defer confRecordersMu.Unlock()
+	recorder, ok := confRecorders[envId]
+	if !ok {
+		recorder = template.NewRecorder(ConfSvc())
+		confRecorders[envId] = recorder
+	}
+	return recorder
+}
+
+func DiscardConfRecorder(envId uid.ID) {
+	confRecordersMu.Lock()
+	defer confRecordersMu.Unlock()
+	delete(confRecorders, envId)
+}
+
 func RepoManager() *repos.RepoManager {
 	return repos.Instance(ConfSvc())
 }
@@ -118,7 +118,7 @@ func (r *aggregatorRole) ProcessTemplates(workflowRepo *repos.Repo, loadSubworkf
 	}
 
 	// TODO: push cached templates here
-	err = templSequence.Execute(the.ConfSvc(), r.GetPath(), template.VarStack{
+	err = templSequence.Execute(the.ConfRecorder(r.GetEnvironmentId()), r.GetPath(), template.VarStack{
 		Locals:   r.Locals,
 		Defaults: r.Defaults,
 		Vars:     r.Vars,
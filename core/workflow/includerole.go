@@ -99,7 +99,7 @@ func (r *includeRole) ProcessTemplates(workflowRepo *repos.Repo, loadSubworkflow
 	}
 
 	// TODO: push cached templates here
-	err = templSequence.Execute(the.ConfSvc(), r.GetPath(), template.VarStack{
+	err = templSequence.Execute(the.ConfRecorder(r.GetEnvironmentId()), r.GetPath(), template.VarStack{
 		Locals:   r.Locals,
 		Defaults: r.Defaults,
 		Vars:     r.Vars,
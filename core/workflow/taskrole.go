@@ -149,7 +149,7 @@ func (t *taskRole) ProcessTemplates(workflowRepo *repos.Repo, _ LoadSubworkflowF
 	}
 
 	// FIXME: push cached templates here
-	err = templSequence.Execute(the.ConfSvc(), t.GetPath(), template.VarStack{
+	err = templSequence.Execute(the.ConfRecorder(t.GetEnvironmentId()), t.GetPath(), template.VarStack{
 		Locals:   t.Locals,
 		Defaults: t.Defaults,
 		Vars:     t.Vars,
@@ -0,0 +1,354 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2021 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+
+package executable
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/AliceO2Group/Control/common"
+	"github.com/AliceO2Group/Control/common/controlmode"
+	"github.com/AliceO2Group/Control/common/event"
+	"github.com/AliceO2Group/Control/executor/executorcmd"
+	pb "github.com/AliceO2Group/Control/executor/protos"
+	mesos "github.com/mesos/mesos-go/api/v1/lib"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+)
+
+const adoptedPollingInterval = 1*time.Second
+
+// TaskStateDirEnv is where the executor keeps the state files of its
+// adoptable tasks. It must be a path which outlives the executor's sandbox,
+// since Mesos gives a restarted executor a new one. If it isn't set, tasks
+// are launched as not adoptable.
+const TaskStateDirEnv = "O2_EXECUTOR_STATE_DIR"
+
+// taskState is what a restarted executor needs to know to take over a task
+// launched by its predecessor.
+type taskState struct {
+	TaskId           string                       `json:"taskId"`
+	Pgid             int                          `json:"pgid"`
+	Pid              int                          `json:"pid"`
+	IsolatedPid      int                          `json:"isolatedPid,omitempty"`
+	StartTime        uint64                       `json:"startTime"`
+	ControlPort      uint64                       `json:"controlPort"`
+	ControlMode      controlmode.ControlMode      `json:"controlMode"`
+	ControlTransport executorcmd.ControlTransport `json:"controlTransport"`
+	LogDir           string                       `json:"logDir,omitempty"`
+	TaskInfo         mesos.TaskInfo               `json:"taskInfo"`
+}
+
+func taskStateDir() string {
+	return os.Getenv(TaskStateDirEnv)
+}
+
+// canAdopt tells whether tasks launched by this executor can be adopted by
+// its successor, which requires a state directory outside the sandbox.
+func canAdopt() bool {
+	return len(taskStateDir()) > 0
+}
+
+func taskStatePath(taskId string) string {
+	return filepath.Join(taskStateDir(), taskId + ".json")
+}
+
+// persistState writes the task state file, so that the task can be adopted
+// should this executor crash.
+func (t *ControllableTask) persistState(controlTransport executorcmd.ControlTransport) {
+	st := &taskState{
+		TaskId:           t.ti.TaskID.Value,
+		Pgid:             t.pgid,
+		Pid:              t.knownPid,
+		IsolatedPid:      t.isolatedPid,
+		ControlPort:      t.Tci.ControlPort,
+		ControlMode:      t.Tci.ControlMode,
+		ControlTransport: controlTransport,
+		LogDir:           taskLogDir(),
+		TaskInfo:         *t.ti,
+	}
+	if ps, err := readProcStat(t.pgid); err == nil {
+		st.StartTime = ps.startTime
+	}
+
+	err := func() error {
+		if err := os.MkdirAll(taskStateDir(), 0755); err != nil {
+			return err
+		}
+		data, err := json.Marshal(st)
+		if err != nil {
+			return err
+		}
+		// write and rename, so that we never leave behind a partial file
+		path := taskStatePath(st.TaskId)
+		if err = ioutil.WriteFile(path + ".tmp", data, 0644); err != nil {
+			return err
+		}
+		return os.Rename(path + ".tmp", path)
+	}()
+	if err != nil {
+		log.WithError(err).
+			WithField("task", t.ti.Name).
+			WithField("dir", taskStateDir()).
+			Error("cannot persist task state, the task will not be adoptable")
+	}
+}
+
+func (t *ControllableTask) forgetState() {
+	if !t.Tci.Adoptable {
+		return
+	}
+	err := os.Remove(taskStatePath(t.ti.TaskID.Value))
+	if err != nil && !os.IsNotExist(err) {
+		log.WithError(err).
+			WithField("task", t.ti.Name).
+			Warning("cannot remove task state file")
+	}
+}
+
+func loadTaskStates() (states []*taskState) {
+	if !canAdopt() {
+		return
+	}
+	files, err := ioutil.ReadDir(taskStateDir())
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.WithError(err).Warning("cannot read task state directory")
+		}
+		return
+	}
+	for _, fi := range files {
+		if fi.IsDir() || !strings.HasSuffix(fi.Name(), ".json") {
+			continue
+		}
+		path := filepath.Join(taskStateDir(), fi.Name())
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			log.WithError(err).WithField("path", path).Warning("cannot read task state file")
+			continue
+		}
+		st := &taskState{}
+		if err = json.Unmarshal(data, st); err != nil || len(st.TaskId) == 0 {
+			log.WithError(err).WithField("path", path).Warning("bad task state file, removing")
+			_ = os.Remove(path)
+			continue
+		}
+		states = append(states, st)
+	}
+	return
+}
+
+// isAlive tells whether the process group leader recorded in the state file
+// is still around, and not just some other process which got the same pid.
+func (st *taskState) isAlive() bool {
+	if !pidExists(st.Pgid) {
+		return false
+	}
+	ps, err := readProcStat(st.Pgid)
+	if err != nil {
+		return false
+	}
+	return st.StartTime == 0 || ps.startTime == st.StartTime
+}
+
+// AdoptOrphanedTasks looks for the state files of tasks launched by a
+// previous instance of this executor, reconnects to those which are still
+// alive and reports them as RUNNING. Tasks for which known returns true are
+// skipped. Dead tasks are reported as FAILED and forgotten.
+func AdoptOrphanedTasks(
+	known func(taskId mesos.TaskID) bool,
+	newSendStatusFunc func(taskInfo mesos.TaskInfo) SendStatusFunc,
+	sendDeviceEventFunc SendDeviceEventFunc,
+	sendMessageFunc SendMessageFunc,
+) (adopted map[mesos.TaskID]Task) {
+	adopted = make(map[mesos.TaskID]Task)
+	for _, st := range loadTaskStates() {
+		if known(st.TaskInfo.TaskID) {
+			continue
+		}
+		logFields := logrus.Fields{
+			"id":   st.TaskId,
+			"task": st.TaskInfo.Name,
+			"pid":  st.Pgid,
+		}
+		sendStatus := newSendStatusFunc(st.TaskInfo)
+
+		var commandInfo common.TaskCommandInfo
+		if err := json.Unmarshal(st.TaskInfo.GetData(), &commandInfo); err != nil {
+			log.WithError(err).WithFields(logFields).Warning("bad task command info in task state file")
+			_ = os.Remove(taskStatePath(st.TaskId))
+			continue
+		}
+
+		if !st.isAlive() {
+			log.WithFields(logFields).Info("orphaned task is gone, nothing to adopt")
+			_ = os.Remove(taskStatePath(st.TaskId))
+			sendStatus(mesos.TASK_FAILED, "task exited while its executor was down")
+			continue
+		}
+
+		taskInfo := st.TaskInfo
+		t := &ControllableTask{
+			taskBase: taskBase{
+				ti:              &taskInfo,
+				Tci:             &commandInfo,
+				sendStatus:      sendStatus,
+				sendDeviceEvent: sendDeviceEventFunc,
+				sendMessage:     sendMessageFunc,
+			},
+		}
+		if err := t.adopt(st); err != nil {
+			log.WithError(err).
+				WithFields(logFields).
+				Error("cannot adopt orphaned task, killing it")
+			sendStatus(mesos.TASK_FAILED, "cannot adopt task after executor restart: " + err.Error())
+			_ = terminateProcessGroup(st, t)
+			_ = os.Remove(taskStatePath(st.TaskId))
+			continue
+		}
+		log.WithFields(logFields).Info("orphaned task adopted")
+		adopted[taskInfo.TaskID] = t
+	}
+	return
+}
+
+// adopt takes over a live task launched by a previous executor instance.
+func (t *ControllableTask) adopt(st *taskState) error {
+	t.pendingFinalTaskStateCh = make(chan mesos.TaskState, 1)
+	t.processDone = make(chan struct{})
+	t.pgid = st.Pgid
+	t.knownPid = st.Pid
+	t.isolatedPid = st.IsolatedPid
+
+	t.rpc = executorcmd.NewClient(
+		st.ControlPort,
+		st.ControlMode,
+		st.ControlTransport,
+		log.WithPrefix("executorcmd").
+			WithFields(logrus.Fields{
+				"id": t.ti.TaskID.Value,
+				"task": t.ti.Name,
+			},
+		),
+	)
+	if t.rpc == nil {
+		return errors.New("rpc client is nil")
+	}
+
+	logDir := st.LogDir
+	if len(logDir) == 0 {
+		logDir = taskLogDir()
+	}
+
+	cxt, cancel := context.WithTimeout(context.Background(), TRANSITION_TIMEOUT)
+	defer cancel()
+	response, err := t.rpc.GetState(cxt, &pb.GetStateRequest{}, grpc.EmptyCallOption{})
+	if err != nil {
+		_ = t.rpc.Close()
+		t.rpc = nil
+		return fmt.Errorf("cannot query task state: %w", err)
+	}
+	if pid := t.hostPid(int(response.GetPid())); pid != 0 {
+		t.knownPid = pid
+	}
+	reachedState := t.rpc.FromDeviceState(response.GetState())
+
+	esc, err := t.rpc.EventStream(context.TODO(), &pb.EventStreamRequest{}, grpc.EmptyCallOption{})
+	if err != nil {
+		_ = t.rpc.Close()
+		t.rpc = nil
+		return fmt.Errorf("cannot set up event stream from task: %w", err)
+	}
+
+	rootPid := t.pgid
+	if t.isolatedPid > 0 {
+		rootPid = t.isolatedPid
+	}
+	go t.monitorResourceUsage(rootPid, t.processDone)
+	go t.forwardEvents(esc)
+	go t.monitorHealth(t.processDone)
+	go t.rotatePlainTaskLogs(logDir, t.processDone)
+	go t.watchAdopted()
+
+	t.sendStatus(mesos.TASK_RUNNING, "task adopted after executor restart in state " + reachedState)
+	taskMessage := event.NewAnnounceTaskPIDEvent(t.ti.TaskID.GetValue(), int32(t.knownPid))
+	jsonEvent, err := json.Marshal(taskMessage)
+	if err != nil {
+		log.WithError(err).Warning("error marshaling message from task")
+	} else {
+		t.sendMessage(jsonEvent)
+	}
+	t.announceCapabilities()
+	return nil
+}
+
+// watchAdopted waits for an adopted task to exit. It isn't our child, so
+// we can't wait(2) for it and its exit status is lost.
+func (t *ControllableTask) watchAdopted() {
+	for pidExists(t.pgid) {
+		time.Sleep(adoptedPollingInterval)
+	}
+	close(t.processDone)
+	t.forgetState()
+
+	pendingState := mesos.TASK_FAILED
+	message := "adopted task exited, exit status unknown"
+	select {
+	case pending := <- t.pendingFinalTaskStateCh:
+		pendingState = pending
+		message = killStageMessage(t.killTracker.get())
+	default:
+	}
+
+	if t.rpc != nil {
+		_ = t.rpc.Close()
+		t.rpc = nil
+	}
+
+	log.WithField("task", t.ti.Name).
+		WithField("status", pendingState.String()).
+		Debug("adopted task done, sending final status update")
+	t.sendStatus(pendingState, message)
+}
+
+func terminateProcessGroup(st *taskState, t *ControllableTask) error {
+	pid := st.Pid
+	if pid == 0 {
+		pid = -st.Pgid
+	}
+	_, err := terminateProcess(pid, st.Pgid, t.Tci.Kill.GetSigtermTimeout(), killSigintTimeout, nil, &t.killTracker, logrus.Fields{
+		"taskId": st.TaskId,
+		"task":   st.TaskInfo.Name,
+	})
+	return err
+}
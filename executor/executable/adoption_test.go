@@ -0,0 +1,405 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2021 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+
+package executable
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/AliceO2Group/Control/common"
+	"github.com/AliceO2Group/Control/common/controlmode"
+	"github.com/AliceO2Group/Control/common/event"
+	"github.com/AliceO2Group/Control/executor/executorcmd"
+	pb "github.com/AliceO2Group/Control/executor/protos"
+	mesos "github.com/mesos/mesos-go/api/v1/lib"
+	"google.golang.org/grpc"
+)
+
+func setTaskStateDir(t *testing.T) string {
+	t.Helper()
+	dir := filepath.Join(t.TempDir(), "taskstate")
+	old, wasSet := os.LookupEnv(TaskStateDirEnv)
+	_ = os.Setenv(TaskStateDirEnv, dir)
+	t.Cleanup(func() {
+		if wasSet {
+			_ = os.Setenv(TaskStateDirEnv, old)
+		} else {
+			_ = os.Unsetenv(TaskStateDirEnv)
+		}
+	})
+	return dir
+}
+
+func newAdoptableTaskInfo(t *testing.T, taskId string, controlPort uint64) mesos.TaskInfo {
+	t.Helper()
+	data, err := json.Marshal(&common.TaskCommandInfo{
+		ControlPort: controlPort,
+		ControlMode: controlmode.DIRECT,
+		Adoptable:   true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return mesos.TaskInfo{
+		Name:     "test-task",
+		TaskID:   mesos.TaskID{Value: taskId},
+		Executor: &mesos.ExecutorInfo{ExecutorID: mesos.ExecutorID{Value: "test-executor"}},
+		Data:     data,
+	}
+}
+
+// startOrphan starts a process in its own process group, like an adoptable
+// task, and reaps it once it exits.
+func startOrphan(t *testing.T) (cmd *exec.Cmd, exited <-chan struct{}) {
+	t.Helper()
+	cmd = exec.Command("sleep", "60")
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	if err := cmd.Start(); err != nil {
+		t.Fatal(err)
+	}
+	done := make(chan struct{})
+	go func() {
+		_ = cmd.Wait()
+		close(done)
+	}()
+	t.Cleanup(func() {
+		_ = cmd.Process.Kill()
+		<-done
+	})
+	return cmd, done
+}
+
+func TestPersistStateWritesAndForgetsStateFile(t *testing.T) {
+	dir := setTaskStateDir(t)
+	cmd, _ := startOrphan(t)
+
+	ti := newAdoptableTaskInfo(t, "task-1", 47100)
+	task := &ControllableTask{
+		taskBase: taskBase{
+			ti:  &ti,
+			Tci: &common.TaskCommandInfo{ControlPort: 47100, Adoptable: true},
+		},
+		pgid:     cmd.Process.Pid,
+		knownPid: cmd.Process.Pid,
+	}
+	task.persistState(executorcmd.JsonTransport)
+
+	path := filepath.Join(dir, "task-1.json")
+	if _, err := os.Stat(path); err != nil {
+		t.Fatalf("state file not written: %v", err)
+	}
+	if _, err := os.Stat(path + ".tmp"); !os.IsNotExist(err) {
+		t.Errorf("temporary state file left behind")
+	}
+
+	states := loadTaskStates()
+	if len(states) != 1 {
+		t.Fatalf("expected 1 task state, got %d", len(states))
+	}
+	st := states[0]
+	if st.TaskId != "task-1" || st.Pgid != cmd.Process.Pid || st.ControlPort != 47100 {
+		t.Errorf("unexpected task state %+v", st)
+	}
+	if st.ControlTransport != executorcmd.JsonTransport {
+		t.Errorf("control transport not persisted, got %s", st.ControlTransport.String())
+	}
+	if st.LogDir != taskLogDir() {
+		t.Errorf("expected log dir %s, got %s", taskLogDir(), st.LogDir)
+	}
+	if st.StartTime == 0 {
+		t.Errorf("process start time not persisted")
+	}
+	if !st.isAlive() {
+		t.Errorf("running task reported as dead")
+	}
+
+	task.forgetState()
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("state file not removed")
+	}
+}
+
+func TestTaskStateIsAliveChecksStartTime(t *testing.T) {
+	cmd, exited := startOrphan(t)
+	ps, err := readProcStat(cmd.Process.Pid)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	st := &taskState{Pgid: cmd.Process.Pid, StartTime: ps.startTime}
+	if !st.isAlive() {
+		t.Errorf("running task reported as dead")
+	}
+	reused := &taskState{Pgid: cmd.Process.Pid, StartTime: ps.startTime + 1}
+	if reused.isAlive() {
+		t.Errorf("process with a different start time reported as the task")
+	}
+
+	_ = cmd.Process.Kill()
+	<-exited
+	if st.isAlive() {
+		t.Errorf("exited task reported as alive")
+	}
+}
+
+func TestLoadTaskStatesRemovesBadFiles(t *testing.T) {
+	dir := setTaskStateDir(t)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	bad := filepath.Join(dir, "bad.json")
+	if err := ioutil.WriteFile(bad, []byte("{not json"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	other := filepath.Join(dir, "notes.txt")
+	if err := ioutil.WriteFile(other, []byte("hello"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if states := loadTaskStates(); len(states) != 0 {
+		t.Errorf("expected no task states, got %d", len(states))
+	}
+	if _, err := os.Stat(bad); !os.IsNotExist(err) {
+		t.Errorf("bad state file not removed")
+	}
+	if _, err := os.Stat(other); err != nil {
+		t.Errorf("unrelated file removed")
+	}
+}
+
+func TestTaskStatesNeedStateDir(t *testing.T) {
+	setTaskStateDir(t)
+	_ = os.Unsetenv(TaskStateDirEnv)
+	if canAdopt() {
+		t.Errorf("adoption possible without a state directory")
+	}
+	if states := loadTaskStates(); len(states) != 0 {
+		t.Errorf("expected no task states, got %d", len(states))
+	}
+}
+
+type statusRecorder struct {
+	mu       sync.Mutex
+	statuses []mesos.TaskState
+	changed  chan struct{}
+}
+
+func newStatusRecorder() *statusRecorder {
+	return &statusRecorder{changed: make(chan struct{}, 16)}
+}
+
+func (r *statusRecorder) send(state mesos.TaskState, _ string) {
+	r.mu.Lock()
+	r.statuses = append(r.statuses, state)
+	r.mu.Unlock()
+	r.changed <- struct{}{}
+}
+
+func (r *statusRecorder) waitFor(t *testing.T, state mesos.TaskState) {
+	t.Helper()
+	timeout := time.After(10*time.Second)
+	for {
+		r.mu.Lock()
+		for _, st := range r.statuses {
+			if st == state {
+				r.mu.Unlock()
+				return
+			}
+		}
+		r.mu.Unlock()
+		select {
+		case <-r.changed:
+		case <-timeout:
+			t.Fatalf("no %s status update received", state.String())
+		}
+	}
+}
+
+func writeTaskState(t *testing.T, st *taskState) {
+	t.Helper()
+	if err := os.MkdirAll(taskStateDir(), 0755); err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(st)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = ioutil.WriteFile(taskStatePath(st.TaskId), data, 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func adoptForTest(known func(mesos.TaskID) bool, recorder *statusRecorder) map[mesos.TaskID]Task {
+	return AdoptOrphanedTasks(
+		known,
+		func(mesos.TaskInfo) SendStatusFunc { return recorder.send },
+		func(event.DeviceEvent) {},
+		func([]byte) {})
+}
+
+func TestAdoptOrphanedTasksReportsDeadTasks(t *testing.T) {
+	setTaskStateDir(t)
+	cmd, exited := startOrphan(t)
+	pid := cmd.Process.Pid
+	_ = cmd.Process.Kill()
+	<-exited
+
+	writeTaskState(t, &taskState{
+		TaskId:   "dead-task",
+		Pgid:     pid,
+		TaskInfo: newAdoptableTaskInfo(t, "dead-task", 47101),
+	})
+	recorder := newStatusRecorder()
+	adopted := adoptForTest(func(mesos.TaskID) bool { return false }, recorder)
+
+	if len(adopted) != 0 {
+		t.Errorf("dead task adopted")
+	}
+	recorder.waitFor(t, mesos.TASK_FAILED)
+	if _, err := os.Stat(taskStatePath("dead-task")); !os.IsNotExist(err) {
+		t.Errorf("state file of dead task not removed")
+	}
+}
+
+func TestAdoptOrphanedTasksSkipsKnownTasks(t *testing.T) {
+	setTaskStateDir(t)
+	cmd, _ := startOrphan(t)
+
+	writeTaskState(t, &taskState{
+		TaskId:   "known-task",
+		Pgid:     cmd.Process.Pid,
+		TaskInfo: newAdoptableTaskInfo(t, "known-task", 47102),
+	})
+	recorder := newStatusRecorder()
+	adopted := adoptForTest(func(mesos.TaskID) bool { return true }, recorder)
+
+	if len(adopted) != 0 {
+		t.Errorf("known task adopted again")
+	}
+	if len(recorder.statuses) != 0 {
+		t.Errorf("status update sent for known task")
+	}
+	if _, err := os.Stat(taskStatePath("known-task")); err != nil {
+		t.Errorf("state file of known task removed")
+	}
+}
+
+// fakeOccServer stands in for the OCC server of an orphaned or starting
+// task, which reports CONFIGURED unless told otherwise
+type fakeOccServer struct {
+	pb.UnimplementedOccServer
+	pid int32
+
+	mu    sync.Mutex
+	state string
+}
+
+func (s *fakeOccServer) GetState(context.Context, *pb.GetStateRequest) (*pb.GetStateReply, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	state := s.state
+	if len(state) == 0 {
+		state = "CONFIGURED"
+	}
+	return &pb.GetStateReply{State: state, Pid: s.pid}, nil
+}
+
+func (s *fakeOccServer) setState(state string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.state = state
+}
+
+// serveFakeOcc serves server on a free local port until the test ends, and
+// returns the port.
+func serveFakeOcc(t *testing.T, server *fakeOccServer) uint64 {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	grpcServer := grpc.NewServer()
+	pb.RegisterOccServer(grpcServer, server)
+	go func() {
+		_ = grpcServer.Serve(lis)
+	}()
+	t.Cleanup(grpcServer.Stop)
+	return uint64(lis.Addr().(*net.TCPAddr).Port)
+}
+
+func (s *fakeOccServer) EventStream(_ *pb.EventStreamRequest, stream pb.Occ_EventStreamServer) error {
+	<-stream.Context().Done()
+	return nil
+}
+
+func TestAdoptOrphanedTasksReattachesLiveTask(t *testing.T) {
+	setTaskStateDir(t)
+	cmd, _ := startOrphan(t)
+	ps, err := readProcStat(cmd.Process.Pid)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	port := serveFakeOcc(t, &fakeOccServer{pid: int32(cmd.Process.Pid)})
+
+	writeTaskState(t, &taskState{
+		TaskId:      "live-task",
+		Pgid:        cmd.Process.Pid,
+		Pid:         cmd.Process.Pid,
+		StartTime:   ps.startTime,
+		ControlPort: port,
+		ControlMode: controlmode.DIRECT,
+		LogDir:      t.TempDir(),
+		TaskInfo:    newAdoptableTaskInfo(t, "live-task", port),
+	})
+	recorder := newStatusRecorder()
+	adopted := adoptForTest(func(mesos.TaskID) bool { return false }, recorder)
+
+	if _, ok := adopted[mesos.TaskID{Value: "live-task"}]; !ok || len(adopted) != 1 {
+		t.Fatalf("live task not adopted")
+	}
+	recorder.waitFor(t, mesos.TASK_RUNNING)
+	if _, err := os.Stat(taskStatePath("live-task")); err != nil {
+		t.Errorf("state file of adopted task removed while it runs")
+	}
+
+	// Once the adopted task exits, its final status goes out and its state
+	// file goes away
+	_ = cmd.Process.Kill()
+	recorder.waitFor(t, mesos.TASK_FAILED)
+	if _, err := os.Stat(taskStatePath("live-task")); !os.IsNotExist(err) {
+		t.Errorf("state file of exited task not removed")
+	}
+}
@@ -47,7 +47,7 @@ func (t *BasicTask) makeTransitionFunc() transitioner.DoTransitionFunc {
 			}
 			return "RUNNING", err
 		case ei.Src == "RUNNING" && ei.Evt == "STOP" && ei.Dst == "CONFIGURED":
-			err = t.ensureBasicTaskKilled()
+			_, err = t.ensureBasicTaskKilled()
 			return "CONFIGURED", err
 		default:
 			// By default we declare any transition as valid and executed as NOOP
@@ -25,12 +25,10 @@
 package executable
 
 import (
-	"bytes"
 	"encoding/json"
 	"errors"
 	"io"
 	"os/exec"
-	"syscall"
 
 	"github.com/AliceO2Group/Control/common/controlmode"
 	"github.com/AliceO2Group/Control/common/event"
@@ -47,10 +45,12 @@ type basicTaskBase struct {
 	taskCmd *exec.Cmd
 	transitioner transitioner.Transitioner
 	pendingFinalTaskStateCh chan mesos.TaskState
+	processDone chan struct{}
+	killTracker killTracker
 }
 
 func (t *basicTaskBase) startBasicTask() (err error) {
-	t.taskCmd, err = prepareTaskCmd(t.Tci)
+	t.taskCmd, err = prepareTaskCmd(t.Tci, t.ti.TaskID.Value)
 	if err != nil {
 		msg := "cannot build task command"
 		log.WithFields(logrus.Fields{
@@ -67,9 +67,14 @@ func (t *basicTaskBase) startBasicTask() (err error) {
 
 	// Set up pipes for controlled process
 	var errStdout, errStderr error
-	var stdoutBuf, stderrBuf bytes.Buffer
+	// Capped like the log files, see taskOutputBuffer
+	stdoutBuf, stderrBuf := newTaskOutputBuffer(t.Tci.LogMaxSize), newTaskOutputBuffer(t.Tci.LogMaxSize)
 	var stdout, stderr io.Writer
 
+	// Everything goes to the task log files in the sandbox, as well as to
+	// the buffers which end up in the BasicTaskTerminated event
+	stdoutFile, stderrFile, closeLogFiles := t.taskLogWriters()
+
 	if t.Tci.Log == nil {
 		none := "none"
 		t.Tci.Log = &none
@@ -86,8 +91,8 @@ func (t *basicTaskBase) startBasicTask() (err error) {
 			WriterLevel(logrus.TraceLevel)
 
 		// Each of these multiwriters will push incoming lines to a buffer as well as the logger
-		stdout = io.MultiWriter(stdoutLog, &stdoutBuf)
-		stderr = io.MultiWriter(stderrLog, &stderrBuf)
+		stdout = io.MultiWriter(stdoutLog, stdoutBuf, stdoutFile)
+		stderr = io.MultiWriter(stderrLog, stderrBuf, stderrFile)
 
 	case "all":
 		stdoutLog := log.WithPrefix("task-stdout").
@@ -97,18 +102,19 @@ func (t *basicTaskBase) startBasicTask() (err error) {
 			WithField("task", t.ti.Name).
 			WriterLevel(logrus.TraceLevel)
 
-		stdout = io.MultiWriter(stdoutLog, &stdoutBuf)
-		stderr = io.MultiWriter(stderrLog, &stderrBuf)
+		stdout = io.MultiWriter(stdoutLog, stdoutBuf, stdoutFile)
+		stderr = io.MultiWriter(stderrLog, stderrBuf, stderrFile)
 
 	default:
 		// Nothing goes to the log, we go straight to the buffer
-		stdout = &stdoutBuf
-		stderr = &stderrBuf
+		stdout = io.MultiWriter(stdoutBuf, stdoutFile)
+		stderr = io.MultiWriter(stderrBuf, stderrFile)
 	}
 
 	stdoutIn, _ := t.taskCmd.StdoutPipe()
 	stderrIn, _ := t.taskCmd.StderrPipe()
 
+	t.killTracker.set(killStageNone)
 	err = t.taskCmd.Start()
 
 	if err != nil {
@@ -120,23 +126,47 @@ func (t *basicTaskBase) startBasicTask() (err error) {
 			}).
 			Error("failed to run basic task")
 
+		closeLogFiles()
 		return err
 	}
 	log.WithField("id", t.ti.TaskID.Value).
 		WithField("task", t.ti.Name).
 		Debug("basic task started")
 
+	copyDone := make(chan struct{}, 2)
 	go func() {
 		_, errStdout = io.Copy(stdout, stdoutIn)
+		copyDone <- struct{}{}
 	}()
 	go func() {
 		_, errStderr = io.Copy(stderr, stderrIn)
+		copyDone <- struct{}{}
+	}()
+	go func() {
+		<-copyDone
+		<-copyDone
+		closeLogFiles()
 	}()
 
+	t.processDone = make(chan struct{})
+	go func(taskCmd *exec.Cmd, processDone <-chan struct{}) {
+		rootPid, err := resolveIsolatedPid(taskCmd, t.Tci, t.ti.TaskID.Value)
+		if err != nil {
+			log.WithError(err).
+				WithField("task", t.ti.Name).
+				Warning("cannot resolve host PID of containerized task")
+			return
+		}
+		t.monitorResourceUsage(rootPid, processDone)
+	}(t.taskCmd, t.processDone)
 	go func() {
 		taskCmd := t.taskCmd
+		processDone := t.processDone
 		err = taskCmd.Wait()
 		// ^ when this unblocks, the task is done
+		close(processDone)
+		cleanupIsolatedTask(taskCmd, t.Tci, t.ti.TaskID.Value)
+		killStage := t.killTracker.get()
 
 		pendingState := mesos.TASK_FINISHED
 		if err != nil {
@@ -144,6 +174,7 @@ func (t *basicTaskBase) startBasicTask() (err error) {
 					"id":    t.ti.TaskID.Value,
 					"task":  t.ti.Name,
 					"error": err.Error(),
+					"killStage": killStage.String(),
 				}).
 				Error("process terminated with error")
 			pendingState = mesos.TASK_FAILED
@@ -184,8 +215,21 @@ func (t *basicTaskBase) startBasicTask() (err error) {
 			btt.VoluntaryTermination = processTerminatedOnItsOwn
 			btt.ExitCode = exitCode
 			btt.FinalMesosState = pendingState
+			if killStage != killStageNone {
+				btt.KillStage = killStage.String()
+			}
 			btt.Stderr = stderrBuf.String()
 			btt.Stdout = stdoutBuf.String()
+			var outputsErr error
+			btt.Outputs, outputsErr = parseTaskOutputs(btt.Stdout)
+			if outputsErr != nil {
+				log.WithError(outputsErr).
+					WithFields(logrus.Fields{
+						"id":   t.ti.TaskID.Value,
+						"task": t.ti.Name,
+					}).
+					Warning("failed to parse outputs of task")
+			}
 			t.sendDeviceEvent(btt)
 		}
 	}()
@@ -193,29 +237,41 @@ func (t *basicTaskBase) startBasicTask() (err error) {
 	return err
 }
 
-func (t *basicTaskBase) ensureBasicTaskKilled() (err error) {
-	if t.taskCmd == nil {
-		return nil
+func (t *basicTaskBase) ensureBasicTaskKilled() (stage killStage, err error) {
+	if t.taskCmd == nil || t.taskCmd.Process == nil {
+		return
 	}
 	if t.Tci.ControlMode == controlmode.HOOK {
-		return nil
+		return
 	}
-	if t.taskCmd.ProcessState.Exited() {
-		return nil
+	select {
+	case <-t.processDone:
+		return
+	default:
 	}
 
 	// Preparing to kill running task
-	t.pendingFinalTaskStateCh <- mesos.TASK_KILLED
-
-	// TODO: SIGTERM before SIGKILL
+	select {
+	case t.pendingFinalTaskStateCh <- mesos.TASK_KILLED:
+	default:
+	}
 
+	// Basic tasks have no control interface, so the kill sequence starts with
+	// SIGTERM to the process group of the containing shell
 	pid := t.taskCmd.Process.Pid
-	err = syscall.Kill(-pid, syscall.SIGKILL)
+	fields := logrus.Fields{
+		"taskId": t.ti.GetTaskID().Value,
+		"task":   t.ti.Name,
+	}
+	stage, err = terminateProcess(-pid, pid, t.Tci.Kill.GetSigtermTimeout(), killSigintTimeout, t.processDone, &t.killTracker, fields)
 	if err != nil {
 		log.WithError(err).
-			WithField("taskId", t.ti.GetTaskID()).
+			WithFields(fields).
 			Warning("could not kill task")
 	}
+	log.WithFields(fields).
+		WithField("killStage", stage.String()).
+		Info("basic task terminated")
 
 	return
 }
@@ -255,11 +311,12 @@ func (t *basicTaskBase) Transition(cmd *executorcmd.ExecutorCommand_Transition)
 }
 
 func (t *basicTaskBase) Kill() error {
+	stage, _ := t.ensureBasicTaskKilled()
 	if t.taskCmd != nil {
 		t.taskCmd = nil
 		log.Debug("exec.Cmd wrapper removed")
 	}
 
-	go t.sendStatus(mesos.TASK_FINISHED, "")
+	go t.sendStatus(mesos.TASK_FINISHED, killStageMessage(stage))
 	return nil
 }
@@ -29,7 +29,7 @@ import (
 	"encoding/json"
 	"errors"
 	"io"
-	"io/ioutil"
+	"os"
 	"reflect"
 	"strings"
 	"syscall"
@@ -47,8 +47,6 @@ import (
 )
 
 const(
-	SIGTERM_TIMEOUT = 1*time.Second
-	SIGINT_TIMEOUT = 3*time.Second
 	KILL_TRANSITION_TIMEOUT = 1*time.Second
 	TRANSITION_TIMEOUT = 10*time.Second
 )
@@ -58,6 +56,10 @@ type ControllableTask struct {
 	rpc *executorcmd.RpcClient
 	pendingFinalTaskStateCh chan mesos.TaskState
 	knownPid int
+	isolatedPid int
+	pgid int
+	processDone chan struct{}
+	killTracker killTracker
 }
 
 type CommitResponse struct {
@@ -67,7 +69,16 @@ type CommitResponse struct {
 
 func (t *ControllableTask) Launch() error {
 	t.pendingFinalTaskStateCh = make(chan mesos.TaskState, 1) // we use this to receive a pending status update if the task was killed
-	taskCmd, err := prepareTaskCmd(t.Tci)
+	t.processDone = make(chan struct{})
+	if t.Tci.Adoptable && !canAdopt() {
+		// Without a state file outside the sandbox nobody could adopt the
+		// task, so we don't let it outlive us
+		log.WithField("task", t.ti.Name).
+			WithField("env", TaskStateDirEnv).
+			Error("task adoption requested but no executor state directory is set, task will not be adoptable")
+		t.Tci.Adoptable = false
+	}
+	taskCmd, err := prepareTaskCmd(t.Tci, t.ti.TaskID.Value)
 	if err != nil {
 		msg := "cannot build task command"
 		log.WithFields(logrus.Fields{
@@ -91,12 +102,33 @@ func (t *ControllableTask) Launch() error {
 	// via channels.
 	go func() {
 
-		// Set up pipes for controlled process
+		// Set up pipes for controlled process, unless it must be able to
+		// outlive us, in which case it writes straight to the sandbox and
+		// we only take care of rotating its logs
 		var errStdout, errStderr error
-		stdoutIn, _ := taskCmd.StdoutPipe()
-		stderrIn, _ := taskCmd.StderrPipe()
+		var stdoutIn, stderrIn io.ReadCloser
+		var plainStdout, plainStderr *os.File
+		if t.Tci.Adoptable {
+			plainStdout, plainStderr, err = t.openPlainTaskLogs()
+			if err != nil {
+				log.WithError(err).
+					WithField("task", t.ti.Name).
+					Warning("cannot create task log files in sandbox, output will not be retrievable")
+			} else {
+				taskCmd.Stdout = plainStdout
+				taskCmd.Stderr = plainStderr
+			}
+		} else {
+			stdoutIn, _ = taskCmd.StdoutPipe()
+			stderrIn, _ = taskCmd.StderrPipe()
+		}
 
 		err = taskCmd.Start()
+		if plainStdout != nil {
+			// the task has its own copies of these
+			_ = plainStdout.Close()
+			_ = plainStderr.Close()
+		}
 		var tciCommandStr string
 		if t.Tci.Value != nil {
 			tciCommandStr = *t.Tci.Value
@@ -111,48 +143,78 @@ func (t *ControllableTask) Launch() error {
 			Error("failed to run task")
 
 			t.sendStatus(mesos.TASK_FAILED, err.Error())
-			_ = t.doKill(-taskCmd.Process.Pid)
+			// nothing to kill, the process was never started
 			return
 		}
 		log.WithField("id", t.ti.TaskID.Value).
 			WithField("task", t.ti.Name).
 			Debug("task launched")
-
-		if t.Tci.Log == nil {
-			none := "none"
-			t.Tci.Log = &none
+		t.pgid = taskCmd.Process.Pid
+
+		// A containerized task lives in its own PID namespace, so the PID it
+		// reports through OCC means nothing to us and we ask the container
+		// runtime for its host PID instead
+		rootPid := taskCmd.Process.Pid
+		if t.Tci.Isolation.IsContainer() {
+			t.isolatedPid, err = resolveIsolatedPid(taskCmd, t.Tci, t.ti.TaskID.Value)
+			if err != nil {
+				log.WithError(err).
+					WithField("task", t.ti.Name).
+					Warning("cannot resolve host PID of containerized task")
+			} else {
+				rootPid = t.isolatedPid
+			}
 		}
-		switch *t.Tci.Log {
-		case "stdout":
-			go func() {
-				_, errStdout = io.Copy(log.WithPrefix("task-stdout").
+		go t.monitorResourceUsage(rootPid, t.processDone)
+		if plainStdout != nil {
+			go t.rotatePlainTaskLogs(taskLogDir(), t.processDone)
+		}
+
+		if stdoutIn != nil {
+			// The task output always goes to the task log files in the sandbox,
+			// and depending on Tci.Log also to the executor log
+			var stdout, stderr io.Writer
+			stdoutFile, stderrFile, closeLogFiles := t.taskLogWriters()
+
+			if t.Tci.Log == nil {
+				none := "none"
+				t.Tci.Log = &none
+			}
+			switch *t.Tci.Log {
+			case "stdout":
+				stdout = io.MultiWriter(log.WithPrefix("task-stdout").
 					WithField("task", t.ti.Name).
 					WithField("nohooks", true).
-					WriterLevel(logrus.DebugLevel), stdoutIn)
-			}()
-			go func() {
-				_, errStderr = io.Copy(log.WithPrefix("task-stderr").
+					WriterLevel(logrus.DebugLevel), stdoutFile)
+				stderr = io.MultiWriter(log.WithPrefix("task-stderr").
 					WithField("task", t.ti.Name).
 					WithField("nohooks", true).
-					WriterLevel(logrus.WarnLevel), stderrIn)
-			}()
-		case "all":
-			go func() {
-				_, errStdout = io.Copy(log.WithPrefix("task-stdout").
+					WriterLevel(logrus.WarnLevel), stderrFile)
+			case "all":
+				stdout = io.MultiWriter(log.WithPrefix("task-stdout").
 					WithField("task", t.ti.Name).
-					WriterLevel(logrus.DebugLevel), stdoutIn)
-			}()
-			go func() {
-				_, errStderr = io.Copy(log.WithPrefix("task-stderr").
+					WriterLevel(logrus.DebugLevel), stdoutFile)
+				stderr = io.MultiWriter(log.WithPrefix("task-stderr").
 					WithField("task", t.ti.Name).
-					WriterLevel(logrus.WarnLevel), stderrIn)
+					WriterLevel(logrus.WarnLevel), stderrFile)
+			default:
+				stdout = stdoutFile
+				stderr = stderrFile
+			}
+
+			copyDone := make(chan struct{}, 2)
+			go func() {
+				_, errStdout = io.Copy(stdout, stdoutIn)
+				copyDone <- struct{}{}
 			}()
-		default:
 			go func() {
-				_, errStdout = io.Copy(ioutil.Discard, stdoutIn)
+				_, errStderr = io.Copy(stderr, stderrIn)
+				copyDone <- struct{}{}
 			}()
 			go func() {
-				_, errStderr = io.Copy(ioutil.Discard, stderrIn)
+				<-copyDone
+				<-copyDone
+				closeLogFiles()
 			}()
 		}
 
@@ -167,6 +229,7 @@ func (t *ControllableTask) Launch() error {
 		}).
 		Debug("starting gRPC client")
 
+		// Only a hint for OCC servers which can't negotiate the transport
 		controlTransport := executorcmd.ProtobufTransport
 		for _, v := range taskCmd.Args {
 			if strings.Contains(v, "-P OCClite") {
@@ -175,6 +238,9 @@ func (t *ControllableTask) Launch() error {
 			}
 		}
 
+		progress := newStartupProgress(t)
+		progress.report(event.StartupPhaseConnecting)
+
 		t.rpc = executorcmd.NewClient(
 			t.Tci.ControlPort,
 			t.Tci.ControlMode,
@@ -198,77 +264,50 @@ func (t *ControllableTask) Launch() error {
 				Error("could not start gRPC client")
 
 			t.sendStatus(mesos.TASK_FAILED, err.Error())
-			t.doKill(-taskCmd.Process.Pid)
+			t.doKill(-taskCmd.Process.Pid, taskCmd.Process.Pid)
 			return
 		}
 		t.rpc.TaskCmd = taskCmd
 
-		elapsed := 0 * time.Second
-		for {
-			log.WithFields(logrus.Fields{
-					"id":      t.ti.TaskID.Value,
-					"task":    t.ti.Name,
-					"command": tciCommandStr,
-					"elapsed": elapsed.String(),
-				}).
-				Debug("polling task for IDLE state reached")
-
-			response, err := t.rpc.GetState(context.TODO(), &pb.GetStateRequest{}, grpc.EmptyCallOption{})
-			if err != nil {
-				log.WithError(err).
-					WithFields(logrus.Fields{
-						"state": response.GetState(),
-						"task":  t.ti.Name,
-						"command": tciCommandStr,
-					}).
-					Info("cannot query task status")
-			} else {
-				log.WithFields(logrus.Fields{
-						"state": response.GetState(),
-						"task":  t.ti.Name,
-						"command": tciCommandStr,
-					}).
-					Debug("task status queried")
-				t.knownPid = int(response.GetPid())
+		// Dialing and capability negotiation have their own timeout, the
+		// startup timeout only covers the task itself
+		deadline := time.Now().Add(t.Tci.Startup.GetTimeout())
+		reachedState, err := t.waitForStandby(deadline, progress)
+		if reachedState == "DONE" || reachedState == "ERROR" {
+			// something went wrong, the device moved to DONE or ERROR on startup
+			pid := t.knownPid
+			if pid == 0 {
+				// The pid was never known through a successful `GetState` in the lifetime
+				// of this process, so we must rely on the PGID of the containing shell
+				pid = -t.rpc.TaskCmd.Process.Pid
 			}
-			// NOTE: we acquire the transitioner-dependent STANDBY equivalent state
-			reachedState := t.rpc.FromDeviceState(response.GetState())
 
-			if reachedState == "STANDBY" && err == nil {
-				log.WithField("id", t.ti.TaskID.Value).
-					WithField("task", t.ti.Name).
-					WithField("command", tciCommandStr).
-					Debug("task running and ready for control input")
-				break
-			} else if reachedState == "DONE" || reachedState == "ERROR" {
-				// something went wrong, the device moved to DONE or ERROR on startup
-				pid := t.knownPid
-				if pid == 0 {
-					// The pid was never known through a successful `GetState` in the lifetime
-					// of this process, so we must rely on the PGID of the containing shell
-					pid = -t.rpc.TaskCmd.Process.Pid
-				}
-
-				_ = syscall.Kill(pid, syscall.SIGKILL)
-
-				log.WithField("task", t.ti.Name).Debug("task killed")
-				t.sendStatus(mesos.TASK_FAILED, "task reached wrong state on startup")
-				return
-			} else if elapsed >= startupTimeout {
-				err = errors.New("timeout while waiting for task startup")
-				log.WithField("task", t.ti.Name).Error(err.Error())
-				t.sendStatus(mesos.TASK_FAILED, err.Error())
-				_ = t.rpc.Close()
-				t.rpc = nil
-				return
-			} else {
-				log.WithField("task", t.ti.Name).
-					WithField("command", tciCommandStr).
-					Debugf("task not ready yet, waiting %s", startupPollingInterval.String())
-				time.Sleep(startupPollingInterval)
-				elapsed += startupPollingInterval
-			}
+			_ = syscall.Kill(pid, syscall.SIGKILL)
+
+			log.WithField("task", t.ti.Name).Debug("task killed")
+			t.sendStatus(mesos.TASK_FAILED, "task reached wrong state on startup")
+			return
+		}
+		if err == nil {
+			err = t.runReadinessProbe(deadline, progress)
 		}
+		if err != nil {
+			log.WithField("task", t.ti.Name).
+				WithField("command", tciCommandStr).
+				WithField("timeout", t.Tci.Startup.GetTimeout().String()).
+				Error(err.Error())
+			t.sendStatus(mesos.TASK_FAILED, err.Error())
+			t.doKill(-taskCmd.Process.Pid, taskCmd.Process.Pid)
+			_ = t.rpc.Close()
+			t.rpc = nil
+			return
+		}
+
+		log.WithField("id", t.ti.TaskID.Value).
+			WithField("task", t.ti.Name).
+			WithField("command", tciCommandStr).
+			WithField("elapsed", time.Since(progress.started).Truncate(time.Millisecond).String()).
+			Debug("task running and ready for control input")
 
 		// Set up event stream from task
 		esc, err := t.rpc.EventStream(context.TODO(), &pb.EventStreamRequest{}, grpc.EmptyCallOption{})
@@ -293,52 +332,28 @@ func (t *ControllableTask) Launch() error {
 		} else {
 			t.sendMessage(jsonEvent)
 		}
+		t.announceCapabilities()
 		
 
+		if t.Tci.Adoptable {
+			t.persistState(t.rpc.Transport)
+		}
+
 		// Process events from task in yet another goroutine
-		go func() {
-			deo := event.DeviceEventOrigin{
-				AgentId:    t.ti.AgentID,
-				ExecutorId: t.ti.GetExecutor().ExecutorID,
-				TaskId:     t.ti.TaskID,
-			}
-			for {
-				if t.rpc == nil {
-					log.WithError(err).Warning("event stream done")
-					break
-				}
-				esr, err := esc.Recv()
-				if err == io.EOF {
-					log.WithError(err).Warning("event stream EOF")
-					break
-				}
-				if err != nil {
-					log.WithError(err).
-						WithField("errorType", reflect.TypeOf(err)).
-						Warning("error receiving event from task")
-					if status.Code(err) == codes.Unavailable {
-						break
-					}
-					continue
-				}
-				ev := esr.GetEvent()
-
-				deviceEvent := event.NewDeviceEvent(deo, ev.GetType())
-				if deviceEvent == nil {
-					log.Debug("nil DeviceEvent received (NULL_DEVICE_EVENT) - closing stream")
-					break
-				}
-
-				t.sendDeviceEvent(deviceEvent)
-			}
-		}()
+		go t.forwardEvents(esc)
+		go t.monitorHealth(t.processDone)
 
 		err = taskCmd.Wait()
 		// ^ when this unblocks, the task is done
+		close(t.processDone)
+		t.forgetState()
+		cleanupIsolatedTask(taskCmd, t.Tci, t.ti.TaskID.Value)
+		killStage := t.killTracker.get()
 		log.WithFields(logrus.Fields{
 			"id":      t.ti.TaskID.Value,
 			"task":    t.ti.Name,
 			"command": tciCommandStr,
+			"killStage": killStage.String(),
 		}).Debug("task done, preparing final update")
 
 		pendingState := mesos.TASK_FINISHED
@@ -380,7 +395,7 @@ func (t *ControllableTask) Launch() error {
 		log.WithField("task", t.ti.Name).
 			WithField("status", pendingState.String()).
 			Debug("sending final status update")
-		t.sendStatus(pendingState, "")
+		t.sendStatus(pendingState, killStageMessage(killStage))
 	}()
 
 	log.WithField("task", t.ti.Name).Debug("gRPC client running, handler forked")
@@ -414,6 +429,14 @@ func (t *ControllableTask) Kill() error {
 		pid = 0
 		reachedState = "UNKNOWN" // FIXME: should be LAUNCHING or similar
 	)
+	if t.rpc == nil {
+		log.WithField("taskId", t.ti.GetTaskID()).
+			Debug("task control interface already gone, nothing to kill")
+		return nil
+	}
+	// The containing shell leads the process group which we SIGKILL as last resort
+	pgid := t.pgid
+
 	cxt, cancel := context.WithTimeout(context.Background(), KILL_TRANSITION_TIMEOUT)
 	defer cancel()
 	response, err := t.rpc.GetState(cxt, &pb.GetStateRequest{}, grpc.EmptyCallOption{})
@@ -462,7 +485,18 @@ func (t *ControllableTask) Kill() error {
 			return
 		}
 
-		for reachedState != "DONE" &&
+		// The whole shutdown transition sequence, including the process exiting
+		// after reaching DONE, must fit within the transition timeout.
+		transitionDeadline := time.After(t.Tci.Kill.GetTransitionTimeout())
+		if !t.Tci.Kill.GetShutdownTransition() {
+			log.WithField("task", t.ti.TaskID.Value).
+				Debug("shutdown transition disabled for this task, skipping to signals")
+		} else {
+			t.killTracker.set(killStageTransition)
+		}
+
+		for t.Tci.Kill.GetShutdownTransition() &&
+			reachedState != "DONE" &&
 			reachedState != "ERROR" {
 			cmd := nextTransition(reachedState)
 			log.WithFields(logrus.Fields{
@@ -474,7 +508,7 @@ func (t *ControllableTask) Kill() error {
 				Debug("state DONE not reached, about to commit transition")
 
 			// Call cmd.Commit() asynchronous
-			commitDone := make(chan *CommitResponse)
+			commitDone := make(chan *CommitResponse, 1)
 			go func() {
 				var cr CommitResponse
 				cr.newState, cr.transitionError = cmd.Commit()
@@ -485,8 +519,9 @@ func (t *ControllableTask) Kill() error {
 			var commitResponse *CommitResponse
 			select {
 			case commitResponse = <-commitDone:
-			case <-time.After(KILL_TRANSITION_TIMEOUT):
+			case <-transitionDeadline:
 				log.WithField("task", t.ti.TaskID.Value).
+					WithField("timeout", t.Tci.Kill.GetTransitionTimeout().String()).
 					Warn("teardown transition sequence timed out")
 			}
 			// timeout we should break
@@ -509,11 +544,20 @@ func (t *ControllableTask) Kill() error {
 
 		log.WithField("task", t.ti.TaskID.Value).
 			Debug("teardown transition sequence done")
-		pid = int(response.GetPid())
+		pid = t.hostPid(int(response.GetPid()))
 		if pid == 0 {
 			// t.knownPid must be valid because GetState was sure to have been successful in the past
 			pid = t.knownPid
 		}
+
+		if reachedState == "DONE" {
+			// After EXIT the process should wind down by itself, we give it
+			// whatever is left of the transition timeout before signalling it.
+			select {
+			case <-t.processDone:
+			case <-transitionDeadline:
+			}
+		}
 	} else {
 		// If GetState didn't succeed during this Kill code path, but might still have
 		// at some earlier point during the lifetime of this task.
@@ -525,7 +569,7 @@ func (t *ControllableTask) Kill() error {
 		if pid == 0 {
 			// The pid was never known through a successful `GetState` in the lifetime
 			// of this process, so we must rely on the PGID of the containing shell
-			pid = -t.rpc.TaskCmd.Process.Pid
+			pid = -pgid
 			// When killing the containing shell we must use syscall.Kill with a negative PID, in order to kill all
 			// children which were assigned the same PGID at launch.
 
@@ -551,52 +595,78 @@ func (t *ControllableTask) Kill() error {
 		t.pendingFinalTaskStateCh <- mesos.TASK_KILLED
 	}
 
-	return t.doKill(pid)
+	return t.doKill(pid, pgid)
 }
 
-func (t *ControllableTask) doKill(pid int) error {
+// announceCapabilities tells the core what was negotiated with the OCC
+// server of the task, so it can be shown along with the task.
+func (t *ControllableTask) announceCapabilities() {
+	if t.rpc == nil {
+		return
+	}
+	taskMessage := event.NewTaskCapabilitiesEvent(t.ti.TaskID.GetValue(), t.rpc.Capabilities)
+	jsonEvent, err := json.Marshal(taskMessage)
+	if err != nil {
+		log.WithError(err).Warning("error marshaling message from task")
+		return
+	}
+	t.sendMessage(jsonEvent)
+}
 
-	killErrCh := make(chan error)
-	go func() {
-		err := syscall.Kill(pid, syscall.SIGTERM)
+// forwardEvents relays the device events of the task to the core until the
+// event stream breaks or the RPC client goes away.
+func (t *ControllableTask) forwardEvents(esc pb.Occ_EventStreamClient) {
+	deo := event.DeviceEventOrigin{
+		AgentId:    t.ti.AgentID,
+		ExecutorId: t.ti.GetExecutor().ExecutorID,
+		TaskId:     t.ti.TaskID,
+	}
+	for {
+		if t.rpc == nil {
+			log.Warning("event stream done")
+			break
+		}
+		esr, err := esc.Recv()
+		if err == io.EOF {
+			log.WithError(err).Warning("event stream EOF")
+			break
+		}
 		if err != nil {
 			log.WithError(err).
-				WithField("taskId", t.ti.GetTaskID()).
-				Warning("task SIGTERM failed")
+				WithField("errorType", reflect.TypeOf(err)).
+				Warning("error receiving event from task")
+			if status.Code(err) == codes.Unavailable {
+				break
+			}
+			continue
 		}
-		killErrCh <- err
-	}()
+		ev := esr.GetEvent()
 
-	// Set a small timeout to SIGTERM if SIGTERM fails or timeout passes,
-	// we perform a SIGKILL.
-	select {
-	case killErr := <- killErrCh:
-		if killErr == nil {
-			time.Sleep(SIGTERM_TIMEOUT)	// Waiting for the SIGTERM to kick in
-			if pidExists(pid) {
-				// SIGINT for the "Waiting for graceful device shutdown. 
-				// Hit Ctrl-C again to abort immediately" message.
-				killErr = syscall.Kill(pid, syscall.SIGINT)
-				if killErr != nil {
-					log.WithError(killErr).
-						WithField("taskId", t.ti.GetTaskID()).
-						Warning("task SIGINT failed")
-				}
-				time.Sleep(SIGINT_TIMEOUT)
-			}
-			if !pidExists(pid) {
-				return killErr
-			}
+		deviceEvent := event.NewDeviceEvent(deo, ev.GetType())
+		if deviceEvent == nil {
+			log.Debug("nil DeviceEvent received (NULL_DEVICE_EVENT) - closing stream")
+			break
+		}
+		if pf, ok := deviceEvent.(*event.PartialFailure); ok {
+			pf.ErrorCount = int(ev.GetErrorCount())
+			pf.DeviceCount = int(ev.GetDeviceCount())
+			pf.Message = ev.GetMessage()
 		}
-	case <-time.After(SIGTERM_TIMEOUT + SIGINT_TIMEOUT):
-	}
 
-	killErr := syscall.Kill(pid, syscall.SIGKILL)
-	if killErr != nil {
-		log.WithError(killErr).
-			WithField("taskId", t.ti.GetTaskID()).
-			Warning("task SIGKILL failed")
+		t.sendDeviceEvent(deviceEvent)
 	}
+}
 
-	return killErr
-}
\ No newline at end of file
+// doKill runs the signal part of the kill sequence: SIGTERM to pid, then
+// after the grace period SIGKILL to the whole process group.
+func (t *ControllableTask) doKill(pid int, pgid int) error {
+	fields := logrus.Fields{
+		"taskId": t.ti.GetTaskID().Value,
+		"task":   t.ti.Name,
+	}
+	stage, err := terminateProcess(pid, pgid, t.Tci.Kill.GetSigtermTimeout(), killSigintTimeout, t.processDone, &t.killTracker, fields)
+	log.WithFields(fields).
+		WithField("killStage", stage.String()).
+		Info("task terminated")
+	return err
+}
@@ -0,0 +1,224 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2021 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package executable
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/AliceO2Group/Control/common"
+	"github.com/AliceO2Group/Control/common/event"
+	pb "github.com/AliceO2Group/Control/executor/protos"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+)
+
+// monitorHealth runs each health check declared in the TCI on its own
+// schedule until done is closed. Only threshold crossings are reported to
+// the core, not individual check results.
+func (t *ControllableTask) monitorHealth(done <-chan struct{}) {
+	for i := range t.Tci.Health {
+		go t.runHealthCheck(i, t.Tci.Health[i], done)
+	}
+}
+
+func (t *ControllableTask) runHealthCheck(index int, check common.HealthCheck, done <-chan struct{}) {
+	deo := event.DeviceEventOrigin{
+		AgentId:    t.ti.AgentID,
+		ExecutorId: t.ti.GetExecutor().ExecutorID,
+		TaskId:     t.ti.TaskID,
+	}
+	fields := logrus.Fields{
+		"task":   t.ti.Name,
+		"check":  check.Type,
+		"index":  index,
+		"policy": check.GetPolicy(),
+	}
+
+	ticker := time.NewTicker(check.GetInterval())
+	defer ticker.Stop()
+
+	failures := 0
+	unhealthy := false
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+		}
+
+		err := t.doHealthCheck(&check)
+		if err == nil {
+			if unhealthy {
+				log.WithFields(fields).Info("task health check passing again")
+				t.sendTaskHealth(deo, index, &check, true, 0, "health check passing again")
+			}
+			failures = 0
+			unhealthy = false
+			continue
+		}
+
+		failures++
+		log.WithError(err).
+			WithFields(fields).
+			WithField("failures", failures).
+			Debug("task health check failed")
+		if !unhealthy && failures >= check.GetThreshold() {
+			unhealthy = true
+			log.WithError(err).
+				WithFields(fields).
+				WithField("failures", failures).
+				Warning("task health check failure threshold reached")
+			t.sendTaskHealth(deo, index, &check, false, failures, err.Error())
+		}
+	}
+}
+
+func (t *ControllableTask) sendTaskHealth(deo event.DeviceEventOrigin, index int, check *common.HealthCheck, healthy bool, failures int, message string) {
+	deviceEvent := event.NewDeviceEvent(deo, pb.DeviceEventType_TASK_HEALTH)
+	if th, ok := deviceEvent.(*event.TaskHealth); ok {
+		th.Check = check.Type
+		th.Index = index
+		th.Policy = check.GetPolicy()
+		th.Healthy = healthy
+		th.Failures = failures
+		th.Message = message
+		t.sendDeviceEvent(th)
+	}
+}
+
+func (t *ControllableTask) doHealthCheck(check *common.HealthCheck) error {
+	switch check.Type {
+	case common.HealthCheckHeartbeat:
+		return t.checkHeartbeat(check.GetTimeout())
+	case common.HealthCheckHttp:
+		return checkHttp(check.GetUrl(), check.GetTimeout())
+	case common.HealthCheckCommand:
+		return t.checkCommand(check.GetCommand(), check.GetTimeout())
+	}
+	return fmt.Errorf("unknown health check type %s", check.Type)
+}
+
+// checkHeartbeat fails if the task doesn't answer GetState in time, or
+// answers that it is in ERROR.
+func (t *ControllableTask) checkHeartbeat(timeout time.Duration) error {
+	rpc := t.rpc
+	if rpc == nil {
+		return errors.New("task control interface gone")
+	}
+	cxt, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	response, err := rpc.GetState(cxt, &pb.GetStateRequest{}, grpc.EmptyCallOption{})
+	if err != nil {
+		return fmt.Errorf("GetState failed: %w", err)
+	}
+	if state := rpc.FromDeviceState(response.GetState()); strings.ToUpper(state) == "ERROR" {
+		return fmt.Errorf("task reports state %s", response.GetState())
+	}
+	return nil
+}
+
+// checkHttp fails unless the url answers with a 2xx or 3xx status.
+func checkHttp(url string, timeout time.Duration) error {
+	client := http.Client{Timeout: timeout}
+	response, err := client.Get(url)
+	if err != nil {
+		return err
+	}
+	_ = response.Body.Close()
+	if response.StatusCode >= 400 {
+		return fmt.Errorf("%s returned %s", url, response.Status)
+	}
+	return nil
+}
+
+// checkCommand fails unless the command exits with status 0. Like the
+// readiness probe, it runs on the host with the task's user and environment.
+func (t *ControllableTask) checkCommand(command string, timeout time.Duration) error {
+	shell := true
+	checkCmd, err := prepareTaskCmd(&common.TaskCommandInfo{
+		CommandInfo: common.CommandInfo{
+			Env:   t.Tci.Env,
+			Shell: &shell,
+			Value: &command,
+			User:  t.Tci.User,
+		},
+		Timeout: timeout,
+	}, t.ti.TaskID.Value)
+	if err != nil {
+		return err
+	}
+	output, err := runProbeCommand(checkCmd, timeout)
+	if err != nil {
+		return fmt.Errorf("%s: %w: %s", command, err, strings.TrimSpace(string(output)))
+	}
+	return nil
+}
+
+// probeMaxOutput bounds how much of a probe command's output we keep.
+const probeMaxOutput = 64*1024
+
+// runProbeCommand runs a command built by prepareTaskCmd and returns its
+// combined output. The output goes to a temporary file rather than a pipe,
+// so that background children which inherit it can't keep us waiting.
+// When the timeout expires the whole process group is killed, not just
+// the shell, and so is anything the command left behind once it exits.
+func runProbeCommand(cmd *exec.Cmd, timeout time.Duration) ([]byte, error) {
+	outputFile, err := ioutil.TempFile("", "o2-probe-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(outputFile.Name())
+	defer outputFile.Close()
+	cmd.Stdout = outputFile
+	cmd.Stderr = outputFile
+
+	if err = cmd.Start(); err != nil {
+		return nil, err
+	}
+	// prepareTaskCmd makes the command the leader of its own process group
+	pgid := cmd.Process.Pid
+	timer := time.AfterFunc(timeout, func() {
+		_ = syscall.Kill(-pgid, syscall.SIGKILL)
+	})
+	err = cmd.Wait()
+	timer.Stop()
+	_ = syscall.Kill(-pgid, syscall.SIGKILL)
+
+	if _, seekErr := outputFile.Seek(0, io.SeekStart); seekErr != nil {
+		return nil, err
+	}
+	output, _ := ioutil.ReadAll(io.LimitReader(outputFile, probeMaxOutput))
+	return output, err
+}
@@ -0,0 +1,182 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2021 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+
+package executable
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/AliceO2Group/Control/common"
+	"github.com/AliceO2Group/Control/common/event"
+	mesos "github.com/mesos/mesos-go/api/v1/lib"
+)
+
+func newHealthCheckTask(sendDeviceEvent SendDeviceEventFunc) *ControllableTask {
+	return &ControllableTask{
+		taskBase: taskBase{
+			ti: &mesos.TaskInfo{
+				Name:     "test-task",
+				TaskID:   mesos.TaskID{Value: "task-1"},
+				Executor: &mesos.ExecutorInfo{ExecutorID: mesos.ExecutorID{Value: "test-executor"}},
+			},
+			Tci:             &common.TaskCommandInfo{},
+			sendDeviceEvent: sendDeviceEvent,
+		},
+	}
+}
+
+func TestCheckCommand(t *testing.T) {
+	task := newHealthCheckTask(nil)
+
+	if err := task.checkCommand("true", time.Second); err != nil {
+		t.Errorf("expected passing command check, got %s", err)
+	}
+	err := task.checkCommand("echo broken; exit 3", time.Second)
+	if err == nil {
+		t.Fatal("expected failing command check")
+	}
+	if !strings.Contains(err.Error(), "broken") {
+		t.Errorf("expected the command output in the error, got %s", err)
+	}
+}
+
+func TestCheckCommandTimeoutKillsGrandchildren(t *testing.T) {
+	task := newHealthCheckTask(nil)
+
+	// the background sleep inherits the output pipe of the shell, killing
+	// only the shell would leave us waiting for it
+	start := time.Now()
+	err := task.checkCommand("sleep 30 & sleep 30", 200*time.Millisecond)
+	if err == nil {
+		t.Fatal("expected command check to time out")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("command check returned after %s, its timeout was not enforced", elapsed)
+	}
+}
+
+func TestCheckCommandWithBackgroundChild(t *testing.T) {
+	task := newHealthCheckTask(nil)
+
+	start := time.Now()
+	if err := task.checkCommand("sleep 5 & exit 0", 10*time.Second); err != nil {
+		t.Errorf("expected passing command check, got %s", err)
+	}
+	if elapsed := time.Since(start); elapsed > 4*time.Second {
+		t.Errorf("command check waited %s for a background child", elapsed)
+	}
+}
+
+func TestCheckHttp(t *testing.T) {
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	if err := checkHttp(server.URL, time.Second); err != nil {
+		t.Errorf("expected passing http check, got %s", err)
+	}
+	status = http.StatusServiceUnavailable
+	if err := checkHttp(server.URL, time.Second); err == nil {
+		t.Error("expected failing http check on 503")
+	}
+
+	url := server.URL
+	server.Close()
+	if err := checkHttp(url, time.Second); err == nil {
+		t.Error("expected failing http check with the server down")
+	}
+}
+
+func TestRunHealthCheckThreshold(t *testing.T) {
+	marker := filepath.Join(t.TempDir(), "healthy")
+	events := make(chan *event.TaskHealth, 10)
+	task := newHealthCheckTask(func(e event.DeviceEvent) {
+		if th, ok := e.(*event.TaskHealth); ok {
+			events <- th
+		}
+	})
+
+	command := "test -e " + marker
+	check := common.HealthCheck{
+		Type:      common.HealthCheckCommand,
+		Interval:  20*time.Millisecond,
+		Timeout:   time.Second,
+		Threshold: 3,
+		Command:   &command,
+		Policy:    common.HealthPolicyUnhealthy,
+	}
+	done := make(chan struct{})
+	defer close(done)
+	go task.runHealthCheck(2, check, done)
+
+	next := func() *event.TaskHealth {
+		t.Helper()
+		select {
+		case th := <-events:
+			return th
+		case <-time.After(5*time.Second):
+			t.Fatal("no task health event received")
+		}
+		return nil
+	}
+
+	th := next()
+	if th.Healthy || th.Failures != 3 {
+		t.Errorf("expected unhealthy after 3 failures, got healthy=%v failures=%d", th.Healthy, th.Failures)
+	}
+	if th.Check != common.HealthCheckCommand || th.Index != 2 || th.Policy != common.HealthPolicyUnhealthy {
+		t.Errorf("unexpected check %s, index %d, policy %s", th.Check, th.Index, th.Policy)
+	}
+
+	// further failures must not be reported again
+	select {
+	case th := <-events:
+		t.Fatalf("unexpected task health event while still failing: %+v", th)
+	case <-time.After(200*time.Millisecond):
+	}
+
+	if err := ioutil.WriteFile(marker, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	th = next()
+	if !th.Healthy || th.Failures != 0 {
+		t.Errorf("expected healthy again, got healthy=%v failures=%d", th.Healthy, th.Failures)
+	}
+
+	// passing checks are only reported when recovering
+	select {
+	case th := <-events:
+		t.Fatalf("unexpected task health event while passing: %+v", th)
+	case <-time.After(200*time.Millisecond):
+	}
+}
@@ -0,0 +1,393 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2021 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+
+package executable
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"os/user"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/AliceO2Group/Control/common"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	isolationNamePrefix       = "o2-task-"
+	ociDirName                = "oci"
+	cpuQuotaPeriod            = 100000 // µs
+	isolatedPidLookupTimeout  = 10*time.Second
+	isolatedPidLookupInterval = 200*time.Millisecond
+)
+
+// isolationName is the name of the systemd scope or container of a task
+func isolationName(taskId string) string {
+	return isolationNamePrefix + taskId
+}
+
+func ociStateDir() string {
+	return filepath.Join(sandboxDir(), ociDirName, "state")
+}
+
+func ociBundleDir(taskId string) string {
+	return filepath.Join(sandboxDir(), ociDirName, taskId)
+}
+
+// isolateTaskCommand wraps the argv of a task in the invocation which runs
+// it with the isolation requested by the task class.
+// setCredential is false if the wrapper itself takes care of switching to
+// the task user, so the wrapper process must keep the executor's credentials.
+func isolateTaskCommand(commandInfo *common.TaskCommandInfo, taskId string, argv []string) (wrapped []string, setCredential bool, err error) {
+	iso := commandInfo.Isolation
+	name := isolationName(taskId)
+
+	switch iso.Mode {
+	case common.IsolationSystemd:
+		// With --scope, systemd-run execs the command in place, so the PID
+		// of the task is the PID of the process we start
+		wrapped = []string{"systemd-run", "--scope", "--quiet", "--collect", "--unit=" + name}
+		if iso.Cpus > 0 {
+			wrapped = append(wrapped, "-p", fmt.Sprintf("CPUQuota=%d%%", int64(iso.Cpus * 100)))
+		}
+		if iso.Memory > 0 {
+			wrapped = append(wrapped, "-p", fmt.Sprintf("MemoryMax=%dM", int64(iso.Memory)))
+		}
+		if commandInfo.User != nil && len(*commandInfo.User) > 0 {
+			wrapped = append(wrapped, "--uid=" + *commandInfo.User)
+		}
+		wrapped = append(append(wrapped, "--"), argv...)
+		return wrapped, false, nil
+
+	case common.IsolationPodman:
+		if len(iso.Image) == 0 {
+			return nil, false, errors.New("podman isolation requires a container image")
+		}
+		// Tasks talk to each other and to the executor over the host network
+		wrapped = []string{"podman", "run", "--rm", "--name", name, "--network=host", "--ipc=host", "--sig-proxy=true"}
+		if commandInfo.User != nil && len(*commandInfo.User) > 0 {
+			// rootless podman, we run as the task user and keep its uid inside
+			wrapped = append(wrapped, "--userns=keep-id")
+		}
+		if iso.Cpus > 0 {
+			wrapped = append(wrapped, "--cpus", strconv.FormatFloat(iso.Cpus, 'f', -1, 64))
+		}
+		if iso.Memory > 0 {
+			wrapped = append(wrapped, "--memory", fmt.Sprintf("%dm", int64(iso.Memory)))
+		}
+		for _, mount := range iso.Mounts {
+			volume := mount.Source + ":" + mount.Target
+			if mount.ReadOnly {
+				volume += ":ro"
+			}
+			wrapped = append(wrapped, "-v", volume)
+		}
+		for _, device := range iso.Devices {
+			wrapped = append(wrapped, "--device", device)
+		}
+		for _, env := range commandInfo.Env {
+			wrapped = append(wrapped, "-e", env)
+		}
+		wrapped = append(append(wrapped, iso.Image), argv...)
+		return wrapped, true, nil
+
+	case common.IsolationOci:
+		var bundleDir string
+		bundleDir, err = writeOciBundle(commandInfo, taskId, argv)
+		if err != nil {
+			return nil, false, err
+		}
+		wrapped = []string{iso.GetRuntime(), "--root", ociStateDir(), "run", "--bundle", bundleDir, name}
+		return wrapped, true, nil
+	}
+
+	return nil, false, fmt.Errorf("unknown isolation mode %s", iso.Mode)
+}
+
+// writeOciBundle creates a bundle for the task in the sandbox, whose
+// config.json is the one of the bundle in the task class with the command,
+// environment, user, limits, mounts and devices of the task. The root
+// filesystem stays where it is.
+func writeOciBundle(commandInfo *common.TaskCommandInfo, taskId string, argv []string) (bundleDir string, err error) {
+	iso := commandInfo.Isolation
+	if len(iso.Bundle) == 0 {
+		return "", errors.New("oci isolation requires a bundle path")
+	}
+
+	var data []byte
+	data, err = ioutil.ReadFile(filepath.Join(iso.Bundle, "config.json"))
+	if err != nil {
+		return "", fmt.Errorf("cannot read OCI bundle config: %w", err)
+	}
+	spec := make(map[string]interface{})
+	if err = json.Unmarshal(data, &spec); err != nil {
+		return "", fmt.Errorf("cannot parse OCI bundle config: %w", err)
+	}
+
+	process := ociObject(spec, "process")
+	process["args"] = argv
+	process["terminal"] = false
+	env := make([]interface{}, 0)
+	if baseEnv, ok := process["env"].([]interface{}); ok {
+		env = append(env, baseEnv...)
+	}
+	for _, v := range commandInfo.Env {
+		env = append(env, v)
+	}
+	process["env"] = env
+
+	linux := ociObject(spec, "linux")
+	if commandInfo.User != nil && len(*commandInfo.User) > 0 {
+		// The runtime runs rootless as the task user, so the user namespace
+		// of the container maps that user, and only that user, onto itself
+		if err = mapOciUser(process, linux, *commandInfo.User); err != nil {
+			return "", err
+		}
+	}
+
+	root := ociObject(spec, "root")
+	if rootPath, ok := root["path"].(string); ok && !filepath.IsAbs(rootPath) {
+		root["path"] = filepath.Join(iso.Bundle, rootPath)
+	}
+
+	mounts, _ := spec["mounts"].([]interface{})
+	for _, mount := range iso.Mounts {
+		options := []string{"rbind", "rw"}
+		if mount.ReadOnly {
+			options[1] = "ro"
+		}
+		mounts = append(mounts, map[string]interface{}{
+			"destination": mount.Target,
+			"type":        "bind",
+			"source":      mount.Source,
+			"options":     options,
+		})
+	}
+	spec["mounts"] = mounts
+
+	resources := ociObject(linux, "resources")
+	if iso.Cpus > 0 {
+		cpu := ociObject(resources, "cpu")
+		cpu["period"] = cpuQuotaPeriod
+		cpu["quota"] = int64(iso.Cpus * cpuQuotaPeriod)
+	}
+	if iso.Memory > 0 {
+		memory := ociObject(resources, "memory")
+		memory["limit"] = int64(iso.Memory * 1024 * 1024)
+	}
+
+	devices, _ := linux["devices"].([]interface{})
+	deviceRules, _ := resources["devices"].([]interface{})
+	for _, path := range iso.Devices {
+		var st unix.Stat_t
+		if err = unix.Stat(path, &st); err != nil {
+			return "", fmt.Errorf("cannot stat device %s: %w", path, err)
+		}
+		var devType string
+		switch st.Mode & unix.S_IFMT {
+		case unix.S_IFCHR:
+			devType = "c"
+		case unix.S_IFBLK:
+			devType = "b"
+		default:
+			return "", fmt.Errorf("%s is not a device node", path)
+		}
+		major, minor := int64(unix.Major(uint64(st.Rdev))), int64(unix.Minor(uint64(st.Rdev)))
+		devices = append(devices, map[string]interface{}{
+			"path":     path,
+			"type":     devType,
+			"major":    major,
+			"minor":    minor,
+			"fileMode": st.Mode & 0777,
+			"uid":      st.Uid,
+			"gid":      st.Gid,
+		})
+		deviceRules = append(deviceRules, map[string]interface{}{
+			"allow":  true,
+			"type":   devType,
+			"major":  major,
+			"minor":  minor,
+			"access": "rwm",
+		})
+	}
+	if len(devices) > 0 {
+		linux["devices"] = devices
+		resources["devices"] = deviceRules
+	}
+
+	bundleDir = ociBundleDir(taskId)
+	if err = os.MkdirAll(bundleDir, 0755); err != nil {
+		return "", err
+	}
+	if data, err = json.MarshalIndent(spec, "", "\t"); err != nil {
+		return "", err
+	}
+	if err = ioutil.WriteFile(filepath.Join(bundleDir, "config.json"), data, 0644); err != nil {
+		return "", err
+	}
+	return bundleDir, nil
+}
+
+// mapOciUser makes the process of an OCI spec run as username, in a user
+// namespace where the uid and gid of username are the same as on the host
+func mapOciUser(process map[string]interface{}, linux map[string]interface{}, username string) error {
+	targetUser, err := user.Lookup(username)
+	if err != nil {
+		return err
+	}
+	uid, err := strconv.ParseUint(targetUser.Uid, 10, 32)
+	if err != nil {
+		return err
+	}
+	gid, err := strconv.ParseUint(targetUser.Gid, 10, 32)
+	if err != nil {
+		return err
+	}
+
+	process["user"] = map[string]interface{}{
+		"uid": uid,
+		"gid": gid,
+	}
+	linux["uidMappings"] = []interface{}{
+		map[string]interface{}{"containerID": uid, "hostID": uid, "size": 1},
+	}
+	linux["gidMappings"] = []interface{}{
+		map[string]interface{}{"containerID": gid, "hostID": gid, "size": 1},
+	}
+
+	namespaces, _ := linux["namespaces"].([]interface{})
+	for _, ns := range namespaces {
+		if nsObj, ok := ns.(map[string]interface{}); ok && nsObj["type"] == "user" {
+			return nil
+		}
+	}
+	linux["namespaces"] = append(namespaces, map[string]interface{}{"type": "user"})
+	return nil
+}
+
+// ociObject returns the JSON object under key in parent, creating it if needed
+func ociObject(parent map[string]interface{}, key string) map[string]interface{} {
+	if obj, ok := parent[key].(map[string]interface{}); ok {
+		return obj
+	}
+	obj := make(map[string]interface{})
+	parent[key] = obj
+	return obj
+}
+
+// isolationHelperCmd builds a command which talks to the container runtime
+// of a task, with the same environment and credentials as the task command
+func isolationHelperCmd(taskCmd *exec.Cmd, argv ...string) *exec.Cmd {
+	cmd := exec.Command(argv[0], argv[1:]...)
+	cmd.Env = taskCmd.Env
+	if taskCmd.SysProcAttr != nil && taskCmd.SysProcAttr.Credential != nil {
+		cmd.SysProcAttr = &syscall.SysProcAttr{Credential: taskCmd.SysProcAttr.Credential}
+	}
+	return cmd
+}
+
+// resolveIsolatedPid returns the PID, as seen from the host, of the main
+// process of a containerized task. For other tasks it is the PID of the
+// process started by the executor.
+func resolveIsolatedPid(taskCmd *exec.Cmd, commandInfo *common.TaskCommandInfo, taskId string) (int, error) {
+	if !commandInfo.Isolation.IsContainer() {
+		return taskCmd.Process.Pid, nil
+	}
+
+	name := isolationName(taskId)
+	deadline := time.Now().Add(isolatedPidLookupTimeout)
+	for {
+		var pid int
+		switch commandInfo.Isolation.Mode {
+		case common.IsolationPodman:
+			out, err := isolationHelperCmd(taskCmd, "podman", "inspect", "--format", "{{.State.Pid}}", name).Output()
+			if err == nil {
+				pid, _ = strconv.Atoi(strings.TrimSpace(string(out)))
+			}
+		case common.IsolationOci:
+			out, err := isolationHelperCmd(taskCmd, commandInfo.Isolation.GetRuntime(), "--root", ociStateDir(), "state", name).Output()
+			if err == nil {
+				var state struct {
+					Pid int `json:"pid"`
+				}
+				if json.Unmarshal(out, &state) == nil {
+					pid = state.Pid
+				}
+			}
+		}
+		if pid > 0 {
+			return pid, nil
+		}
+		if time.Now().After(deadline) {
+			return 0, fmt.Errorf("cannot find host PID of container %s", name)
+		}
+		time.Sleep(isolatedPidLookupInterval)
+	}
+}
+
+// cleanupIsolatedTask removes whatever the container runtime might have
+// left behind once the task process is gone, e.g. if the runtime itself
+// got SIGKILLed and never got to remove its container.
+func cleanupIsolatedTask(taskCmd *exec.Cmd, commandInfo *common.TaskCommandInfo, taskId string) {
+	if taskCmd == nil || !commandInfo.Isolation.IsContainer() {
+		return
+	}
+
+	name := isolationName(taskId)
+	var err error
+	switch commandInfo.Isolation.Mode {
+	case common.IsolationPodman:
+		err = isolationHelperCmd(taskCmd, "podman", "rm", "--force", "--ignore", name).Run()
+	case common.IsolationOci:
+		err = isolationHelperCmd(taskCmd, commandInfo.Isolation.GetRuntime(), "--root", ociStateDir(), "delete", "--force", name).Run()
+		_ = os.RemoveAll(ociBundleDir(taskId))
+	}
+	if err != nil {
+		log.WithError(err).
+			WithFields(logrus.Fields{
+				"taskId":    taskId,
+				"container": name,
+			}).
+			Debug("container cleanup failed")
+	}
+}
+
+// hostPid maps a PID reported by the task itself through OCC to the
+// corresponding PID on the host
+func (t *ControllableTask) hostPid(reported int) int {
+	if t.Tci.Isolation.IsContainer() && t.isolatedPid > 0 {
+		return t.isolatedPid
+	}
+	return reported
+}
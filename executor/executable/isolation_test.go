@@ -0,0 +1,449 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2021 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+
+package executable
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"os/user"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/AliceO2Group/Control/common"
+)
+
+// setSandboxDir points the sandbox of the executor to a temporary directory
+// for the duration of the test
+func setSandboxDir(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	old, wasSet := os.LookupEnv("MESOS_SANDBOX")
+	_ = os.Setenv("MESOS_SANDBOX", dir)
+	t.Cleanup(func() {
+		if wasSet {
+			_ = os.Setenv("MESOS_SANDBOX", old)
+		} else {
+			_ = os.Unsetenv("MESOS_SANDBOX")
+		}
+	})
+	return dir
+}
+
+// writeTestOciBundle creates a bundle like the one of a task class, with a
+// relative root filesystem path
+func writeTestOciBundle(t *testing.T, namespaces []string) string {
+	t.Helper()
+	bundle := filepath.Join(t.TempDir(), "bundle")
+	if err := os.MkdirAll(bundle, 0755); err != nil {
+		t.Fatal(err)
+	}
+	nsList := make([]map[string]string, 0)
+	for _, ns := range namespaces {
+		nsList = append(nsList, map[string]string{"type": ns})
+	}
+	data, err := json.Marshal(map[string]interface{}{
+		"ociVersion": "1.0.2",
+		"process": map[string]interface{}{
+			"terminal": true,
+			"user":     map[string]interface{}{"uid": 0, "gid": 0},
+			"args":     []string{"sh"},
+			"env":      []string{"PATH=/usr/bin:/bin"},
+			"cwd":      "/",
+		},
+		"root":   map[string]interface{}{"path": "rootfs", "readonly": true},
+		"mounts": []map[string]interface{}{{"destination": "/proc", "type": "proc", "source": "proc"}},
+		"linux":  map[string]interface{}{"namespaces": nsList},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = ioutil.WriteFile(filepath.Join(bundle, "config.json"), data, 0644); err != nil {
+		t.Fatal(err)
+	}
+	return bundle
+}
+
+type ociTestIdMapping struct {
+	ContainerID uint32 `json:"containerID"`
+	HostID      uint32 `json:"hostID"`
+	Size        uint32 `json:"size"`
+}
+
+// ociTestSpec is the part of an OCI runtime spec the tests look at
+type ociTestSpec struct {
+	Process struct {
+		Terminal bool     `json:"terminal"`
+		Args     []string `json:"args"`
+		Env      []string `json:"env"`
+		User     struct {
+			Uid uint32 `json:"uid"`
+			Gid uint32 `json:"gid"`
+		} `json:"user"`
+	} `json:"process"`
+	Root struct {
+		Path string `json:"path"`
+	} `json:"root"`
+	Mounts []struct {
+		Destination string   `json:"destination"`
+		Type        string   `json:"type"`
+		Source      string   `json:"source"`
+		Options     []string `json:"options"`
+	} `json:"mounts"`
+	Linux struct {
+		UidMappings []ociTestIdMapping `json:"uidMappings"`
+		GidMappings []ociTestIdMapping `json:"gidMappings"`
+		Namespaces  []struct {
+			Type string `json:"type"`
+		} `json:"namespaces"`
+		Resources struct {
+			Cpu *struct {
+				Period int64 `json:"period"`
+				Quota  int64 `json:"quota"`
+			} `json:"cpu"`
+			Memory *struct {
+				Limit int64 `json:"limit"`
+			} `json:"memory"`
+			Devices []struct {
+				Allow  bool   `json:"allow"`
+				Type   string `json:"type"`
+				Major  int64  `json:"major"`
+				Minor  int64  `json:"minor"`
+				Access string `json:"access"`
+			} `json:"devices"`
+		} `json:"resources"`
+		Devices []struct {
+			Path  string `json:"path"`
+			Type  string `json:"type"`
+			Major int64  `json:"major"`
+			Minor int64  `json:"minor"`
+		} `json:"devices"`
+	} `json:"linux"`
+}
+
+func TestIsolateTaskCommand(t *testing.T) {
+	sandbox := setSandboxDir(t)
+	bundle := writeTestOciBundle(t, []string{"pid", "mount"})
+
+	argv := []string{"/bin/sh", "-c", "echo \"a b\" 'c d'"}
+	username := "flp"
+
+	testCases := []struct {
+		name          string
+		isolation     common.Isolation
+		user          *string
+		env           []string
+		expected      []string
+		setCredential bool
+		wantErr       bool
+	}{
+		{
+			name:      "systemd without limits",
+			isolation: common.Isolation{Mode: common.IsolationSystemd},
+			expected: []string{"systemd-run", "--scope", "--quiet", "--collect", "--unit=o2-task-t1",
+				"--", "/bin/sh", "-c", "echo \"a b\" 'c d'"},
+		},
+		{
+			name:      "systemd with limits and user",
+			isolation: common.Isolation{Mode: common.IsolationSystemd, Cpus: 1.5, Memory: 512},
+			user:      &username,
+			expected: []string{"systemd-run", "--scope", "--quiet", "--collect", "--unit=o2-task-t1",
+				"-p", "CPUQuota=150%", "-p", "MemoryMax=512M", "--uid=flp",
+				"--", "/bin/sh", "-c", "echo \"a b\" 'c d'"},
+		},
+		{
+			name:      "podman without image",
+			isolation: common.Isolation{Mode: common.IsolationPodman},
+			wantErr:   true,
+		},
+		{
+			name: "podman with limits, mounts, devices and environment",
+			isolation: common.Isolation{
+				Mode:   common.IsolationPodman,
+				Image:  "registry.cern.ch/o2/flp:latest",
+				Cpus:   0.5,
+				Memory: 256,
+				Mounts: []common.IsolationMount{
+					{Source: "/etc/o2", Target: "/etc/o2", ReadOnly: true},
+					{Source: "/data", Target: "/data"},
+				},
+				Devices: []string{"/dev/cru0"},
+			},
+			user: &username,
+			env:  []string{"O2_ROLE=flp001", "O2_MSG=hello world"},
+			expected: []string{"podman", "run", "--rm", "--name", "o2-task-t1", "--network=host", "--ipc=host", "--sig-proxy=true",
+				"--userns=keep-id", "--cpus", "0.5", "--memory", "256m",
+				"-v", "/etc/o2:/etc/o2:ro", "-v", "/data:/data",
+				"--device", "/dev/cru0",
+				"-e", "O2_ROLE=flp001", "-e", "O2_MSG=hello world",
+				"registry.cern.ch/o2/flp:latest", "/bin/sh", "-c", "echo \"a b\" 'c d'"},
+			setCredential: true,
+		},
+		{
+			name:      "oci without bundle",
+			isolation: common.Isolation{Mode: common.IsolationOci},
+			wantErr:   true,
+		},
+		{
+			name:      "oci with the default runtime",
+			isolation: common.Isolation{Mode: common.IsolationOci, Bundle: bundle},
+			expected: []string{"runc", "--root", filepath.Join(sandbox, "oci", "state"),
+				"run", "--bundle", filepath.Join(sandbox, "oci", "t1"), "o2-task-t1"},
+			setCredential: true,
+		},
+		{
+			name:      "oci with crun",
+			isolation: common.Isolation{Mode: common.IsolationOci, Bundle: bundle, Runtime: "crun"},
+			expected: []string{"crun", "--root", filepath.Join(sandbox, "oci", "state"),
+				"run", "--bundle", filepath.Join(sandbox, "oci", "t1"), "o2-task-t1"},
+			setCredential: true,
+		},
+		{
+			name:      "unknown mode",
+			isolation: common.Isolation{Mode: "jail"},
+			wantErr:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			commandInfo := &common.TaskCommandInfo{Isolation: tc.isolation}
+			commandInfo.User = tc.user
+			commandInfo.Env = tc.env
+
+			wrapped, setCredential, err := isolateTaskCommand(commandInfo, "t1", argv)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got %q", wrapped)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(wrapped, tc.expected) {
+				t.Errorf("expected\n%q\ngot\n%q", tc.expected, wrapped)
+			}
+			if setCredential != tc.setCredential {
+				t.Errorf("expected setCredential %t, got %t", tc.setCredential, setCredential)
+			}
+		})
+	}
+}
+
+func TestWriteOciBundle(t *testing.T) {
+	sandbox := setSandboxDir(t)
+
+	current, err := user.Current()
+	if err != nil {
+		t.Fatal(err)
+	}
+	uid, _ := strconv.ParseUint(current.Uid, 10, 32)
+	gid, _ := strconv.ParseUint(current.Gid, 10, 32)
+	username := current.Username
+
+	testCases := []struct {
+		name       string
+		namespaces []string
+		isolation  common.Isolation
+		user       *string
+		argv       []string
+		env        []string
+		check      func(t *testing.T, spec *ociTestSpec)
+	}{
+		{
+			name: "argv and environment",
+			argv: []string{"/bin/sh", "-c", "o2-readout-exe --config \"file:/etc/o2/readout.cfg\" 'x y'", "", "a\tb"},
+			env:  []string{"O2_MSG=hello world", "O2_QUOTE=\"q\""},
+			check: func(t *testing.T, spec *ociTestSpec) {
+				expectedArgs := []string{"/bin/sh", "-c", "o2-readout-exe --config \"file:/etc/o2/readout.cfg\" 'x y'", "", "a\tb"}
+				if !reflect.DeepEqual(spec.Process.Args, expectedArgs) {
+					t.Errorf("expected args %q, got %q", expectedArgs, spec.Process.Args)
+				}
+				expectedEnv := []string{"PATH=/usr/bin:/bin", "O2_MSG=hello world", "O2_QUOTE=\"q\""}
+				if !reflect.DeepEqual(spec.Process.Env, expectedEnv) {
+					t.Errorf("expected env %q, got %q", expectedEnv, spec.Process.Env)
+				}
+				if spec.Process.Terminal {
+					t.Error("expected no terminal")
+				}
+				if len(spec.Linux.UidMappings) != 0 || len(spec.Linux.GidMappings) != 0 {
+					t.Error("expected no user mapping without a task user")
+				}
+			},
+		},
+		{
+			name:       "user mapping",
+			namespaces: []string{"pid", "mount"},
+			user:       &username,
+			argv:       []string{"true"},
+			check: func(t *testing.T, spec *ociTestSpec) {
+				if spec.Process.User.Uid != uint32(uid) || spec.Process.User.Gid != uint32(gid) {
+					t.Errorf("expected process user %d:%d, got %d:%d", uid, gid, spec.Process.User.Uid, spec.Process.User.Gid)
+				}
+				expectedUid := []ociTestIdMapping{{ContainerID: uint32(uid), HostID: uint32(uid), Size: 1}}
+				if !reflect.DeepEqual(spec.Linux.UidMappings, expectedUid) {
+					t.Errorf("expected uid mappings %+v, got %+v", expectedUid, spec.Linux.UidMappings)
+				}
+				expectedGid := []ociTestIdMapping{{ContainerID: uint32(gid), HostID: uint32(gid), Size: 1}}
+				if !reflect.DeepEqual(spec.Linux.GidMappings, expectedGid) {
+					t.Errorf("expected gid mappings %+v, got %+v", expectedGid, spec.Linux.GidMappings)
+				}
+				if count := countNamespaces(spec, "user"); count != 1 {
+					t.Errorf("expected one user namespace, got %d", count)
+				}
+				if count := countNamespaces(spec, "pid"); count != 1 {
+					t.Errorf("expected the namespaces of the bundle to be kept, got %d pid namespaces", count)
+				}
+			},
+		},
+		{
+			name:       "user mapping with a user namespace in the bundle",
+			namespaces: []string{"pid", "user"},
+			user:       &username,
+			argv:       []string{"true"},
+			check: func(t *testing.T, spec *ociTestSpec) {
+				if count := countNamespaces(spec, "user"); count != 1 {
+					t.Errorf("expected one user namespace, got %d", count)
+				}
+				if len(spec.Linux.UidMappings) != 1 || len(spec.Linux.GidMappings) != 1 {
+					t.Errorf("expected one uid and one gid mapping, got %+v and %+v", spec.Linux.UidMappings, spec.Linux.GidMappings)
+				}
+			},
+		},
+		{
+			name: "limits, mounts and devices",
+			isolation: common.Isolation{
+				Cpus:   2.5,
+				Memory: 128,
+				Mounts: []common.IsolationMount{
+					{Source: "/etc/o2", Target: "/etc/o2", ReadOnly: true},
+					{Source: "/data", Target: "/data"},
+				},
+				Devices: []string{"/dev/null"},
+			},
+			argv: []string{"true"},
+			check: func(t *testing.T, spec *ociTestSpec) {
+				if spec.Linux.Resources.Cpu == nil || spec.Linux.Resources.Cpu.Period != 100000 || spec.Linux.Resources.Cpu.Quota != 250000 {
+					t.Errorf("unexpected cpu limit %+v", spec.Linux.Resources.Cpu)
+				}
+				if spec.Linux.Resources.Memory == nil || spec.Linux.Resources.Memory.Limit != 128*1024*1024 {
+					t.Errorf("unexpected memory limit %+v", spec.Linux.Resources.Memory)
+				}
+				if len(spec.Mounts) != 3 {
+					t.Fatalf("expected the mount of the bundle and 2 bind mounts, got %+v", spec.Mounts)
+				}
+				if spec.Mounts[1].Source != "/etc/o2" || spec.Mounts[1].Type != "bind" ||
+					!reflect.DeepEqual(spec.Mounts[1].Options, []string{"rbind", "ro"}) {
+					t.Errorf("unexpected read-only mount %+v", spec.Mounts[1])
+				}
+				if spec.Mounts[2].Destination != "/data" || !reflect.DeepEqual(spec.Mounts[2].Options, []string{"rbind", "rw"}) {
+					t.Errorf("unexpected read-write mount %+v", spec.Mounts[2])
+				}
+				if len(spec.Linux.Devices) != 1 || spec.Linux.Devices[0].Path != "/dev/null" ||
+					spec.Linux.Devices[0].Type != "c" || spec.Linux.Devices[0].Major != 1 || spec.Linux.Devices[0].Minor != 3 {
+					t.Errorf("unexpected devices %+v", spec.Linux.Devices)
+				}
+				if len(spec.Linux.Resources.Devices) != 1 || !spec.Linux.Resources.Devices[0].Allow ||
+					spec.Linux.Resources.Devices[0].Access != "rwm" {
+					t.Errorf("unexpected device rules %+v", spec.Linux.Resources.Devices)
+				}
+			},
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			bundle := writeTestOciBundle(t, tc.namespaces)
+			iso := tc.isolation
+			iso.Mode = common.IsolationOci
+			iso.Bundle = bundle
+			commandInfo := &common.TaskCommandInfo{Isolation: iso}
+			commandInfo.User = tc.user
+			commandInfo.Env = tc.env
+
+			taskId := "t" + strconv.Itoa(i)
+			bundleDir, err := writeOciBundle(commandInfo, taskId, tc.argv)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if bundleDir != filepath.Join(sandbox, "oci", taskId) {
+				t.Errorf("unexpected bundle directory %s", bundleDir)
+			}
+
+			data, err := ioutil.ReadFile(filepath.Join(bundleDir, "config.json"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			spec := &ociTestSpec{}
+			if err = json.Unmarshal(data, spec); err != nil {
+				t.Fatal(err)
+			}
+			if spec.Root.Path != filepath.Join(bundle, "rootfs") {
+				t.Errorf("expected the root filesystem of the bundle, got %s", spec.Root.Path)
+			}
+			tc.check(t, spec)
+		})
+	}
+}
+
+func TestWriteOciBundleErrors(t *testing.T) {
+	setSandboxDir(t)
+	bundle := writeTestOciBundle(t, nil)
+	missingUser := "o2-no-such-user"
+
+	testCases := []struct {
+		name      string
+		isolation common.Isolation
+		user      *string
+	}{
+		{"no bundle", common.Isolation{Mode: common.IsolationOci}, nil},
+		{"missing bundle", common.Isolation{Mode: common.IsolationOci, Bundle: filepath.Join(bundle, "nope")}, nil},
+		{"unknown user", common.Isolation{Mode: common.IsolationOci, Bundle: bundle}, &missingUser},
+		{"not a device", common.Isolation{Mode: common.IsolationOci, Bundle: bundle, Devices: []string{filepath.Join(bundle, "config.json")}}, nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			commandInfo := &common.TaskCommandInfo{Isolation: tc.isolation}
+			commandInfo.User = tc.user
+			if _, err := writeOciBundle(commandInfo, "t1", []string{"true"}); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+func countNamespaces(spec *ociTestSpec, nsType string) (count int) {
+	for _, ns := range spec.Linux.Namespaces {
+		if ns.Type == nsType {
+			count++
+		}
+	}
+	return
+}
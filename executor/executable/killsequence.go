@@ -0,0 +1,170 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2021 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+
+package executable
+
+import (
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	killPollingInterval = 100*time.Millisecond
+	// FairMQ devices which are still shutting down after SIGTERM print
+	// "Hit Ctrl-C again to abort immediately", so they get a SIGINT and
+	// this much time before SIGKILL
+	killSigintTimeout = 3*time.Second
+)
+
+// killStage tracks how far a task's kill sequence has progressed, so that
+// whoever observes the process exit can tell which stage ended it.
+type killStage int32
+
+const (
+	killStageNone killStage = iota // the process exited on its own
+	killStageTransition
+	killStageSigterm
+	killStageSigint
+	killStageSigkill
+)
+
+func (s killStage) String() string {
+	switch s {
+	case killStageTransition:
+		return "transition"
+	case killStageSigterm:
+		return "SIGTERM"
+	case killStageSigint:
+		return "SIGINT"
+	case killStageSigkill:
+		return "SIGKILL"
+	}
+	return "none"
+}
+
+type killTracker struct {
+	stage int32
+}
+
+func (k *killTracker) set(stage killStage) {
+	atomic.StoreInt32(&k.stage, int32(stage))
+}
+
+func (k *killTracker) get() killStage {
+	return killStage(atomic.LoadInt32(&k.stage))
+}
+
+// waitForExit blocks until done is closed or timeout elapses, and returns
+// true if the process exited. If there is no done channel, i.e. nobody is
+// waiting on the process, we fall back to polling the pid.
+func waitForExit(pid int, done <-chan struct{}, timeout time.Duration) bool {
+	if done != nil {
+		// An exit which already happened must win over a zero timeout
+		select {
+		case <-done:
+			return true
+		default:
+		}
+		if timeout <= 0 {
+			return false
+		}
+		select {
+		case <-done:
+			return true
+		case <-time.After(timeout):
+			return false
+		}
+	}
+	for elapsed := 0*time.Second; elapsed < timeout; elapsed += killPollingInterval {
+		if !pidExists(pid) {
+			return true
+		}
+		time.Sleep(killPollingInterval)
+	}
+	return !pidExists(pid)
+}
+
+// terminateProcess sends SIGTERM to pid and gives it sigtermGrace to exit,
+// then SIGINT and sigintGrace, and finally sends SIGKILL to the whole
+// process group pgid. It returns the stage that actually ended the process.
+func terminateProcess(pid int, pgid int, sigtermGrace time.Duration, sigintGrace time.Duration, done <-chan struct{}, tracker *killTracker, fields logrus.Fields) (killStage, error) {
+	if waitForExit(pid, done, 0) {
+		return tracker.get(), nil
+	}
+
+	tracker.set(killStageSigterm)
+	err := syscall.Kill(pid, syscall.SIGTERM)
+	if err != nil {
+		log.WithError(err).
+			WithFields(fields).
+			Warning("task SIGTERM failed")
+	}
+	if waitForExit(pid, done, sigtermGrace) {
+		return killStageSigterm, nil
+	}
+
+	log.WithFields(fields).
+		WithField("grace", sigtermGrace.String()).
+		Warning("task still running after SIGTERM grace period, sending SIGINT")
+
+	tracker.set(killStageSigint)
+	err = syscall.Kill(pid, syscall.SIGINT)
+	if err != nil {
+		log.WithError(err).
+			WithFields(fields).
+			Warning("task SIGINT failed")
+	}
+	if waitForExit(pid, done, sigintGrace) {
+		return killStageSigint, nil
+	}
+
+	log.WithFields(fields).
+		WithField("grace", sigintGrace.String()).
+		Warning("task still running after SIGINT grace period, sending SIGKILL to process group")
+
+	if pgid == 0 {
+		pgid = pid
+	} else if pgid > 0 {
+		pgid = -pgid
+	}
+	tracker.set(killStageSigkill)
+	err = syscall.Kill(pgid, syscall.SIGKILL)
+	if err != nil {
+		log.WithError(err).
+			WithFields(fields).
+			Warning("task SIGKILL failed")
+	}
+	return killStageSigkill, err
+}
+
+func killStageMessage(stage killStage) string {
+	if stage == killStageNone {
+		return ""
+	}
+	return "task ended by kill sequence stage " + stage.String()
+}
@@ -0,0 +1,155 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2021 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+
+package executable
+
+import (
+	"os"
+	"os/exec"
+	"os/signal"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// TestKillSequenceHelperProcess is not a test, but the child process used
+// by TestTerminateProcess, which ignores the signals listed in
+// O2_KILL_TEST_IGNORE.
+func TestKillSequenceHelperProcess(t *testing.T) {
+	ignore, ok := os.LookupEnv("O2_KILL_TEST_IGNORE")
+	if !ok {
+		return
+	}
+	for _, sig := range ignore {
+		switch sig {
+		case 'T':
+			signal.Ignore(syscall.SIGTERM)
+		case 'I':
+			signal.Ignore(syscall.SIGINT)
+		}
+	}
+	// tell the parent that the signal dispositions are in place
+	_, _ = os.Stdout.Write([]byte("ready\n"))
+	time.Sleep(time.Minute)
+	os.Exit(0)
+}
+
+// startKillTestChild starts a child in its own process group which ignores
+// the given signals, and returns it with a channel closed once it exited.
+func startKillTestChild(t *testing.T, ignore string) (*exec.Cmd, <-chan struct{}) {
+	t.Helper()
+	cmd := exec.Command(os.Args[0], "-test.run=^TestKillSequenceHelperProcess$")
+	cmd.Env = append(os.Environ(), "O2_KILL_TEST_IGNORE=" + ignore)
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = cmd.Start(); err != nil {
+		t.Fatal(err)
+	}
+	ready := make([]byte, len("ready\n"))
+	if _, err = stdout.Read(ready); err != nil {
+		t.Fatal(err)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		_ = cmd.Wait()
+		close(done)
+	}()
+	t.Cleanup(func() {
+		_ = syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+		<-done
+	})
+	return cmd, done
+}
+
+func TestTerminateProcess(t *testing.T) {
+	testCases := []struct {
+		name          string
+		ignore        string
+		expectedStage killStage
+		expectedTerm  syscall.Signal
+	}{
+		{"exits on SIGTERM", "", killStageSigterm, syscall.SIGTERM},
+		{"ignores SIGTERM", "T", killStageSigint, syscall.SIGINT},
+		{"ignores SIGTERM and SIGINT", "TI", killStageSigkill, syscall.SIGKILL},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cmd, done := startKillTestChild(t, tc.ignore)
+			tracker := &killTracker{}
+
+			stage, err := terminateProcess(cmd.Process.Pid, cmd.Process.Pid, 200*time.Millisecond, 200*time.Millisecond, done, tracker, logrus.Fields{})
+			if err != nil {
+				t.Errorf("unexpected error %s", err)
+			}
+			if stage != tc.expectedStage || tracker.get() != tc.expectedStage {
+				t.Errorf("expected stage %s, got %s (tracker %s)", tc.expectedStage, stage, tracker.get())
+			}
+
+			select {
+			case <-done:
+			case <-time.After(5*time.Second):
+				t.Fatal("child still running after the kill sequence")
+			}
+			status := cmd.ProcessState.Sys().(syscall.WaitStatus)
+			if !status.Signaled() || status.Signal() != tc.expectedTerm {
+				t.Errorf("expected the child to be ended by %s, got %s", tc.expectedTerm, cmd.ProcessState)
+			}
+		})
+	}
+}
+
+func TestTerminateProcessAlreadyExited(t *testing.T) {
+	cmd, done := startKillTestChild(t, "")
+	_ = cmd.Process.Kill()
+	<-done
+
+	tracker := &killTracker{}
+	tracker.set(killStageTransition)
+	stage, err := terminateProcess(cmd.Process.Pid, cmd.Process.Pid, time.Second, time.Second, done, tracker, logrus.Fields{})
+	if err != nil || stage != killStageTransition {
+		t.Errorf("expected the transition stage to be reported, got %s (%v)", stage, err)
+	}
+}
+
+func TestWaitForExitZeroTimeout(t *testing.T) {
+	done := make(chan struct{})
+	if waitForExit(0, done, 0) {
+		t.Error("expected a running process to be reported as running")
+	}
+	close(done)
+	// with both channels ready, select would pick one at random
+	for i := 0; i < 100; i++ {
+		if !waitForExit(0, done, 0) {
+			t.Fatal("expected an exited process to be reported as exited")
+		}
+	}
+}
@@ -0,0 +1,226 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2021 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+
+package executable
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/AliceO2Group/Control/common"
+	"github.com/AliceO2Group/Control/common/event"
+	pb "github.com/AliceO2Group/Control/executor/protos"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+)
+
+const (
+	startupInitialBackoff   = 100*time.Millisecond
+	startupMaxBackoff       = 5*time.Second
+	startupProgressInterval = 5*time.Second
+)
+
+// startupProgress reports the startup phase of a task to the core whenever
+// the phase changes, and every startupProgressInterval otherwise.
+type startupProgress struct {
+	t        *ControllableTask
+	started  time.Time
+	phase    string
+	lastSent time.Time
+}
+
+func newStartupProgress(t *ControllableTask) *startupProgress {
+	return &startupProgress{t: t, started: time.Now()}
+}
+
+func (p *startupProgress) report(phase string) {
+	if phase == p.phase && time.Since(p.lastSent) < startupProgressInterval {
+		return
+	}
+	p.phase = phase
+	p.lastSent = time.Now()
+
+	elapsed := time.Since(p.started)
+	log.WithFields(logrus.Fields{
+			"task":    p.t.ti.Name,
+			"phase":   phase,
+			"elapsed": elapsed.Truncate(time.Millisecond).String(),
+		}).
+		Debug("task starting")
+
+	progressEvent := event.NewTaskStartupProgressEvent(p.t.ti.TaskID.GetValue(), phase, int64(elapsed/time.Millisecond))
+	jsonEvent, err := json.Marshal(progressEvent)
+	if err != nil {
+		log.WithError(err).Warning("error marshaling startup progress event")
+		return
+	}
+	p.t.sendMessage(jsonEvent)
+}
+
+func nextBackoff(backoff time.Duration) time.Duration {
+	backoff *= 2
+	if backoff > startupMaxBackoff {
+		return startupMaxBackoff
+	}
+	return backoff
+}
+
+// waitForStandby blocks until the task reaches its STANDBY-equivalent state,
+// a terminal state, or the deadline. Where the OCC server supports it, we
+// subscribe to StateStream so that we hear of state changes right away,
+// otherwise we poll GetState with exponential backoff.
+func (t *ControllableTask) waitForStandby(deadline time.Time, progress *startupProgress) (reachedState string, err error) {
+	progress.report(event.StartupPhaseInitializing)
+
+	// A task which accepts the connection but never replies must not keep
+	// us past the deadline
+	cxt, cancel := context.WithDeadline(context.Background(), deadline)
+	defer cancel()
+
+	stateCh := make(chan string, 1)
+	var stream pb.Occ_StateStreamClient
+	var streamErr error
+	if t.rpc.Capabilities.StateStream {
+		stream, streamErr = t.rpc.StateStream(cxt, &pb.StateStreamRequest{}, grpc.EmptyCallOption{})
+	}
+	if streamErr == nil && stream != nil {
+		go func() {
+			for {
+				reply, err := stream.Recv()
+				if err != nil {
+					// io.EOF, codes.Unimplemented or cancelled, either way
+					// polling takes over
+					return
+				}
+				select {
+				case stateCh <- reply.GetState():
+				case <-cxt.Done():
+					return
+				}
+			}
+		}()
+	}
+
+	backoff := startupInitialBackoff
+	for {
+		response, err := t.rpc.GetState(cxt, &pb.GetStateRequest{}, grpc.EmptyCallOption{})
+		if err != nil {
+			log.WithError(err).
+				WithField("task", t.ti.Name).
+				Info("cannot query task status")
+		} else {
+			log.WithFields(logrus.Fields{
+					"state": response.GetState(),
+					"task":  t.ti.Name,
+				}).
+				Debug("task status queried")
+			t.knownPid = t.hostPid(int(response.GetPid()))
+		}
+		// NOTE: we acquire the transitioner-dependent STANDBY equivalent state
+		reachedState = t.rpc.FromDeviceState(response.GetState())
+
+		if reachedState == "STANDBY" && err == nil {
+			return reachedState, nil
+		} else if reachedState == "DONE" || reachedState == "ERROR" {
+			return reachedState, errors.New("task reached wrong state on startup")
+		}
+
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return reachedState, errors.New("timeout while waiting for task startup")
+		}
+		wait := backoff
+		if wait > remaining {
+			wait = remaining
+		}
+
+		select {
+		case state := <-stateCh:
+			log.WithField("task", t.ti.Name).
+				WithField("state", state).
+				Debug("task state change received from stream")
+		case <-time.After(wait):
+			backoff = nextBackoff(backoff)
+		}
+		progress.report(event.StartupPhaseInitializing)
+	}
+}
+
+// runReadinessProbe runs the readiness command of the task, if any, until
+// it exits with status 0 or the deadline passes. The command runs with the
+// same user and environment as the task itself, but always on the host,
+// outside of any isolation the task might be subject to.
+func (t *ControllableTask) runReadinessProbe(deadline time.Time, progress *startupProgress) error {
+	readiness := t.Tci.Startup.GetReadiness()
+	if len(readiness) == 0 {
+		return nil
+	}
+
+	shell := true
+	backoff := startupInitialBackoff
+	for {
+		progress.report(event.StartupPhaseProbing)
+
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return errors.New("timeout while waiting for task readiness probe to succeed")
+		}
+
+		probeCmd, err := prepareTaskCmd(&common.TaskCommandInfo{
+			CommandInfo: common.CommandInfo{
+				Env:   t.Tci.Env,
+				Shell: &shell,
+				Value: &readiness,
+				User:  t.Tci.User,
+			},
+			Timeout: remaining,
+		}, t.ti.TaskID.Value)
+		if err != nil {
+			return err
+		}
+		output, err := runProbeCommand(probeCmd, remaining)
+		if err == nil {
+			log.WithField("task", t.ti.Name).
+				Debug("task readiness probe succeeded")
+			return nil
+		}
+		log.WithError(err).
+			WithFields(logrus.Fields{
+				"task":    t.ti.Name,
+				"command": readiness,
+				"output":  string(output),
+			}).
+			Debug("task readiness probe failed")
+
+		wait := backoff
+		if wait > remaining {
+			wait = remaining
+		}
+		time.Sleep(wait)
+		backoff = nextBackoff(backoff)
+	}
+}
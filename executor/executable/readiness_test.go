@@ -0,0 +1,149 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2021 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+
+package executable
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/AliceO2Group/Control/common"
+	"github.com/AliceO2Group/Control/common/controlmode"
+	"github.com/AliceO2Group/Control/executor/executorcmd"
+	mesos "github.com/mesos/mesos-go/api/v1/lib"
+	"github.com/sirupsen/logrus"
+)
+
+// newStartingTask returns a task connected to server, as it is once its
+// control port answers.
+func newStartingTask(t *testing.T, server *fakeOccServer) *ControllableTask {
+	t.Helper()
+	port := serveFakeOcc(t, server)
+	task := &ControllableTask{
+		taskBase: taskBase{
+			ti: &mesos.TaskInfo{
+				Name:     "test-task",
+				TaskID:   mesos.TaskID{Value: "task-1"},
+				Executor: &mesos.ExecutorInfo{ExecutorID: mesos.ExecutorID{Value: "test-executor"}},
+			},
+			Tci:         &common.TaskCommandInfo{},
+			sendMessage: func([]byte) {},
+		},
+	}
+	task.rpc = executorcmd.NewClient(port, controlmode.DIRECT, executorcmd.ProtobufTransport, logrus.NewEntry(logrus.StandardLogger()))
+	if task.rpc == nil {
+		t.Fatal("cannot connect to fake OCC server")
+	}
+	t.Cleanup(func() { _ = task.rpc.Close() })
+	return task
+}
+
+func TestWaitForStandby(t *testing.T) {
+	testCases := []struct {
+		name          string
+		state         string
+		becomes       string // state the task moves to after a while, if any
+		timeout       time.Duration
+		expectedState string // not checked if empty
+		expectError   string
+	}{
+		{"already in STANDBY", "STANDBY", "", time.Second, "STANDBY", ""},
+		{"reaches STANDBY", "STARTING", "STANDBY", 5*time.Second, "STANDBY", ""},
+		{"stays in another state", "STARTING", "", 300*time.Millisecond, "", "timeout while waiting for task startup"},
+		{"reaches ERROR", "STARTING", "ERROR", 5*time.Second, "ERROR", "wrong state"},
+		{"reaches DONE", "DONE", "", 5*time.Second, "DONE", "wrong state"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := &fakeOccServer{pid: 1, state: tc.state}
+			task := newStartingTask(t, server)
+			if len(tc.becomes) > 0 {
+				timer := time.AfterFunc(200*time.Millisecond, func() { server.setState(tc.becomes) })
+				defer timer.Stop()
+			}
+
+			start := time.Now()
+			reachedState, err := task.waitForStandby(start.Add(tc.timeout), newStartupProgress(task))
+			if len(tc.expectedState) > 0 && reachedState != tc.expectedState {
+				t.Errorf("expected state %s, got %s", tc.expectedState, reachedState)
+			}
+			if len(tc.expectError) == 0 && err != nil {
+				t.Errorf("unexpected error %s", err)
+			}
+			if len(tc.expectError) > 0 && (err == nil || !strings.Contains(err.Error(), tc.expectError)) {
+				t.Errorf("expected error containing %q, got %v", tc.expectError, err)
+			}
+			if elapsed := time.Since(start); elapsed > tc.timeout + 2*time.Second {
+				t.Errorf("returned after %s, past the deadline", elapsed)
+			}
+		})
+	}
+}
+
+func TestRunReadinessProbe(t *testing.T) {
+	marker := filepath.Join(t.TempDir(), "ready")
+
+	testCases := []struct {
+		name        string
+		readiness   string
+		timeout     time.Duration
+		expectError bool
+	}{
+		{"no probe", "", time.Second, false},
+		{"probe succeeds", "true", time.Second, false},
+		{"probe succeeds after a while", "test -e " + marker, 5*time.Second, false},
+		{"probe keeps failing", "false", 300*time.Millisecond, true},
+		{"probe hangs", "sleep 30", 300*time.Millisecond, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			task := newStartingTask(t, &fakeOccServer{pid: 1, state: "STANDBY"})
+			task.Tci.Startup.Readiness = &tc.readiness
+			if strings.Contains(tc.readiness, marker) {
+				timer := time.AfterFunc(200*time.Millisecond, func() {
+					if err := ioutil.WriteFile(marker, nil, 0644); err != nil {
+						t.Error(err)
+					}
+				})
+				defer timer.Stop()
+			}
+
+			start := time.Now()
+			err := task.runReadinessProbe(start.Add(tc.timeout), newStartupProgress(task))
+			if tc.expectError != (err != nil) {
+				t.Errorf("unexpected error value: %v", err)
+			}
+			if elapsed := time.Since(start); elapsed > tc.timeout + 2*time.Second {
+				t.Errorf("returned after %s, past the deadline", elapsed)
+			}
+		})
+	}
+}
+
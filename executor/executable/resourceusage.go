@@ -0,0 +1,272 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2021 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+
+package executable
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/AliceO2Group/Control/common/event"
+	pb "github.com/AliceO2Group/Control/executor/protos"
+	"github.com/sirupsen/logrus"
+)
+
+// USER_HZ, the unit of the CPU times in /proc/[pid]/stat, is 100 on all
+// architectures we run on.
+const clockTicksPerSecond = 100
+
+var errProcessNotFound = errors.New("process not found")
+
+type procStat struct {
+	pid        int
+	ppid       int
+	cpuTicks   uint64
+	threads    int
+	rssPages   uint64
+	startTime  uint64 // clock ticks since boot, tells apart processes with a recycled pid
+}
+
+// readProcStat parses the fields we need from /proc/[pid]/stat, see proc(5).
+func readProcStat(pid int) (ps procStat, err error) {
+	data, err := ioutil.ReadFile(fmt.Sprintf("/proc/%d/stat", pid))
+	if err != nil {
+		return
+	}
+	// The command name (field 2) is in parentheses and may contain spaces,
+	// so we start parsing after the last closing parenthesis.
+	stat := string(data)
+	idx := strings.LastIndex(stat, ")")
+	if idx < 0 {
+		err = fmt.Errorf("cannot parse /proc/%d/stat", pid)
+		return
+	}
+	fields := strings.Fields(stat[idx+1:])
+	// fields[0] is field 3 (state), so field N is at fields[N-3]
+	if len(fields) < 22 {
+		err = fmt.Errorf("cannot parse /proc/%d/stat: too few fields", pid)
+		return
+	}
+	ps.pid = pid
+	ps.ppid, _ = strconv.Atoi(fields[1])
+	for _, i := range []int{11, 12, 13, 14} { // utime, stime, cutime, cstime
+		ticks, _ := strconv.ParseUint(fields[i], 10, 64)
+		ps.cpuTicks += ticks
+	}
+	ps.threads, _ = strconv.Atoi(fields[17])
+	ps.startTime, _ = strconv.ParseUint(fields[19], 10, 64)
+	ps.rssPages, _ = strconv.ParseUint(fields[21], 10, 64)
+	return
+}
+
+func readProcIO(pid int) (readBytes uint64, writeBytes uint64) {
+	f, err := os.Open(fmt.Sprintf("/proc/%d/io", pid))
+	if err != nil {
+		return
+	}
+	defer f.Close()
+	return parseProcIO(f)
+}
+
+// parseProcIO returns the bytes read from and written to storage according
+// to the contents of a /proc/[pid]/io file.
+func parseProcIO(r io.Reader) (readBytes uint64, writeBytes uint64) {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		kv := strings.SplitN(scanner.Text(), ":", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		value, _ := strconv.ParseUint(strings.TrimSpace(kv[1]), 10, 64)
+		switch kv[0] {
+		case "read_bytes":
+			readBytes = value
+		case "write_bytes":
+			writeBytes = value
+		}
+	}
+	return
+}
+
+func countOpenFds(pid int) int {
+	fds, err := ioutil.ReadDir(fmt.Sprintf("/proc/%d/fd", pid))
+	if err != nil {
+		return 0
+	}
+	return len(fds)
+}
+
+// procTable is a snapshot of all processes on the host.
+type procTable struct {
+	scannedAt time.Time
+	byPid     map[int]procStat
+	children  map[int][]procStat
+}
+
+func scanProcTable() (table *procTable, err error) {
+	entries, err := ioutil.ReadDir("/proc")
+	if err != nil {
+		return
+	}
+	table = &procTable{
+		scannedAt: time.Now(),
+		byPid:     make(map[int]procStat),
+		children:  make(map[int][]procStat),
+	}
+	for _, entry := range entries {
+		pid, convErr := strconv.Atoi(entry.Name())
+		if convErr != nil {
+			continue
+		}
+		ps, statErr := readProcStat(pid)
+		if statErr != nil {
+			continue // the process might have exited in the meantime
+		}
+		table.byPid[pid] = ps
+		table.children[ps.ppid] = append(table.children[ps.ppid], ps)
+	}
+	return
+}
+
+// procScanner shares /proc scans between the resource usage samplers of all
+// the tasks of an executor. Since each sampler accepts a table up to half its
+// interval old, /proc is read at most twice per interval, however many tasks
+// there are.
+type procScanner struct {
+	mu      sync.Mutex
+	current *procTable
+}
+
+var sharedProcScanner = &procScanner{}
+
+func (s *procScanner) table(maxAge time.Duration) (*procTable, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.current != nil && time.Since(s.current.scannedAt) < maxAge {
+		return s.current, nil
+	}
+	table, err := scanProcTable()
+	if err != nil {
+		return nil, err
+	}
+	s.current = table
+	return table, nil
+}
+
+// sampleProcessTree sums up the resource usage of rootPid and all of its
+// descendants.
+func sampleProcessTree(table *procTable, rootPid int) (usage event.ResourceUsage, err error) {
+	root, ok := table.byPid[rootPid]
+	if !ok {
+		err = errProcessNotFound
+		return
+	}
+
+	pageSize := uint64(os.Getpagesize())
+	var cpuTicks uint64
+	queue := []procStat{root}
+	for len(queue) > 0 {
+		ps := queue[0]
+		queue = append(queue[1:], table.children[ps.pid]...)
+
+		usage.Processes++
+		cpuTicks += ps.cpuTicks
+		usage.Threads += ps.threads
+		usage.RssBytes += ps.rssPages * pageSize
+		usage.OpenFds += countOpenFds(ps.pid)
+		readBytes, writeBytes := readProcIO(ps.pid)
+		usage.ReadBytes += readBytes
+		usage.WriteBytes += writeBytes
+	}
+	usage.CpuSeconds = float64(cpuTicks) / clockTicksPerSecond
+	usage.SampledAt = table.scannedAt
+	return
+}
+
+// monitorResourceUsage periodically samples the process tree of rootPid and
+// sends the result to the core as a TASK_RESOURCE_USAGE device event, until
+// done is closed.
+func (t *taskBase) monitorResourceUsage(rootPid int, done <-chan struct{}) {
+	interval := t.Tci.ResourceSamplingInterval
+	if interval <= 0 {
+		return
+	}
+	deo := event.DeviceEventOrigin{
+		AgentId:    t.ti.AgentID,
+		ExecutorId: t.ti.GetExecutor().ExecutorID,
+		TaskId:     t.ti.TaskID,
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var previous *event.ResourceUsage
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+		}
+
+		table, err := sharedProcScanner.table(interval / 2)
+		var usage event.ResourceUsage
+		if err == nil {
+			usage, err = sampleProcessTree(table, rootPid)
+		}
+		if err == errProcessNotFound {
+			return
+		}
+		if err != nil {
+			log.WithError(err).
+				WithFields(logrus.Fields{
+					"task": t.ti.Name,
+					"pid":  rootPid,
+				}).
+				Debug("cannot sample task resource usage")
+			continue
+		}
+		if previous != nil {
+			elapsed := usage.SampledAt.Sub(previous.SampledAt).Seconds()
+			if elapsed > 0 && usage.CpuSeconds >= previous.CpuSeconds {
+				usage.CpuPercent = 100 * (usage.CpuSeconds - previous.CpuSeconds) / elapsed
+			}
+		}
+		previous = &usage
+
+		deviceEvent := event.NewDeviceEvent(deo, pb.DeviceEventType_TASK_RESOURCE_USAGE)
+		if tru, ok := deviceEvent.(*event.TaskResourceUsage); ok {
+			tru.Usage = usage
+			t.sendDeviceEvent(tru)
+		}
+	}
+}
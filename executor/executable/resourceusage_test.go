@@ -0,0 +1,131 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2021 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+
+package executable
+
+import (
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestReadProcStat(t *testing.T) {
+	ps, err := readProcStat(os.Getpid())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ps.pid != os.Getpid() || ps.ppid != os.Getppid() {
+		t.Errorf("expected pid %d and ppid %d, got %d and %d", os.Getpid(), os.Getppid(), ps.pid, ps.ppid)
+	}
+	if ps.threads < 1 || ps.rssPages == 0 || ps.startTime == 0 {
+		t.Errorf("implausible stat of the test process: %+v", ps)
+	}
+}
+
+func TestParseProcIO(t *testing.T) {
+	const procIO = `rchar: 323934931
+wchar: 323929600
+syscr: 632687
+syscw: 632675
+read_bytes: 4096
+write_bytes: 323932160
+cancelled_write_bytes: 0
+`
+	readBytes, writeBytes := parseProcIO(strings.NewReader(procIO))
+	if readBytes != 4096 || writeBytes != 323932160 {
+		t.Errorf("expected 4096 bytes read and 323932160 written, got %d and %d", readBytes, writeBytes)
+	}
+
+	readBytes, writeBytes = parseProcIO(strings.NewReader("garbage\nread_bytes: x\n"))
+	if readBytes != 0 || writeBytes != 0 {
+		t.Errorf("expected nothing from garbage, got %d and %d", readBytes, writeBytes)
+	}
+}
+
+func TestSampleProcessTree(t *testing.T) {
+	child := exec.Command("sleep", "30")
+	if err := child.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = child.Process.Kill()
+		_ = child.Wait()
+	}()
+
+	table, err := scanProcTable()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	usage, err := sampleProcessTree(table, os.Getpid())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if usage.Processes < 2 {
+		t.Errorf("expected the test process and its child, got %d processes", usage.Processes)
+	}
+	if usage.Threads < 2 || usage.RssBytes == 0 || usage.OpenFds == 0 {
+		t.Errorf("implausible usage of the test process tree: %+v", usage)
+	}
+	if !usage.SampledAt.Equal(table.scannedAt) {
+		t.Errorf("expected the sample to be dated from the scan")
+	}
+
+	usage, err = sampleProcessTree(table, child.Process.Pid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if usage.Processes != 1 {
+		t.Errorf("expected the child alone, got %d processes", usage.Processes)
+	}
+
+	if _, err = sampleProcessTree(table, -1); err != errProcessNotFound {
+		t.Errorf("expected errProcessNotFound, got %v", err)
+	}
+}
+
+func TestProcScannerSharesScans(t *testing.T) {
+	scanner := &procScanner{}
+	first, err := scanner.table(time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := scanner.table(time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first != second {
+		t.Error("expected a recent scan to be shared")
+	}
+	third, err := scanner.table(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if third == first {
+		t.Error("expected a new scan once the previous one is too old")
+	}
+}
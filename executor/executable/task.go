@@ -34,7 +34,6 @@ import (
 	"strconv"
 	"strings"
 	"syscall"
-	"time"
 
 	"github.com/AliceO2Group/Control/common"
 	"github.com/AliceO2Group/Control/common/controlmode"
@@ -46,11 +45,6 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
-const(
-	startupPollingInterval = 500 * time.Millisecond
-	startupTimeout         = 30 * time.Second
-)
-
 var log = logger.New(logrus.StandardLogger(), "executor")
 
 type SendStatusFunc func(state mesos.TaskState, message string)
@@ -143,28 +137,55 @@ func NewTask(taskInfo mesos.TaskInfo, sendStatusFunc SendStatusFunc, sendDeviceE
 	return newTask
 }
 
-func prepareTaskCmd(commandInfo *common.TaskCommandInfo) (*exec.Cmd, error) {
+// sandboxDir returns the Mesos sandbox of the executor, i.e. its working
+// directory unless MESOS_SANDBOX says otherwise
+func sandboxDir() string {
+	sandbox := os.Getenv("MESOS_SANDBOX")
+	if len(sandbox) == 0 {
+		sandbox, _ = os.Getwd()
+	}
+	return sandbox
+}
+
+func prepareTaskCmd(commandInfo *common.TaskCommandInfo, taskId string) (*exec.Cmd, error) {
 	var taskCmd *exec.Cmd
 	ctx := context.Background()
 	if commandInfo.Timeout.Seconds() > 0 { // if a timeout is defined, we add a context
 		ctx, _ = context.WithTimeout(ctx, commandInfo.Timeout)
 	}
 
+	var argv []string
 	if *commandInfo.Shell {
 		rawCommand := strings.Join(append([]string{*commandInfo.Value}, commandInfo.Arguments...), " ")
-		taskCmd = exec.CommandContext(ctx, "/bin/sh", []string{"-c", rawCommand}...)
+		argv = []string{"/bin/sh", "-c", rawCommand}
 	} else {
-		taskCmd = exec.CommandContext(ctx, *commandInfo.Value, commandInfo.Arguments...)
+		argv = append([]string{*commandInfo.Value}, commandInfo.Arguments...)
 	}
+
+	// If the task must be isolated, the command is wrapped in an invocation of
+	// systemd-run or of a container runtime
+	setCredential := true
+	if commandInfo.Isolation.IsEnabled() {
+		var err error
+		argv, setCredential, err = isolateTaskCommand(commandInfo, taskId, argv)
+		if err != nil {
+			return nil, err
+		}
+	}
+	taskCmd = exec.CommandContext(ctx, argv[0], argv[1:]...)
 	taskCmd.Env = append(os.Environ(), commandInfo.Env...)
 
 	// We must setpgid(2) in order to be able to kill the whole process group which consists of
 	// the containing shell and all of its children
 	taskCmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
-	setPdeathsig(taskCmd.SysProcAttr)
+	// An adoptable task must survive its executor, otherwise it goes down
+	// along with it
+	if !commandInfo.Adoptable {
+		setPdeathsig(taskCmd.SysProcAttr)
+	}
 
 	// If the commandInfo specifies a username
-	if commandInfo.User != nil && len(*commandInfo.User) > 0 {
+	if setCredential && commandInfo.User != nil && len(*commandInfo.User) > 0 {
 		// we must first look up the uid/gid
 		targetUser, err := user.Lookup(*commandInfo.User)
 		if err != nil {
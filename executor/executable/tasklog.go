@@ -0,0 +1,571 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2021 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+
+package executable
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	taskLogDirName         = "tasklogs"
+	defaultTaskLogMaxSize  = 10*1024*1024
+	defaultTaskLogMaxFiles = 5
+	// upper bound on the amount of data returned by a single follow read
+	taskLogReadChunkSize   = 256*1024
+	// upper bound on the amount of data returned by a tail, which must fit
+	// in a Mesos message as well as in a gRPC message of the core
+	taskLogTailMaxSize     = 1024*1024
+	// how often the size of the logs of adoptable tasks is checked
+	plainTaskLogRotationInterval = 5*time.Second
+)
+
+const (
+	TaskLogStdout = "stdout"
+	TaskLogStderr = "stderr"
+)
+
+// rotatingLogFile is an io.Writer which appends to a file in the Mesos
+// sandbox and rotates it once it grows beyond maxSize, keeping at most
+// maxFiles files (the current one plus maxFiles-1 numbered backups).
+type rotatingLogFile struct {
+	mu       sync.Mutex
+	path     string
+	maxSize  int64
+	maxFiles int
+
+	file     *os.File
+	closed   bool
+	size     int64 // size of the current file
+	written  int64 // total bytes ever written, used as a cursor by readers
+}
+
+func openRotatingLogFile(path string, maxSize int64, maxFiles int) (*rotatingLogFile, error) {
+	if maxSize <= 0 {
+		maxSize = defaultTaskLogMaxSize
+	}
+	if maxFiles <= 0 {
+		maxFiles = defaultTaskLogMaxFiles
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+	if err != nil {
+		return nil, err
+	}
+	return &rotatingLogFile{
+		path:     path,
+		maxSize:  maxSize,
+		maxFiles: maxFiles,
+		file:     f,
+	}, nil
+}
+
+func (r *rotatingLogFile) backupPath(i int) string {
+	return fmt.Sprintf("%s.%d", r.path, i)
+}
+
+// rotate must be called with r.mu held
+func (r *rotatingLogFile) rotate() error {
+	if r.file != nil {
+		_ = r.file.Close()
+		r.file = nil
+	}
+	_ = os.Remove(r.backupPath(r.maxFiles - 1))
+	for i := r.maxFiles - 2; i >= 1; i-- {
+		_ = os.Rename(r.backupPath(i), r.backupPath(i + 1))
+	}
+	if r.maxFiles > 1 {
+		if err := os.Rename(r.path, r.backupPath(1)); err != nil {
+			return err
+		}
+	}
+
+	f, err := os.OpenFile(r.path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	r.file = f
+	r.size = 0
+	return nil
+}
+
+func (r *rotatingLogFile) Write(p []byte) (n int, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.closed {
+		return 0, errors.New("log file closed")
+	}
+	if r.file == nil {
+		// A previous rotation failed half way, we try to start over
+		f, err := os.OpenFile(r.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+		if err != nil {
+			return 0, err
+		}
+		fi, err := f.Stat()
+		if err != nil {
+			_ = f.Close()
+			return 0, err
+		}
+		r.file = f
+		r.size = fi.Size()
+	}
+	if r.size > 0 && r.size + int64(len(p)) > r.maxSize {
+		if err = r.rotate(); err != nil {
+			return 0, err
+		}
+	}
+	n, err = r.file.Write(p)
+	r.size += int64(n)
+	r.written += int64(n)
+	return
+}
+
+func (r *rotatingLogFile) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.closed = true
+	if r.file == nil {
+		return nil
+	}
+	err := r.file.Close()
+	r.file = nil
+	return err
+}
+
+// Tail returns the last `lines` lines written to the log, looking into
+// rotated files if needed, as well as a cursor for subsequent ReadFrom calls.
+func (r *rotatingLogFile) Tail(lines int) (data []byte, cursor int64, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	cursor = r.written
+	if lines <= 0 {
+		return nil, cursor, nil
+	}
+	data, err = tailLogFiles(r.path, lines)
+	return data, cursor, err
+}
+
+// tailLogFiles returns the last `lines` lines of the log at path, looking
+// into its rotated files if needed, but at most taskLogTailMaxSize bytes.
+func tailLogFiles(path string, lines int) ([]byte, error) {
+	var buf []byte
+	for i := 0; ; i++ {
+		filePath := path
+		if i > 0 {
+			filePath = fmt.Sprintf("%s.%d", path, i)
+		}
+		content, err := ioutil.ReadFile(filePath)
+		if os.IsNotExist(err) {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		buf = append(content, buf...)
+		if bytes.Count(buf, []byte{'\n'}) > lines || len(buf) >= taskLogTailMaxSize {
+			break
+		}
+	}
+
+	buf = lastLines(buf, lines)
+	if len(buf) > taskLogTailMaxSize {
+		// We cut at a line boundary if we can
+		buf = buf[len(buf) - taskLogTailMaxSize:]
+		if idx := bytes.IndexByte(buf, '\n'); idx >= 0 && idx < len(buf) - 1 {
+			buf = buf[idx + 1:]
+		}
+	}
+	return buf, nil
+}
+
+// ReadFrom returns whatever was written to the log since cursor, up to
+// taskLogReadChunkSize bytes, and the cursor to pass to the next call.
+// If the data at cursor was already rotated away, reading resumes at the
+// start of the current file.
+func (r *rotatingLogFile) ReadFrom(cursor int64) (data []byte, next int64, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if cursor >= r.written || cursor < 0 {
+		return nil, r.written, nil
+	}
+	fileStart := r.written - r.size
+	if cursor < fileStart {
+		cursor = fileStart
+	}
+
+	f, err := os.Open(r.path)
+	if err != nil {
+		return nil, cursor, err
+	}
+	defer f.Close()
+
+	length := r.written - cursor
+	if length > taskLogReadChunkSize {
+		length = taskLogReadChunkSize
+	}
+	data = make([]byte, length)
+	n, err := f.ReadAt(data, cursor - fileStart)
+	if err != nil && err != io.EOF {
+		return nil, cursor, err
+	}
+	return data[:n], cursor + int64(n), nil
+}
+
+func lastLines(buf []byte, lines int) []byte {
+	trimmed := bytes.TrimSuffix(buf, []byte{'\n'})
+	idx := len(trimmed)
+	for i := 0; i < lines; i++ {
+		idx = bytes.LastIndexByte(trimmed[:idx], '\n')
+		if idx < 0 {
+			return buf
+		}
+	}
+	return buf[idx + 1:]
+}
+
+// taskLogs holds the stdout and stderr log files of a task
+type taskLogs struct {
+	stdout *rotatingLogFile
+	stderr *rotatingLogFile
+}
+
+var (
+	taskLogRegistryMu sync.RWMutex
+	taskLogRegistry   = make(map[string]*taskLogs)
+	// log directories of running adoptable tasks, which may be in the
+	// sandbox of a previous executor instance
+	plainTaskLogDirs  = make(map[string]string)
+)
+
+func taskLogDir() string {
+	return filepath.Join(sandboxDir(), taskLogDirName)
+}
+
+// openTaskLogs creates the stdout and stderr log files for the task in the
+// Mesos sandbox. The files stay readable through ReadTaskLogs for the
+// lifetime of the sandbox, also after the task is gone.
+func (t *taskBase) openTaskLogs() (logs *taskLogs, err error) {
+	dir := taskLogDir()
+	if err = os.MkdirAll(dir, 0755); err != nil {
+		return
+	}
+
+	taskId := t.ti.TaskID.GetValue()
+	logs = &taskLogs{}
+	logs.stdout, err = openRotatingLogFile(filepath.Join(dir, taskId + "." + TaskLogStdout), t.Tci.LogMaxSize, t.Tci.LogMaxFiles)
+	if err != nil {
+		return nil, err
+	}
+	logs.stderr, err = openRotatingLogFile(filepath.Join(dir, taskId + "." + TaskLogStderr), t.Tci.LogMaxSize, t.Tci.LogMaxFiles)
+	if err != nil {
+		_ = logs.stdout.Close()
+		return nil, err
+	}
+
+	taskLogRegistryMu.Lock()
+	taskLogRegistry[taskId] = logs
+	taskLogRegistryMu.Unlock()
+	return
+}
+
+// taskLogWriters returns the writers to which the task's stdout and stderr
+// should be copied, or ioutil.Discard if the log files cannot be created.
+// Once the task output ends, closeFunc closes the files, which stay readable
+// through ReadTaskLogs.
+func (t *taskBase) taskLogWriters() (stdout io.Writer, stderr io.Writer, closeFunc func()) {
+	logs, err := t.openTaskLogs()
+	if err != nil {
+		log.WithError(err).
+			WithField("task", t.ti.Name).
+			Warning("cannot create task log files in sandbox, output will not be retrievable")
+		return ioutil.Discard, ioutil.Discard, func() {}
+	}
+	taskId := t.ti.TaskID.GetValue()
+	stdout = &taskLogErrorWriter{w: logs.stdout, task: t.ti.Name, stream: TaskLogStdout}
+	stderr = &taskLogErrorWriter{w: logs.stderr, task: t.ti.Name, stream: TaskLogStderr}
+	return stdout, stderr, func() {
+		taskLogRegistryMu.Lock()
+		delete(taskLogRegistry, taskId)
+		taskLogRegistryMu.Unlock()
+		_ = logs.stdout.Close()
+		_ = logs.stderr.Close()
+	}
+}
+
+// taskLogErrorWriter never fails, so that a full disk or a failed rotation
+// doesn't stop the copy from the task's pipe, which would block the task
+// as soon as the pipe buffer is full. Errors are logged instead, once for
+// every streak of failed writes.
+type taskLogErrorWriter struct {
+	w       io.Writer
+	task    string
+	stream  string
+	failing bool
+}
+
+func (l *taskLogErrorWriter) Write(p []byte) (int, error) {
+	_, err := l.w.Write(p)
+	if err != nil && !l.failing {
+		log.WithError(err).
+			WithField("task", l.task).
+			WithField("stream", l.stream).
+			Warning("cannot write task log file, output is being dropped")
+	}
+	l.failing = err != nil
+	return len(p), nil
+}
+
+// taskOutputBuffer keeps the last max bytes written to it.
+type taskOutputBuffer struct {
+	buf bytes.Buffer
+	max int
+}
+
+func newTaskOutputBuffer(max int64) *taskOutputBuffer {
+	if max <= 0 {
+		max = defaultTaskLogMaxSize
+	}
+	return &taskOutputBuffer{max: int(max)}
+}
+
+func (b *taskOutputBuffer) Write(p []byte) (int, error) {
+	n := len(p)
+	if len(p) >= b.max {
+		b.buf.Reset()
+		p = p[len(p) - b.max:]
+	} else if excess := b.buf.Len() + len(p) - b.max; excess > 0 {
+		b.buf.Next(excess)
+	}
+	b.buf.Write(p)
+	return n, nil
+}
+
+func (b *taskOutputBuffer) String() string {
+	return b.buf.String()
+}
+
+// openPlainTaskLogs creates the stdout and stderr log files for an adoptable
+// task. The task writes to them directly rather than through the executor,
+// so that it isn't left with broken pipes if the executor goes away.
+// The files are opened in append mode, so that rotatePlainTaskLogs can
+// truncate them under the task's feet.
+func (t *taskBase) openPlainTaskLogs() (stdout *os.File, stderr *os.File, err error) {
+	dir := taskLogDir()
+	if err = os.MkdirAll(dir, 0755); err != nil {
+		return
+	}
+
+	taskId := t.ti.TaskID.GetValue()
+	stdout, err = os.OpenFile(filepath.Join(dir, taskId + "." + TaskLogStdout), os.O_CREATE|os.O_WRONLY|os.O_TRUNC|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, nil, err
+	}
+	stderr, err = os.OpenFile(filepath.Join(dir, taskId + "." + TaskLogStderr), os.O_CREATE|os.O_WRONLY|os.O_TRUNC|os.O_APPEND, 0644)
+	if err != nil {
+		_ = stdout.Close()
+		return nil, nil, err
+	}
+	return
+}
+
+// rotatePlainTaskLogs keeps the logs of an adoptable task in dir within the
+// task's LogMaxSize and LogMaxFiles until done is closed.
+func (t *taskBase) rotatePlainTaskLogs(dir string, done <-chan struct{}) {
+	taskId := t.ti.TaskID.GetValue()
+	taskLogRegistryMu.Lock()
+	plainTaskLogDirs[taskId] = dir
+	taskLogRegistryMu.Unlock()
+	defer func() {
+		taskLogRegistryMu.Lock()
+		delete(plainTaskLogDirs, taskId)
+		taskLogRegistryMu.Unlock()
+	}()
+
+	failing := false
+	ticker := time.NewTicker(plainTaskLogRotationInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+		}
+		var err error
+		for _, stream := range []string{TaskLogStdout, TaskLogStderr} {
+			path := filepath.Join(dir, taskId + "." + stream)
+			if rotErr := rotatePlainTaskLog(path, t.Tci.LogMaxSize, t.Tci.LogMaxFiles); rotErr != nil {
+				err = rotErr
+			}
+		}
+		if err != nil && !failing {
+			log.WithError(err).
+				WithField("task", t.ti.Name).
+				Warning("cannot rotate task log files")
+		}
+		failing = err != nil
+	}
+}
+
+// rotatePlainTaskLog rotates a log file which a task writes to directly, if
+// it grew beyond maxSize. Since we can't make the task reopen the file, we
+// copy it to the first backup and truncate it, so whatever the task writes
+// in between is lost.
+func rotatePlainTaskLog(path string, maxSize int64, maxFiles int) error {
+	if maxSize <= 0 {
+		maxSize = defaultTaskLogMaxSize
+	}
+	if maxFiles <= 0 {
+		maxFiles = defaultTaskLogMaxFiles
+	}
+	fi, err := os.Stat(path)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if fi.Size() <= maxSize {
+		return nil
+	}
+
+	backupPath := func(i int) string {
+		return fmt.Sprintf("%s.%d", path, i)
+	}
+	_ = os.Remove(backupPath(maxFiles - 1))
+	for i := maxFiles - 2; i >= 1; i-- {
+		_ = os.Rename(backupPath(i), backupPath(i + 1))
+	}
+	if maxFiles > 1 {
+		if err = copyFile(path, backupPath(1)); err != nil {
+			return err
+		}
+	}
+	return os.Truncate(path, 0)
+}
+
+func copyFile(src string, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	if _, err = io.Copy(out, in); err != nil {
+		_ = out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+// readPlainTaskLog reads a task log file which is not written through the
+// executor, in which case the cursor is simply an offset in the file. If
+// the file shrank below the cursor, it was rotated and reading resumes at
+// its start.
+func readPlainTaskLog(path string, tail int, cursor int64) (data []byte, next int64, err error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer f.Close()
+	fi, err := f.Stat()
+	if err != nil {
+		return nil, 0, err
+	}
+	size := fi.Size()
+
+	if tail > 0 {
+		data, err = tailLogFiles(path, tail)
+		return data, size, err
+	}
+	if cursor > size {
+		cursor = 0
+	}
+	if cursor == size || cursor < 0 {
+		return nil, size, nil
+	}
+	length := size - cursor
+	if length > taskLogReadChunkSize {
+		length = taskLogReadChunkSize
+	}
+	data = make([]byte, length)
+	n, err := f.ReadAt(data, cursor)
+	if err != nil && err != io.EOF {
+		return nil, cursor, err
+	}
+	return data[:n], cursor + int64(n), nil
+}
+
+// ReadTaskLogs returns the output of a task launched by this executor, or
+// adopted by it.
+// If tail > 0 the last tail lines are returned, otherwise the data written
+// since cursor. The returned cursor can be used to follow the output.
+func ReadTaskLogs(taskId string, stream string, tail int, cursor int64) (data []byte, next int64, err error) {
+	stream = strings.ToLower(stream)
+	if stream == "" {
+		stream = TaskLogStdout
+	}
+	if stream != TaskLogStdout && stream != TaskLogStderr {
+		return nil, 0, fmt.Errorf("unknown log stream %s", stream)
+	}
+
+	taskLogRegistryMu.RLock()
+	logs, ok := taskLogRegistry[taskId]
+	dir, isPlain := plainTaskLogDirs[taskId]
+	taskLogRegistryMu.RUnlock()
+	if !ok {
+		// adoptable and adopted tasks write their logs straight to disk
+		if !isPlain {
+			dir = taskLogDir()
+		}
+		path := filepath.Join(dir, taskId + "." + stream)
+		if _, statErr := os.Stat(path); statErr != nil {
+			return nil, 0, fmt.Errorf("no logs for task %s", taskId)
+		}
+		return readPlainTaskLog(path, tail, cursor)
+	}
+
+	logFile := logs.stdout
+	if stream == TaskLogStderr {
+		logFile = logs.stderr
+	}
+
+	if tail > 0 {
+		return logFile.Tail(tail)
+	}
+	return logFile.ReadFrom(cursor)
+}
@@ -0,0 +1,243 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2021 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+
+package executable
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func readLogFile(t *testing.T, path string) string {
+	t.Helper()
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("cannot read %s: %v", path, err)
+	}
+	return string(data)
+}
+
+func TestRotatingLogFileRotatesBySize(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "task.stdout")
+	r, err := openRotatingLogFile(path, 10, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+
+	for _, line := range []string{"aaaa\n", "bbbb\n", "cccc\n"} {
+		if _, err := r.Write([]byte(line)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if got := readLogFile(t, path); got != "cccc\n" {
+		t.Errorf("current file = %q, want %q", got, "cccc\n")
+	}
+	if got := readLogFile(t, path + ".1"); got != "aaaa\nbbbb\n" {
+		t.Errorf("first backup = %q, want %q", got, "aaaa\nbbbb\n")
+	}
+}
+
+func TestRotatingLogFileKeepsMaxFiles(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "task.stdout")
+	r, err := openRotatingLogFile(path, 5, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+
+	for _, line := range []string{"0000\n", "1111\n", "2222\n", "3333\n", "4444\n"} {
+		if _, err := r.Write([]byte(line)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 3 {
+		t.Errorf("%d files kept, want 3", len(files))
+	}
+	for path, want := range map[string]string{
+		path:        "4444\n",
+		path + ".1": "3333\n",
+		path + ".2": "2222\n",
+	} {
+		if got := readLogFile(t, path); got != want {
+			t.Errorf("%s = %q, want %q", filepath.Base(path), got, want)
+		}
+	}
+	if _, err := os.Stat(path + ".3"); !os.IsNotExist(err) {
+		t.Errorf("%s.3 should have been removed", filepath.Base(path))
+	}
+}
+
+func TestRotatingLogFileTailAndReadFrom(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "task.stdout")
+	r, err := openRotatingLogFile(path, 10, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+
+	for _, line := range []string{"aaaa\n", "bbbb\n", "cccc\n"} {
+		_, _ = r.Write([]byte(line))
+	}
+
+	// The tail spans the current file and the first backup
+	data, cursor, err := r.Tail(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "bbbb\ncccc\n" {
+		t.Errorf("Tail(2) = %q", data)
+	}
+	if cursor != 15 {
+		t.Errorf("cursor = %d, want 15", cursor)
+	}
+
+	_, _ = r.Write([]byte("dddd\n"))
+	data, next, err := r.ReadFrom(cursor)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "dddd\n" || next != 20 {
+		t.Errorf("ReadFrom(15) = %q, %d", data, next)
+	}
+}
+
+func TestTaskLogErrorWriterNeverFails(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "task.stdout")
+	r, err := openRotatingLogFile(path, 10, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = r.Close()
+
+	w := &taskLogErrorWriter{w: r, task: "test", stream: TaskLogStdout}
+	n, err := w.Write([]byte("lost\n"))
+	if err != nil || n != 5 {
+		t.Errorf("Write = %d, %v, want 5, nil", n, err)
+	}
+}
+
+func TestTaskOutputBufferKeepsTail(t *testing.T) {
+	b := newTaskOutputBuffer(8)
+	_, _ = b.Write([]byte("0123"))
+	_, _ = b.Write([]byte("4567"))
+	_, _ = b.Write([]byte("89"))
+	if got := b.String(); got != "23456789" {
+		t.Errorf("buffer = %q, want %q", got, "23456789")
+	}
+	_, _ = b.Write(bytes.Repeat([]byte("x"), 20))
+	if got := b.String(); got != strings.Repeat("x", 8) {
+		t.Errorf("buffer = %q after an oversized write", got)
+	}
+}
+
+func TestRotatePlainTaskLogCopiesAndTruncates(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "task.stdout")
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC|os.O_APPEND, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	write := func(s string) {
+		if _, err := f.WriteString(s); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("aaaa\n")
+	if err := rotatePlainTaskLog(path, 10, 3); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(path + ".1"); !os.IsNotExist(err) {
+		t.Errorf("log rotated before reaching max size")
+	}
+
+	write("bbbb\ncccc\n")
+	if err := rotatePlainTaskLog(path, 10, 3); err != nil {
+		t.Fatal(err)
+	}
+	if got := readLogFile(t, path + ".1"); got != "aaaa\nbbbb\ncccc\n" {
+		t.Errorf("unexpected backup content %q", got)
+	}
+	if got := readLogFile(t, path); got != "" {
+		t.Errorf("log not truncated, got %q", got)
+	}
+
+	// The task keeps writing to the same descriptor, at the start of the
+	// truncated file
+	write("dddd\n")
+	if got := readLogFile(t, path); got != "dddd\n" {
+		t.Errorf("unexpected content after truncation %q", got)
+	}
+
+	for _, line := range []string{"eeee\neeee\neeee\n", "ffff\nffff\nffff\n"} {
+		write(line)
+		if err := rotatePlainTaskLog(path, 10, 3); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := os.Stat(path + ".3"); !os.IsNotExist(err) {
+		t.Errorf("more than maxFiles log files kept")
+	}
+	if got := readLogFile(t, path + ".2"); !strings.HasPrefix(got, "dddd\n") {
+		t.Errorf("unexpected second backup content %q", got)
+	}
+}
+
+func TestReadPlainTaskLogFollowsTruncation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "task.stdout")
+	if err := ioutil.WriteFile(path, []byte("one\ntwo\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	data, cursor, err := readPlainTaskLog(path, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "one\ntwo\n" || cursor != 8 {
+		t.Fatalf("unexpected read %q at %d", data, cursor)
+	}
+
+	if err := ioutil.WriteFile(path, []byte("new\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	data, cursor, err = readPlainTaskLog(path, 0, cursor)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "new\n" || cursor != 4 {
+		t.Errorf("read after truncation returned %q at %d", data, cursor)
+	}
+}
@@ -0,0 +1,102 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2021 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package executable
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// A basic or hook task can hand structured results back to the core by
+// printing lines such as
+//   __o2output__:calib.path=/tmp/calib-1234.root
+// on stdout, or by ending its stdout with a JSON object, e.g.
+//   {"calib.path": "/tmp/calib-1234.root", "calib.entries": 1024}
+// Marker lines win over the JSON trailer in case of duplicate keys.
+const (
+	taskOutputPrefix     = "__o2output__:"
+	taskOutputMaxLineLen = 1024*1024
+)
+
+// parseTaskOutputs extracts the structured outputs from the stdout of a
+// basic task. Non-string JSON values are passed on in their JSON encoding.
+// If stdout can't be scanned to the end, e.g. because of a line longer than
+// taskOutputMaxLineLen, the outputs found before that point are returned
+// along with the error, and the trailer is ignored.
+func parseTaskOutputs(stdout string) (outputs map[string]string, err error) {
+	outputs = make(map[string]string)
+	markers := make(map[string]string)
+	lastLine := ""
+
+	scanner := bufio.NewScanner(strings.NewReader(stdout))
+	scanner.Buffer(make([]byte, 64*1024), taskOutputMaxLineLen)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if len(line) == 0 {
+			continue
+		}
+		lastLine = line
+		if !strings.HasPrefix(line, taskOutputPrefix) {
+			continue
+		}
+		split := strings.SplitN(strings.TrimPrefix(line, taskOutputPrefix), "=", 2)
+		if len(split) != 2 || !isValidOutputKey(strings.TrimSpace(split[0])) {
+			log.WithField("line", line).
+				Warning("ignoring malformed task output line")
+			continue
+		}
+		markers[strings.TrimSpace(split[0])] = split[1]
+	}
+	if err = scanner.Err(); err != nil {
+		err = fmt.Errorf("cannot read task outputs: %w", err)
+		lastLine = ""
+	}
+
+	if strings.HasPrefix(lastLine, "{") {
+		trailer := make(map[string]interface{})
+		if jsonErr := json.Unmarshal([]byte(lastLine), &trailer); jsonErr == nil {
+			for k, v := range trailer {
+				if !isValidOutputKey(k) {
+					continue
+				}
+				if s, ok := v.(string); ok {
+					outputs[k] = s
+				} else if encoded, err := json.Marshal(v); err == nil {
+					outputs[k] = string(encoded)
+				}
+			}
+		}
+	}
+	for k, v := range markers {
+		outputs[k] = v
+	}
+	return
+}
+
+func isValidOutputKey(key string) bool {
+	return len(key) > 0 && !strings.ContainsAny(key, " \t=")
+}
@@ -0,0 +1,107 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2021 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+
+package executable
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseTaskOutputs(t *testing.T) {
+	cases := []struct {
+		name   string
+		stdout string
+		want   map[string]string
+	}{
+		{
+			"no outputs",
+			"starting\ndone\n",
+			map[string]string{},
+		},
+		{
+			"empty stdout",
+			"",
+			map[string]string{},
+		},
+		{
+			"markers",
+			"starting\n__o2output__:calib.path=/tmp/calib-1234.root\n  __o2output__:calib.entries=1024\ndone\n",
+			map[string]string{"calib.path": "/tmp/calib-1234.root", "calib.entries": "1024"},
+		},
+		{
+			"malformed markers",
+			"__o2output__:no value\n__o2output__:=empty key\n__o2output__:bad key=x\n__o2output__:good=a=b\n",
+			map[string]string{"good": "a=b"},
+		},
+		{
+			"valid trailer",
+			"starting\n{\"calib.path\": \"/tmp/calib-1234.root\", \"calib.entries\": 1024, \"flags\": [\"a\"], \"bad key\": \"x\"}\n\n",
+			map[string]string{"calib.path": "/tmp/calib-1234.root", "calib.entries": "1024", "flags": "[\"a\"]"},
+		},
+		{
+			"malformed trailer",
+			"__o2output__:a=1\n{\"calib.path\": \"/tmp/calib\n",
+			map[string]string{"a": "1"},
+		},
+		{
+			"trailer not last",
+			"{\"a\": \"1\"}\ndone\n",
+			map[string]string{},
+		},
+		{
+			"markers win over trailer",
+			"__o2output__:a=marker\n{\"a\": \"trailer\", \"b\": \"trailer\"}\n",
+			map[string]string{"a": "marker", "b": "trailer"},
+		},
+	}
+	for _, c := range cases {
+		outputs, err := parseTaskOutputs(c.stdout)
+		if err != nil {
+			t.Errorf("%s: unexpected error %s", c.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(outputs, c.want) {
+			t.Errorf("%s: expected %v, got %v", c.name, c.want, outputs)
+		}
+	}
+}
+
+func TestParseTaskOutputsOversizedLine(t *testing.T) {
+	stdout := "__o2output__:before=1\n" +
+		strings.Repeat("x", taskOutputMaxLineLen + 1) + "\n" +
+		"__o2output__:after=2\n" +
+		"{\"trailer\": \"3\"}\n"
+
+	outputs, err := parseTaskOutputs(stdout)
+	if err == nil {
+		t.Fatal("expected an error for an oversized line")
+	}
+	want := map[string]string{"before": "1"}
+	if !reflect.DeepEqual(outputs, want) {
+		t.Errorf("expected the outputs before the oversized line %v, got %v", want, outputs)
+	}
+}
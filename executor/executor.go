@@ -271,6 +271,13 @@ func buildEventHandler(state *internalState) events.Handler {
 			state.framework = e.Subscribed.FrameworkInfo
 			state.executor = e.Subscribed.ExecutorInfo
 			state.agent = e.Subscribed.AgentInfo
+
+			// Only once we know who we are can we report on tasks left
+			// behind by a previous instance of this executor
+			if !state.adoptionDone {
+				state.adoptionDone = true
+				go adoptOrphanedTasks(state)
+			}
 			return nil
 		},
 		executor.Event_LAUNCH: func(_ context.Context, e *executor.Event) error {
@@ -391,6 +398,7 @@ type internalState struct {
 	killedTasks    map[mesos.TaskID]mesos.TaskStatus
 	activeTasks    map[mesos.TaskID]executable.Task
 	shouldQuit     bool
+	adoptionDone   bool
 
 	statusCh       chan mesos.TaskStatus
 	messageCh      chan []byte
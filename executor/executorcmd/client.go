@@ -32,6 +32,7 @@ import (
 	"fmt"
 	"os/exec"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/AliceO2Group/Control/executor/executorcmd/nopb"
@@ -39,6 +40,7 @@ import (
 	"google.golang.org/grpc"
 
 	"github.com/AliceO2Group/Control/common/controlmode"
+	"github.com/AliceO2Group/Control/common/event"
 	"github.com/AliceO2Group/Control/executor/executorcmd/transitioner"
 	"github.com/AliceO2Group/Control/executor/protos"
 	"github.com/sirupsen/logrus"
@@ -50,8 +52,27 @@ const (
 	ProtobufTransport = ControlTransport(0)
 	JsonTransport = ControlTransport(1)
 )
+
+func (ct ControlTransport) String() string {
+	if ct == JsonTransport {
+		return "json"
+	}
+	return "protobuf"
+}
+
 const GRPC_DIAL_TIMEOUT = 30 * time.Second
+const GET_STATE_TIMEOUT = 5 * time.Second
+const GET_CAPABILITIES_TIMEOUT = 2 * time.Second
+
+// The ptree syntaxes supported by the OCC library and plugin, which we assume
+// for OCC servers that predate GetCapabilities.
+var legacyPropertyFormats = []string{"ini", "json", "xml"}
 
+const ptreePrefix = "__ptree__:"
+
+// NewClient connects to the OCC server of a task and negotiates the transport
+// and features to use with it. The given controlTransport is only used if the
+// server is too old to take part in the negotiation.
 func NewClient(
 	controlPort uint64,
 	controlMode controlmode.ControlMode,
@@ -59,10 +80,7 @@ func NewClient(
 	log *logrus.Entry,
 ) *RpcClient {
 	endpoint := fmt.Sprintf("127.0.0.1:%d", controlPort)
-	controlTransportS := "Protobuf"
-	if controlTransport == JsonTransport {
-		controlTransportS = "JSON"
-	}
+	controlTransportS := controlTransport.String()
 
 	log.WithField("endpoint", endpoint).
 		WithField("transport", controlTransportS).
@@ -79,21 +97,18 @@ func NewClient(
 		return nil
 	}
 
-	var occClient pb.OccClient
-	if controlTransport == JsonTransport {
-		occClient = nopb.NewOccClient(conn)
-	} else {
-		occClient = pb.NewOccClient(conn)
-	}
-
 	client := &RpcClient {
-		OccClient: occClient,
 		conn: conn,
+		log: log,
 	}
+	client.negotiate(controlTransport)
+	cancel()
 
 	log.WithFields(logrus.Fields{"endpoint": endpoint, "controlMode": controlMode.String()}).Debug("instantiating new transitioner")
 	client.Transitioner = transitioner.NewTransitioner(controlMode, client.doTransition)
-	client.log = log
+	if fmqTransitioner, ok := client.Transitioner.(*transitioner.FairMQ); ok {
+		fmqTransitioner.GetDeviceState = client.getDeviceState
+	}
 	return client
 }
 
@@ -103,6 +118,94 @@ type RpcClient struct {
 	Transitioner transitioner.Transitioner
 	TaskCmd      *exec.Cmd
 	log          *logrus.Entry
+
+	// What was agreed with the OCC server when connecting
+	Transport    ControlTransport
+	Capabilities event.OccCapabilities
+}
+
+func newOccClient(conn *grpc.ClientConn, transport ControlTransport) pb.OccClient {
+	if transport == JsonTransport {
+		return nopb.NewOccClient(conn)
+	}
+	return pb.NewOccClient(conn)
+}
+
+// negotiate asks the OCC server for its capabilities, first over the transport
+// we expect it to speak and then over the other one. If it answers, we go
+// with the transport it prefers, otherwise it's a legacy server and we
+// assume the fallback transport and the features it always had.
+func (r *RpcClient) negotiate(fallback ControlTransport) {
+	candidates := []ControlTransport{ProtobufTransport, JsonTransport}
+	if fallback == JsonTransport {
+		candidates = []ControlTransport{JsonTransport, ProtobufTransport}
+	}
+
+	for _, transport := range candidates {
+		cxt, cancel := context.WithTimeout(context.Background(), GET_CAPABILITIES_TIMEOUT)
+		reply, err := newOccClient(r.conn, transport).GetCapabilities(cxt, &pb.GetCapabilitiesRequest{}, grpc.EmptyCallOption{})
+		cancel()
+		if err != nil {
+			r.log.WithError(err).
+				WithField("transport", transport.String()).
+				Debug("OCC server did not answer GetCapabilities")
+			continue
+		}
+
+		r.Transport = transport
+		for _, offered := range reply.GetTransports() {
+			if offered == ProtobufTransport.String() {
+				r.Transport = ProtobufTransport
+				break
+			} else if offered == JsonTransport.String() {
+				r.Transport = JsonTransport
+				break
+			}
+		}
+		r.OccClient = newOccClient(r.conn, r.Transport)
+		r.Capabilities = event.OccCapabilities{
+			Transport:       r.Transport.String(),
+			StateStream:     reply.GetStateStream(),
+			Ptree:           reply.GetPtree(),
+			PropertyFormats: reply.GetPropertyFormats(),
+			Version:         reply.GetVersion(),
+			Negotiated:      true,
+		}
+		r.log.WithFields(logrus.Fields{
+				"transport": r.Capabilities.Transport,
+				"stateStream": r.Capabilities.StateStream,
+				"ptree": r.Capabilities.Ptree,
+				"version": r.Capabilities.Version,
+			}).
+			Debug("OCC capabilities negotiated")
+		return
+	}
+
+	r.Transport = fallback
+	r.OccClient = newOccClient(r.conn, r.Transport)
+	r.Capabilities = event.OccCapabilities{
+		Transport:       r.Transport.String(),
+		StateStream:     r.Transport == ProtobufTransport, // OCClite never had it
+		Ptree:           true,
+		PropertyFormats: legacyPropertyFormats,
+		Negotiated:      false,
+	}
+	r.log.WithField("transport", r.Capabilities.Transport).
+		Debug("legacy OCC server, assuming capabilities")
+}
+
+// supportsPtree tells whether the OCC server expands properties pushed under
+// a __ptree__:<syntax>:<key> key with the given syntax.
+func (r *RpcClient) supportsPtree(syntax string) bool {
+	if !r.Capabilities.Ptree {
+		return false
+	}
+	for _, format := range r.Capabilities.PropertyFormats {
+		if format == syntax {
+			return true
+		}
+	}
+	return false
 }
 
 func (r *RpcClient) Close() error {
@@ -113,6 +216,18 @@ func (r *RpcClient) FromDeviceState(state string) string {
 	return r.Transitioner.FromDeviceState(state)
 }
 
+func (r *RpcClient) getDeviceState() (state string, err error) {
+	cxt, cancel := context.WithTimeout(context.Background(), GET_STATE_TIMEOUT)
+	defer cancel()
+	var response *pb.GetStateReply
+	response, err = r.GetState(cxt, &pb.GetStateRequest{}, grpc.EmptyCallOption{})
+	if err != nil {
+		return
+	}
+	state = response.GetState()
+	return
+}
+
 func (r *RpcClient) doTransition(ei transitioner.EventInfo) (newState string, err error) {
 	r.log.WithField("event", ei.Evt).
 		Debug("executor<->occplugin interface requesting transition")
@@ -125,6 +240,14 @@ func (r *RpcClient) doTransition(ei transitioner.EventInfo) (newState string, er
 			return
 		}
 		for k, v := range ei.Args {
+			// A server which can't build a ptree from this payload gets it
+			// as a plain string under the bare key
+			if strings.HasPrefix(k, ptreePrefix) {
+				if split := strings.SplitN(strings.TrimPrefix(k, ptreePrefix), ":", 2); len(split) == 2 &&
+					!r.supportsPtree(split[0]) {
+					k = split[1]
+				}
+			}
 			cfg = append(cfg, &pb.ConfigEntry{Key: k, Value: v})
 			r.log.WithField("key", k).
 				WithField("value", v).
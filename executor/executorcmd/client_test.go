@@ -0,0 +1,324 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2021 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+
+package executorcmd
+
+import (
+	"context"
+	"net"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/AliceO2Group/Control/common/controlmode"
+	"github.com/AliceO2Group/Control/common/event"
+	"github.com/AliceO2Group/Control/executor/executorcmd/transitioner/fairmq"
+	pb "github.com/AliceO2Group/Control/executor/protos"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type fmqTransitionKey struct {
+	state string
+	event string
+}
+
+// The subset of the FairMQ device state machine exposed by the OCC plugin,
+// with intermediate states resolved as the plugin does.
+var fmqTransitions = map[fmqTransitionKey]string{
+	{fairmq.IDLE, fairmq.EvtINIT_DEVICE}:                   fairmq.INITIALIZING_DEVICE,
+	{fairmq.INITIALIZING_DEVICE, fairmq.EvtCOMPLETE_INIT}:  fairmq.INITIALIZED,
+	{fairmq.INITIALIZED, fairmq.EvtBIND}:                   fairmq.BOUND,
+	{fairmq.BOUND, fairmq.EvtCONNECT}:                      fairmq.DEVICE_READY,
+	{fairmq.DEVICE_READY, fairmq.EvtINIT_TASK}:             fairmq.READY,
+	{fairmq.READY, fairmq.EvtRUN}:                          fairmq.RUNNING,
+	{fairmq.RUNNING, fairmq.EvtSTOP}:                       fairmq.READY,
+	{fairmq.READY, fairmq.EvtRESET_TASK}:                   fairmq.DEVICE_READY,
+	{fairmq.DEVICE_READY, fairmq.EvtRESET_DEVICE}:          fairmq.IDLE,
+	{fairmq.BOUND, fairmq.EvtRESET_DEVICE}:                 fairmq.IDLE,
+	{fairmq.INITIALIZED, fairmq.EvtRESET_DEVICE}:           fairmq.IDLE,
+	{fairmq.IDLE, fairmq.EvtEND}:                           fairmq.EXITING,
+}
+
+// fakeFairMQDevice is an OCC server which behaves like a FairMQ device
+// with the OCC plugin loaded.
+type fakeFairMQDevice struct {
+	pb.UnimplementedOccServer
+
+	mu      sync.Mutex
+	state   string
+	stuckOn map[string]bool // events after which the device stays put
+	events  []string
+}
+
+func (d *fakeFairMQDevice) GetState(context.Context, *pb.GetStateRequest) (*pb.GetStateReply, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return &pb.GetStateReply{State: d.state, Pid: 1}, nil
+}
+
+func (d *fakeFairMQDevice) Transition(_ context.Context, req *pb.TransitionRequest) (*pb.TransitionReply, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if req.GetSrcState() != d.state {
+		return nil, status.Errorf(codes.InvalidArgument, "transition not possible: state mismatch: source: %s current: %s", req.GetSrcState(), d.state)
+	}
+	evt := req.GetTransitionEvent()
+	d.events = append(d.events, evt)
+
+	next, ok := fmqTransitions[fmqTransitionKey{d.state, evt}]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "transition invalid event name: %s", evt)
+	}
+	if d.stuckOn[evt] {
+		return &pb.TransitionReply{Trigger: pb.StateChangeTrigger_DEVICE_ERROR, State: d.state, TransitionEvent: evt, Ok: false}, nil
+	}
+	d.state = next
+	return &pb.TransitionReply{Trigger: pb.StateChangeTrigger_EXECUTOR, State: d.state, TransitionEvent: evt, Ok: true}, nil
+}
+
+func (d *fakeFairMQDevice) getEvents() []string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return append([]string{}, d.events...)
+}
+
+// fakeCapableDevice is a fakeFairMQDevice whose OCC server takes part in
+// capability negotiation.
+type fakeCapableDevice struct {
+	*fakeFairMQDevice
+	capabilities *pb.GetCapabilitiesReply
+	arguments    []*pb.ConfigEntry
+}
+
+func (d *fakeCapableDevice) GetCapabilities(context.Context, *pb.GetCapabilitiesRequest) (*pb.GetCapabilitiesReply, error) {
+	return d.capabilities, nil
+}
+
+func (d *fakeCapableDevice) Transition(cxt context.Context, req *pb.TransitionRequest) (*pb.TransitionReply, error) {
+	d.mu.Lock()
+	d.arguments = append(d.arguments, req.GetArguments()...)
+	d.mu.Unlock()
+	return d.fakeFairMQDevice.Transition(cxt, req)
+}
+
+func newFakeFairMQClient(t *testing.T, device pb.OccServer) *RpcClient {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("cannot listen: %v", err)
+	}
+	server := grpc.NewServer()
+	pb.RegisterOccServer(server, device)
+	go func() { _ = server.Serve(lis) }()
+	t.Cleanup(server.Stop)
+
+	client := NewClient(
+		uint64(lis.Addr().(*net.TCPAddr).Port),
+		controlmode.FAIRMQ,
+		ProtobufTransport,
+		logrus.NewEntry(logrus.StandardLogger()),
+	)
+	if client == nil {
+		t.Fatal("cannot connect to fake FairMQ device")
+	}
+	t.Cleanup(func() { _ = client.Close() })
+	return client
+}
+
+func TestFairMQRecover(t *testing.T) {
+	testCases := []struct {
+		name           string
+		deviceState    string
+		expectedState  string
+		expectedEvents []string
+		expectError    bool
+	}{
+		{
+			name:           "error during RUNNING",
+			deviceState:    fairmq.RUNNING,
+			expectedState:  "STANDBY",
+			expectedEvents: []string{fairmq.EvtSTOP, fairmq.EvtRESET_TASK, fairmq.EvtRESET_DEVICE},
+		},
+		{
+			name:           "stuck halfway through CONFIGURE",
+			deviceState:    fairmq.BOUND,
+			expectedState:  "STANDBY",
+			expectedEvents: []string{fairmq.EvtRESET_DEVICE},
+		},
+		{
+			name:           "stuck in INITIALIZING DEVICE",
+			deviceState:    fairmq.INITIALIZING_DEVICE,
+			expectedState:  "STANDBY",
+			expectedEvents: []string{fairmq.EvtCOMPLETE_INIT, fairmq.EvtRESET_DEVICE},
+		},
+		{
+			name:           "device in ERROR",
+			deviceState:    fairmq.ERROR,
+			expectedState:  "ERROR",
+			expectedEvents: []string{},
+			expectError:    true,
+		},
+		{
+			name:           "already IDLE",
+			deviceState:    fairmq.IDLE,
+			expectedState:  "STANDBY",
+			expectedEvents: []string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			device := &fakeFairMQDevice{state: tc.deviceState}
+			client := newFakeFairMQClient(t, device)
+
+			finalState, err := client.Transitioner.Commit("RECOVER", "ERROR", "STANDBY", nil)
+			if finalState != tc.expectedState {
+				t.Errorf("expected final state %s, got %s", tc.expectedState, finalState)
+			}
+			if tc.expectError != (err != nil) {
+				t.Errorf("unexpected error value: %v", err)
+			}
+			if events := device.getEvents(); !reflect.DeepEqual(events, tc.expectedEvents) {
+				t.Errorf("expected events %v, got %v", tc.expectedEvents, events)
+			}
+		})
+	}
+}
+
+func TestFairMQGoErrorRecoverAndReconfigure(t *testing.T) {
+	// The fake device refuses ERROR FOUND like any other unknown event, so
+	// GO_ERROR must leave it alone for RECOVER to work
+	device := &fakeFairMQDevice{state: fairmq.IDLE}
+	client := newFakeFairMQClient(t, device)
+
+	steps := []struct {
+		evt, src, dst string
+	}{
+		{"CONFIGURE", "STANDBY", "CONFIGURED"},
+		{"START", "CONFIGURED", "RUNNING"},
+		{"GO_ERROR", "RUNNING", "ERROR"},
+		{"RECOVER", "ERROR", "STANDBY"},
+		{"CONFIGURE", "STANDBY", "CONFIGURED"},
+		{"START", "CONFIGURED", "RUNNING"},
+	}
+	for _, step := range steps {
+		finalState, err := client.Transitioner.Commit(step.evt, step.src, step.dst, nil)
+		if err != nil {
+			t.Fatalf("%s failed: %v", step.evt, err)
+		}
+		if finalState != step.dst {
+			t.Fatalf("%s: expected %s, got %s", step.evt, step.dst, finalState)
+		}
+	}
+}
+
+func TestFairMQRecoverAfterFailedStop(t *testing.T) {
+	device := &fakeFairMQDevice{state: fairmq.RUNNING, stuckOn: map[string]bool{fairmq.EvtSTOP: true}}
+	client := newFakeFairMQClient(t, device)
+
+	// STOP fails and the device stays in RUNNING, so the core sees an error...
+	if _, err := client.Transitioner.Commit("STOP", "RUNNING", "CONFIGURED", nil); err == nil {
+		t.Fatal("expected STOP to fail")
+	}
+
+	// ...and once the device gets unstuck, RECOVER must start from RUNNING
+	// even though the core believes the task is in ERROR.
+	device.mu.Lock()
+	device.stuckOn = nil
+	device.mu.Unlock()
+
+	finalState, err := client.Transitioner.Commit("RECOVER", "ERROR", "STANDBY", nil)
+	if err != nil || finalState != "STANDBY" {
+		t.Fatalf("expected STANDBY, got %s (%v)", finalState, err)
+	}
+}
+
+func TestNegotiateLegacyServer(t *testing.T) {
+	client := newFakeFairMQClient(t, &fakeFairMQDevice{state: fairmq.IDLE})
+
+	if client.Capabilities.Negotiated {
+		t.Error("expected capabilities to be assumed for a server without GetCapabilities")
+	}
+	if client.Transport != ProtobufTransport || client.Capabilities.Transport != "protobuf" {
+		t.Errorf("expected the fallback transport, got %s", client.Capabilities.Transport)
+	}
+	if !client.Capabilities.StateStream || !client.Capabilities.Ptree {
+		t.Errorf("expected legacy protobuf capabilities, got %+v", client.Capabilities)
+	}
+}
+
+func TestNegotiateCapabilities(t *testing.T) {
+	device := &fakeCapableDevice{
+		fakeFairMQDevice: &fakeFairMQDevice{state: fairmq.IDLE},
+		capabilities: &pb.GetCapabilitiesReply{
+			Transports:      []string{"protobuf"},
+			StateStream:     false,
+			Ptree:           true,
+			PropertyFormats: []string{"json"},
+			Version:         "1.2.3",
+		},
+	}
+	client := newFakeFairMQClient(t, device)
+
+	expected := event.OccCapabilities{
+		Transport:       "protobuf",
+		StateStream:     false,
+		Ptree:           true,
+		PropertyFormats: []string{"json"},
+		Version:         "1.2.3",
+		Negotiated:      true,
+	}
+	if !reflect.DeepEqual(client.Capabilities, expected) {
+		t.Fatalf("expected %+v, got %+v", expected, client.Capabilities)
+	}
+
+	// Payloads in a syntax the server can't build a ptree from are pushed
+	// under their bare key
+	_, err := client.Transitioner.Commit("CONFIGURE", "STANDBY", "CONFIGURED", map[string]string{
+		"__ptree__:json:a": `{"x": 1}`,
+		"__ptree__:xml:b":  `<x>1</x>`,
+		"c":                "plain",
+	})
+	if err != nil {
+		t.Fatalf("CONFIGURE failed: %v", err)
+	}
+	pushed := make(map[string]string)
+	device.mu.Lock()
+	for _, entry := range device.arguments {
+		pushed[entry.GetKey()] = entry.GetValue()
+	}
+	device.mu.Unlock()
+	expectedArgs := map[string]string{
+		"__ptree__:json:a": `{"x": 1}`,
+		"b":                `<x>1</x>`,
+		"c":                "plain",
+	}
+	if !reflect.DeepEqual(pushed, expectedArgs) {
+		t.Errorf("expected arguments %v, got %v", expectedArgs, pushed)
+	}
+}
@@ -14,6 +14,7 @@ type OccClient interface {
 	StateStream(ctx context.Context, in *pb.StateStreamRequest, opts ...grpc.CallOption) (pb.Occ_StateStreamClient, error)
 	GetState(ctx context.Context, in *pb.GetStateRequest, opts ...grpc.CallOption) (*pb.GetStateReply, error)
 	Transition(ctx context.Context, in *pb.TransitionRequest, opts ...grpc.CallOption) (*pb.TransitionReply, error)
+	GetCapabilities(ctx context.Context, in *pb.GetCapabilitiesRequest, opts ...grpc.CallOption) (*pb.GetCapabilitiesReply, error)
 }
 type occClient struct {
 	cc *grpc.ClientConn
@@ -60,8 +61,41 @@ func (c *occClient) EventStream(ctx context.Context, in *pb.EventStreamRequest,
 	return x, nil
 }
 
+type occStateStreamClient struct {
+	grpc.ClientStream
+}
+func (x *occStateStreamClient) Recv() (*pb.StateStreamReply, error) {
+	m := new(pb.StateStreamReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *occClient) StateStream(ctx context.Context, in *pb.StateStreamRequest, opts ...grpc.CallOption) (pb.Occ_StateStreamClient, error) {
-	return nil, nil
+	opts = append(opts,
+		[]grpc.CallOption{
+			grpc.CallContentSubtype("json"),
+		}...
+	)
+	streamDesc := grpc.StreamDesc{
+		StreamName:    "StateStream",
+		Handler: nil,
+		ServerStreams: true,
+		ClientStreams: false,
+	}
+	stream, err := c.cc.NewStream(ctx, &streamDesc, "StateStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &occStateStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
 func (c *occClient) GetState(ctx context.Context, in *pb.GetStateRequest, opts ...grpc.CallOption) (*pb.GetStateReply, error) {
@@ -91,3 +125,17 @@ func (c *occClient) Transition(ctx context.Context, in *pb.TransitionRequest, op
 	}
 	return out, nil
 }
+
+func (c *occClient) GetCapabilities(ctx context.Context, in *pb.GetCapabilitiesRequest, opts ...grpc.CallOption) (*pb.GetCapabilitiesReply, error) {
+	out := pb.GetCapabilitiesReply{}
+	opts = append(opts,
+		[]grpc.CallOption{
+			grpc.CallContentSubtype("json"),
+		}...
+	)
+	err := c.cc.Invoke(ctx, "GetCapabilities", in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -24,11 +24,23 @@
 
 package transitioner
 
-import "github.com/AliceO2Group/Control/executor/executorcmd/transitioner/fairmq"
+import (
+	"fmt"
+
+	"github.com/AliceO2Group/Control/executor/executorcmd/transitioner/fairmq"
+)
+
+// The longest path back to IDLE is RUNNING→READY→DEVICE READY→IDLE, but
+// we allow a few more steps in case the device moves on its own meanwhile.
+const maxRecoverySteps = 8
 
 type FairMQ struct {
 	DoTransition DoTransitionFunc
 
+	// Optional, used to find out where the device really is in its state
+	// machine, since after an error it might be stuck anywhere
+	GetDeviceState GetStateFunc
+
 	stateMap map[string]string
 	invStateMap map[string]string
 }
@@ -63,10 +75,13 @@ func (cm *FairMQ) Commit(evt string, src string, dst string, args map[string]str
 	case "STOP":
 		finalState, err = cm.DoTransition(EventInfo{fairmq.EvtSTOP, cm.fmqStateForState(src), cm.fmqStateForState(dst), args})
 		finalState = cm.stateForFmqState(finalState)
-	case "RECOVER": fallthrough
+	case "RECOVER":
+		finalState, err = cm.doRecover(evt, src, dst, args)
 	case "GO_ERROR":
-		log.WithField("event", evt).Error("transition not implemented yet")
-		finalState = src
+		// ERROR FOUND would make the device terminal, so the device is left
+		// where it is and only the task is considered to be in ERROR, which
+		// keeps it recoverable
+		finalState = "ERROR"
 	case "CONFIGURE":
 		finalState, err = cm.doConfigure(evt, src, dst, args)
 	case "RESET":
@@ -166,6 +181,75 @@ func (cm *FairMQ) doReset(evt string, src string, dst string, args map[string]st
 	return
 }
 
+// currentFmqState returns the state the device reports, falling back to
+// the FairMQ equivalent of the O² state we think it's in.
+func (cm *FairMQ) currentFmqState(src string) string {
+	if cm.GetDeviceState != nil {
+		deviceState, err := cm.GetDeviceState()
+		if err == nil && len(deviceState) != 0 {
+			return deviceState
+		}
+		log.WithError(err).
+			WithField("src", src).
+			Warning("cannot query FairMQ device state, assuming source state")
+	}
+	return cm.fmqStateForState(src)
+}
+
+// doRecover walks the device back down to IDLE one step at a time, starting
+// from wherever it actually is, e.g. RUNNING if STOP failed or BOUND if
+// CONFIGURE failed halfway through. A FairMQ device in ERROR accepts no
+// further transition other than END, so it is refused and must be relaunched.
+func (cm *FairMQ) doRecover(evt string, src string, dst string, args map[string]string) (finalState string, err error) {
+	state := cm.currentFmqState(src)
+
+	for i := 0; i < maxRecoverySteps && state != fairmq.IDLE; i++ {
+		var ei EventInfo
+		switch state {
+		case fairmq.RUNNING:
+			ei = EventInfo{fairmq.EvtSTOP, fairmq.RUNNING, fairmq.READY, nil}
+		case fairmq.READY:
+			ei = EventInfo{fairmq.EvtRESET_TASK, fairmq.READY, fairmq.DEVICE_READY, nil}
+		case fairmq.INITIALIZING_DEVICE:
+			ei = EventInfo{fairmq.EvtCOMPLETE_INIT, fairmq.INITIALIZING_DEVICE, fairmq.INITIALIZED, nil}
+		case fairmq.INITIALIZED, fairmq.BOUND, fairmq.DEVICE_READY:
+			ei = EventInfo{fairmq.EvtRESET_DEVICE, state, fairmq.IDLE, args}
+		case fairmq.ERROR:
+			err = fmt.Errorf("FairMQ device in %s cannot be recovered, the task must be relaunched", state)
+			finalState = "ERROR"
+			return
+		default:
+			// EXITING, or a transient state which the device should leave on its own
+			err = fmt.Errorf("cannot recover FairMQ device from state %s", state)
+			finalState = "ERROR"
+			return
+		}
+
+		var newState string
+		newState, err = cm.DoTransition(ei)
+		if err != nil || len(newState) == 0 || newState == state {
+			if err == nil {
+				err = fmt.Errorf("FairMQ device refused %s from state %s", ei.Evt, state)
+			}
+			log.WithError(err).
+				WithField("event", evt).
+				WithField("fmqState", state).
+				Warning("FairMQ device recovery failed")
+			finalState = "ERROR"
+			return
+		}
+		state = newState
+	}
+
+	if state != fairmq.IDLE {
+		err = fmt.Errorf("FairMQ device did not reach %s during recovery, stuck in %s", fairmq.IDLE, state)
+		finalState = "ERROR"
+		return
+	}
+	finalState = cm.stateForFmqState(state)
+	return
+}
+
 func (cm *FairMQ) FromDeviceState(state string) string {
 	return cm.stateForFmqState(state)
 }
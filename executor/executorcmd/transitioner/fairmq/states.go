@@ -30,9 +30,14 @@ const(
 	IDLE                = "IDLE"
 	INITIALIZING_DEVICE = "INITIALIZING DEVICE"
 	INITIALIZED         = "INITIALIZED"
+	BINDING             = "BINDING"
 	BOUND               = "BOUND"
+	CONNECTING          = "CONNECTING"
 	DEVICE_READY        = "DEVICE READY"
+	INITIALIZING_TASK   = "INITIALIZING TASK"
 	READY               = "READY"
 	RUNNING             = "RUNNING"
+	RESETTING_TASK      = "RESETTING TASK"
+	RESETTING_DEVICE    = "RESETTING DEVICE"
 	EXITING             = "EXITING"
 )
\ No newline at end of file
@@ -61,3 +61,4 @@ func NewTransitioner(cm controlmode.ControlMode, transitionFunc DoTransitionFunc
 }
 
 type DoTransitionFunc func(ei EventInfo) (newState string, err error)
+type GetStateFunc func() (state string, err error)
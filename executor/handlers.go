@@ -264,6 +264,46 @@ func handleMessageEvent(state *internalState, data []byte) (err error) {
 				Trace("response sent")
 		}()
 
+	case "MesosCommand_GetTaskLogs":
+		// Task logs are read from the sandbox, so this also works for tasks
+		// which are no longer active
+		go func() {
+			var cmd = new(controlcommands.MesosCommand_GetTaskLogs)
+			err = json.Unmarshal(data, cmd)
+			if err != nil {
+				log.WithFields(logrus.Fields{
+						"name": incoming.Name,
+						"message": string(data[:]),
+						"error": err.Error(),
+					}).
+					Error("cannot unmarshal incoming MESSAGE")
+				return
+			}
+
+			logData, cursor, readErr := executable.ReadTaskLogs(taskId.Value, cmd.Stream, cmd.Tail, cmd.Cursor)
+			response := controlcommands.NewMesosCommandResponse_GetTaskLogs(cmd, readErr, taskId.Value, logData, cursor)
+
+			data, marshalError := json.Marshal(response)
+			if marshalError != nil {
+				log.WithFields(logrus.Fields{
+						"commandName": response.GetCommandName(),
+						"commandId": response.GetCommandId(),
+						"error": response.Err().Error(),
+						"marshalError": marshalError,
+					}).
+					Error("cannot marshal MesosCommandResponse for sending as MESSAGE")
+				return
+			}
+
+			_, _ = state.cli.Send(context.TODO(), calls.NonStreaming(calls.Message(data)))
+			log.WithFields(logrus.Fields{
+					"commandName": response.GetCommandName(),
+					"commandId": response.GetCommandId(),
+					"taskId": response.TaskId,
+					"error": response.Err().Error(),
+				}).
+				Trace("response sent")
+		}()
 
 	default:
 		err = errors.New(fmt.Sprintf("unrecognized controlcommand %s", incoming.Name))
@@ -295,6 +335,33 @@ func handleLaunchEvent(state *internalState, taskInfo mesos.TaskInfo) {
 	}
 }
 
+// Takes over the tasks left behind by a previous instance of this executor, if
+// any are still alive. This function is thread-safe with respect to state.
+func adoptOrphanedTasks(state *internalState) {
+	adopted := executable.AdoptOrphanedTasks(
+		func(taskId mesos.TaskID) bool {
+			state.activeTasksMu.RLock()
+			defer state.activeTasksMu.RUnlock()
+			_, ok := state.activeTasks[taskId]
+			return ok
+		},
+		func(taskInfo mesos.TaskInfo) executable.SendStatusFunc {
+			return makeSendStatusUpdateFunc(state, taskInfo)
+		},
+		makeSendDeviceEventFunc(state),
+		makeSendMessageFunc(state))
+
+	if len(adopted) == 0 {
+		return
+	}
+	state.activeTasksMu.Lock()
+	for taskId, adoptedTask := range adopted {
+		state.activeTasks[taskId] = adoptedTask
+	}
+	state.activeTasksMu.Unlock()
+	log.WithField("count", len(adopted)).Info("orphaned tasks adopted")
+}
+
 // Attempts to kill a task. This function is thread-safe with respect to state.
 func handleKillEvent(state *internalState, e *executor.Event_Kill) error {
 	state.activeTasksMu.RLock()
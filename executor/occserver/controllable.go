@@ -0,0 +1,131 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2021 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+
+package occserver
+
+// Controllable is implemented by the business logic of a task controlled
+// by an OCC server. Each method is called when the corresponding transition
+// is requested, and a non-nil error sends the task to ERROR.
+type Controllable interface {
+	Configure(properties Properties) error
+	Reset() error
+	Start(properties Properties) error
+	Stop() error
+	Exit() error
+	Recover() error
+}
+
+// Pausable can be implemented by a Controllable which supports PAUSE and
+// RESUME while running.
+type Pausable interface {
+	Pause() error
+	Resume() error
+}
+
+// RunningIterator can be implemented by a Controllable which does its work
+// in the OCC server loop. IterateRunning is called repeatedly in RUNNING,
+// until it reports the end of data (which results in an END_OF_STREAM event
+// for the executor) or fails (which sends the task to ERROR).
+type RunningIterator interface {
+	IterateRunning() (endOfData bool, err error)
+}
+
+// Checker can be implemented by a Controllable which wants to be checked
+// periodically in any state. An error sends the task to ERROR.
+type Checker interface {
+	IterateCheck() error
+}
+
+// Callbacks is a Controllable, Pausable, RunningIterator and Checker built
+// from optional functions, for tasks which only care about a few of them.
+// A nil callback is a successful no-op.
+type Callbacks struct {
+	OnConfigure      func(properties Properties) error
+	OnReset          func() error
+	OnStart          func(properties Properties) error
+	OnStop           func() error
+	OnExit           func() error
+	OnRecover        func() error
+	OnPause          func() error
+	OnResume         func() error
+	OnIterateRunning func() (endOfData bool, err error)
+	OnIterateCheck   func() error
+}
+
+func (c *Callbacks) Configure(properties Properties) error {
+	if c.OnConfigure == nil {
+		return nil
+	}
+	return c.OnConfigure(properties)
+}
+
+func (c *Callbacks) Reset() error {
+	return callOrNil(c.OnReset)
+}
+
+func (c *Callbacks) Start(properties Properties) error {
+	if c.OnStart == nil {
+		return nil
+	}
+	return c.OnStart(properties)
+}
+
+func (c *Callbacks) Stop() error {
+	return callOrNil(c.OnStop)
+}
+
+func (c *Callbacks) Exit() error {
+	return callOrNil(c.OnExit)
+}
+
+func (c *Callbacks) Recover() error {
+	return callOrNil(c.OnRecover)
+}
+
+func (c *Callbacks) Pause() error {
+	return callOrNil(c.OnPause)
+}
+
+func (c *Callbacks) Resume() error {
+	return callOrNil(c.OnResume)
+}
+
+func (c *Callbacks) IterateRunning() (endOfData bool, err error) {
+	if c.OnIterateRunning == nil {
+		return false, nil
+	}
+	return c.OnIterateRunning()
+}
+
+func (c *Callbacks) IterateCheck() error {
+	return callOrNil(c.OnIterateCheck)
+}
+
+func callOrNil(f func() error) error {
+	if f == nil {
+		return nil
+	}
+	return f()
+}
@@ -0,0 +1,479 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2021 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+
+// Package occserver implements the server side of the OCC protocol, i.e.
+// the gRPC interface through which the executor controls a task in `direct`
+// control mode. It is the Go counterpart of occlib: the business logic of a
+// task implements Controllable (or fills in Callbacks), and the Server runs
+// the state machine, the property map handling and the event and state
+// streams.
+package occserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/AliceO2Group/Control/common/logger"
+	pb "github.com/AliceO2Group/Control/executor/protos"
+	"github.com/looplab/fsm"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var log = logger.New(logrus.StandardLogger(), "occserver")
+
+const (
+	STANDBY    = "STANDBY"
+	CONFIGURED = "CONFIGURED"
+	RUNNING    = "RUNNING"
+	PAUSED     = "PAUSED"
+	ERROR      = "ERROR"
+	DONE       = "DONE"
+)
+
+const (
+	DefaultControlPort       = 47100
+	ControlPortEnv           = "OCC_CONTROL_PORT"
+	ControlPortArg           = "--control-port"
+	DefaultIterationInterval = 10*time.Millisecond
+	streamBufferSize         = 64
+)
+
+// Event describes a transition of the state machine run by the Server.
+type Event struct {
+	Name string
+	Src  []string
+	Dst  string
+}
+
+// DefaultEvents is the OCC state machine, as implemented by occlib.
+var DefaultEvents = []Event{
+	{Name: "CONFIGURE", Src: []string{STANDBY},                    Dst: CONFIGURED},
+	{Name: "RESET",     Src: []string{CONFIGURED},                 Dst: STANDBY},
+	{Name: "START",     Src: []string{CONFIGURED},                 Dst: RUNNING},
+	{Name: "STOP",      Src: []string{RUNNING, PAUSED},            Dst: CONFIGURED},
+	{Name: "PAUSE",     Src: []string{RUNNING},                    Dst: PAUSED},
+	{Name: "RESUME",    Src: []string{PAUSED},                     Dst: RUNNING},
+	{Name: "EXIT",      Src: []string{STANDBY, CONFIGURED},        Dst: DONE},
+	{Name: "GO_ERROR",  Src: []string{CONFIGURED, RUNNING, PAUSED}, Dst: ERROR},
+	{Name: "RECOVER",   Src: []string{ERROR},                      Dst: STANDBY},
+}
+
+// HandlerFunc runs the business logic of a transition. A non-nil error
+// sends the task to ERROR instead of the destination state.
+type HandlerFunc func(properties Properties) error
+
+type Option func(s *Server)
+
+// WithEvents replaces the OCC state machine with a custom one. Transitions
+// without a handler (see WithHandler) always succeed.
+func WithEvents(initialState string, events []Event) Option {
+	return func(s *Server) {
+		s.initialState = initialState
+		s.events = events
+	}
+}
+
+// WithHandler sets or replaces the handler of a transition.
+func WithHandler(event string, handler HandlerFunc) Option {
+	return func(s *Server) {
+		s.handlers[strings.ToUpper(event)] = handler
+	}
+}
+
+// WithIterationInterval sets how often IterateRunning and IterateCheck are
+// called, if the Controllable implements them.
+func WithIterationInterval(interval time.Duration) Option {
+	return func(s *Server) {
+		s.iterationInterval = interval
+	}
+}
+
+// Server implements pb.OccServer on top of a Controllable.
+type Server struct {
+	pb.UnimplementedOccServer
+
+	mu                sync.Mutex
+	obj               Controllable
+	sm                *fsm.FSM
+	initialState      string
+	events            []Event
+	handlers          map[string]HandlerFunc
+	iterationInterval time.Duration
+	endOfData         bool
+
+	subMu             sync.Mutex
+	stateSubs         map[chan string]struct{}
+	eventSubs         map[chan pb.DeviceEventType]struct{}
+
+	grpcServer        *grpc.Server
+	done              chan struct{}
+	stopOnce          sync.Once
+	stopping          chan struct{}
+}
+
+func NewServer(obj Controllable, opts ...Option) *Server {
+	s := &Server{
+		obj:               obj,
+		initialState:      STANDBY,
+		events:            DefaultEvents,
+		handlers:          make(map[string]HandlerFunc),
+		iterationInterval: DefaultIterationInterval,
+		stateSubs:         make(map[chan string]struct{}),
+		eventSubs:         make(map[chan pb.DeviceEventType]struct{}),
+		done:              make(chan struct{}),
+		stopping:          make(chan struct{}),
+	}
+
+	s.handlers["CONFIGURE"] = obj.Configure
+	s.handlers["RESET"] = func(Properties) error { return obj.Reset() }
+	s.handlers["START"] = obj.Start
+	s.handlers["STOP"] = func(Properties) error { return obj.Stop() }
+	s.handlers["EXIT"] = func(Properties) error { return obj.Exit() }
+	s.handlers["RECOVER"] = func(Properties) error { return obj.Recover() }
+	if pausable, ok := obj.(Pausable); ok {
+		s.handlers["PAUSE"] = func(Properties) error { return pausable.Pause() }
+		s.handlers["RESUME"] = func(Properties) error { return pausable.Resume() }
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	fsmEvents := make(fsm.Events, len(s.events))
+	for i, evt := range s.events {
+		fsmEvents[i] = fsm.EventDesc{Name: evt.Name, Src: evt.Src, Dst: evt.Dst}
+	}
+	s.sm = fsm.NewFSM(s.initialState, fsmEvents, fsm.Callbacks{})
+
+	if _, ok := obj.(RunningIterator); ok {
+		go s.runIterations()
+	} else if _, ok := obj.(Checker); ok {
+		go s.runIterations()
+	}
+	return s
+}
+
+// ControlPort returns the port on which the OCC server should listen, as
+// passed by the executor through --control-port or OCC_CONTROL_PORT.
+func ControlPort(args []string) int {
+	for i, arg := range args {
+		var value string
+		if arg == ControlPortArg && i+1 < len(args) {
+			value = args[i+1]
+		} else if strings.HasPrefix(arg, ControlPortArg + "=") {
+			value = strings.TrimPrefix(arg, ControlPortArg + "=")
+		} else {
+			continue
+		}
+		if port, err := strconv.Atoi(value); err == nil {
+			return port
+		}
+	}
+	if port, err := strconv.Atoi(os.Getenv(ControlPortEnv)); err == nil {
+		return port
+	}
+	return DefaultControlPort
+}
+
+// ListenAndServe serves OCC on all interfaces on the given port, until Stop
+// is called.
+func (s *Server) ListenAndServe(port int) error {
+	lis, err := net.Listen("tcp", fmt.Sprintf("0.0.0.0:%d", port))
+	if err != nil {
+		return err
+	}
+	return s.Serve(lis)
+}
+
+// Serve serves OCC on lis, until Stop is called.
+func (s *Server) Serve(lis net.Listener) error {
+	s.mu.Lock()
+	if s.grpcServer != nil {
+		s.mu.Unlock()
+		return errors.New("OCC server already serving")
+	}
+	s.grpcServer = grpc.NewServer()
+	pb.RegisterOccServer(s.grpcServer, s)
+	grpcServer := s.grpcServer
+	s.mu.Unlock()
+
+	log.WithField("address", lis.Addr().String()).Info("OCC server listening")
+	return grpcServer.Serve(lis)
+}
+
+// Stop closes all streams and stops serving.
+func (s *Server) Stop() {
+	s.stopOnce.Do(func() {
+		close(s.stopping)
+	})
+	s.mu.Lock()
+	grpcServer := s.grpcServer
+	s.mu.Unlock()
+	if grpcServer != nil {
+		grpcServer.Stop()
+	}
+}
+
+// Done is closed once the state machine reaches DONE, at which point the
+// task is expected to exit.
+func (s *Server) Done() <-chan struct{} {
+	return s.done
+}
+
+// State returns the current state of the state machine.
+func (s *Server) State() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.sm.Current()
+}
+
+// PushEvent sends a device event to every executor subscribed to the
+// event stream.
+func (s *Server) PushEvent(eventType pb.DeviceEventType) {
+	s.subMu.Lock()
+	defer s.subMu.Unlock()
+	for ch := range s.eventSubs {
+		select {
+		case ch <- eventType:
+		default:
+			log.WithField("event", eventType.String()).Warning("event stream subscriber too slow, event dropped")
+		}
+	}
+}
+
+// setState must be called with s.mu held
+func (s *Server) setState(state string) {
+	s.sm.SetState(state)
+	s.publishState(state)
+	if state == DONE {
+		select {
+		case <-s.done:
+		default:
+			close(s.done)
+		}
+	}
+}
+
+func (s *Server) publishState(state string) {
+	s.subMu.Lock()
+	defer s.subMu.Unlock()
+	for ch := range s.stateSubs {
+		select {
+		case ch <- state:
+		default:
+			log.WithField("state", state).Warning("state stream subscriber too slow, state change dropped")
+		}
+	}
+}
+
+func (s *Server) runIterations() {
+	ticker := time.NewTicker(s.iterationInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.stopping:
+			return
+		case <-s.done:
+			return
+		case <-ticker.C:
+		}
+
+		s.mu.Lock()
+		if iterator, ok := s.obj.(RunningIterator); ok && s.sm.Current() == RUNNING && !s.endOfData {
+			endOfData, err := iterator.IterateRunning()
+			if err != nil {
+				log.WithError(err).Error("running iteration failed")
+				s.setState(ERROR)
+			} else if endOfData {
+				s.endOfData = true
+				s.PushEvent(pb.DeviceEventType_END_OF_STREAM)
+			}
+		}
+		if checker, ok := s.obj.(Checker); ok {
+			if err := checker.IterateCheck(); err != nil {
+				log.WithError(err).Error("periodic check failed")
+				s.setState(ERROR)
+			}
+		}
+		s.mu.Unlock()
+	}
+}
+
+func (s *Server) EventStream(req *pb.EventStreamRequest, srv pb.Occ_EventStreamServer) error {
+	ch := make(chan pb.DeviceEventType, streamBufferSize)
+	s.subMu.Lock()
+	s.eventSubs[ch] = struct{}{}
+	s.subMu.Unlock()
+	defer func() {
+		s.subMu.Lock()
+		delete(s.eventSubs, ch)
+		s.subMu.Unlock()
+	}()
+
+	for {
+		select {
+		case <-srv.Context().Done():
+			return nil
+		case <-s.stopping:
+			return nil
+		case eventType := <-ch:
+			err := srv.Send(&pb.EventStreamReply{Event: &pb.DeviceEvent{Type: eventType}})
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *Server) StateStream(req *pb.StateStreamRequest, srv pb.Occ_StateStreamServer) error {
+	ch := make(chan string, streamBufferSize)
+	s.subMu.Lock()
+	s.stateSubs[ch] = struct{}{}
+	s.subMu.Unlock()
+	defer func() {
+		s.subMu.Lock()
+		delete(s.stateSubs, ch)
+		s.subMu.Unlock()
+	}()
+
+	for {
+		select {
+		case <-srv.Context().Done():
+			return nil
+		case <-s.stopping:
+			return nil
+		case state := <-ch:
+			err := srv.Send(&pb.StateStreamReply{Type: pb.StateType_STATE_STABLE, State: state})
+			if err != nil {
+				return err
+			}
+			if state == DONE {
+				// we're about to shut down, no more state changes after this
+				return nil
+			}
+		}
+	}
+}
+
+func (s *Server) GetState(context.Context, *pb.GetStateRequest) (*pb.GetStateReply, error) {
+	return &pb.GetStateReply{
+		State: s.State(),
+		Pid:   int32(os.Getpid()),
+	}, nil
+}
+
+func (s *Server) GetCapabilities(context.Context, *pb.GetCapabilitiesRequest) (*pb.GetCapabilitiesReply, error) {
+	return &pb.GetCapabilitiesReply{
+		Transports:      []string{"protobuf"},
+		StateStream:     true,
+		Ptree:           true,
+		PropertyFormats: []string{"json", "xml"}, // see Payload.Decode
+	}, nil
+}
+
+func (s *Server) Transition(cxt context.Context, req *pb.TransitionRequest) (*pb.TransitionReply, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if req == nil {
+		return nil, status.New(codes.InvalidArgument, "null request received").Err()
+	}
+	evt := strings.ToUpper(req.GetTransitionEvent())
+	current := s.sm.Current()
+	if req.GetSrcState() != current {
+		return nil, status.New(codes.InvalidArgument,
+			fmt.Sprintf("transition not possible: state mismatch: source: %s current: %s", req.GetSrcState(), current)).Err()
+	}
+	if current == DONE {
+		return nil, status.New(codes.FailedPrecondition,
+			fmt.Sprintf("transition not possible: current state: %s", current)).Err()
+	}
+
+	reply := &pb.TransitionReply{
+		TransitionEvent: req.GetTransitionEvent(),
+	}
+	logFields := logrus.Fields{
+		"event": evt,
+		"src":   current,
+	}
+
+	if s.sm.Cannot(evt) {
+		log.WithFields(logFields).Warning("invalid event received")
+		reply.State = current
+		reply.Trigger = pb.StateChangeTrigger_DEVICE_INTENTIONAL
+		return reply, nil
+	}
+
+	dst := s.destination(evt, current)
+	newState := dst
+	if handler, ok := s.handlers[evt]; ok && handler != nil {
+		if err := handler(NewProperties(req.GetArguments())); err != nil {
+			log.WithFields(logFields).
+				WithError(err).
+				Error("transition failed")
+			newState = ERROR
+		}
+	}
+	if evt == "START" || evt == "RECOVER" {
+		s.endOfData = false
+	}
+	s.setState(newState)
+
+	reply.State = newState
+	reply.Ok = newState == dst
+	if newState == ERROR && dst != ERROR {
+		reply.Trigger = pb.StateChangeTrigger_DEVICE_ERROR
+	} else {
+		reply.Trigger = pb.StateChangeTrigger_EXECUTOR
+	}
+	log.WithFields(logFields).
+		WithField("dst", newState).
+		Debug("transition done")
+	return reply, nil
+}
+
+func (s *Server) destination(evt string, src string) string {
+	for _, e := range s.events {
+		if strings.ToUpper(e.Name) != evt {
+			continue
+		}
+		for _, eSrc := range e.Src {
+			if eSrc == src {
+				return e.Dst
+			}
+		}
+	}
+	return src
+}
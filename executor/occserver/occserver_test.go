@@ -0,0 +1,222 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2021 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+
+package occserver
+
+import (
+	"context"
+	"errors"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/AliceO2Group/Control/common/controlmode"
+	"github.com/AliceO2Group/Control/executor/executorcmd"
+	pb "github.com/AliceO2Group/Control/executor/protos"
+	"github.com/sirupsen/logrus"
+)
+
+func newTestServer(t *testing.T, obj Controllable, opts ...Option) (*Server, *executorcmd.RpcClient) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("cannot listen: %v", err)
+	}
+	server := NewServer(obj, opts...)
+	go func() { _ = server.Serve(lis) }()
+	t.Cleanup(server.Stop)
+
+	client := executorcmd.NewClient(
+		uint64(lis.Addr().(*net.TCPAddr).Port),
+		controlmode.DIRECT,
+		executorcmd.ProtobufTransport,
+		logrus.NewEntry(logrus.StandardLogger()),
+	)
+	if client == nil {
+		t.Fatal("cannot connect to OCC server")
+	}
+	t.Cleanup(func() { _ = client.Close() })
+	return server, client
+}
+
+func TestLifecycle(t *testing.T) {
+	var configured Properties
+	started := false
+	_, client := newTestServer(t, &Callbacks{
+		OnConfigure: func(p Properties) error {
+			configured = p
+			return nil
+		},
+		OnStart: func(p Properties) error {
+			started = true
+			return nil
+		},
+	})
+
+	steps := []struct{ evt, src, dst string }{
+		{"CONFIGURE", STANDBY, CONFIGURED},
+		{"START", CONFIGURED, RUNNING},
+		{"STOP", RUNNING, CONFIGURED},
+		{"RESET", CONFIGURED, STANDBY},
+		{"EXIT", STANDBY, DONE},
+	}
+	for _, step := range steps {
+		var args map[string]string
+		if step.evt == "CONFIGURE" {
+			args = map[string]string{
+				"chans.data.0.address":    "tcp://*:5555",
+				"__ptree__:json:settings": `{"rate": 10}`,
+			}
+		}
+		finalState, err := client.Transitioner.Commit(step.evt, step.src, step.dst, args)
+		if err != nil {
+			t.Fatalf("%s failed: %v", step.evt, err)
+		}
+		if finalState != step.dst {
+			t.Fatalf("%s: expected %s, got %s", step.evt, step.dst, finalState)
+		}
+	}
+
+	if !started {
+		t.Error("start handler not called")
+	}
+	if got, _ := configured.Get("chans.data.0.address"); got != "tcp://*:5555" {
+		t.Errorf("unexpected plain property: %q", got)
+	}
+	var settings struct{ Rate int `json:"rate"` }
+	payload, ok := configured.Payload("settings")
+	if !ok {
+		t.Fatal("payload property not parsed")
+	}
+	if err := payload.Decode(&settings); err != nil || settings.Rate != 10 {
+		t.Errorf("unexpected payload: %+v, %v", settings, err)
+	}
+}
+
+func TestCallbackErrorAndRecover(t *testing.T) {
+	server, client := newTestServer(t, &Callbacks{
+		OnStart: func(Properties) error {
+			return errors.New("cannot open input")
+		},
+	})
+
+	if _, err := client.Transitioner.Commit("CONFIGURE", STANDBY, CONFIGURED, nil); err != nil {
+		t.Fatalf("CONFIGURE failed: %v", err)
+	}
+	finalState, err := client.Transitioner.Commit("START", CONFIGURED, RUNNING, nil)
+	if err == nil {
+		t.Fatal("START should have failed")
+	}
+	if finalState != ERROR || server.State() != ERROR {
+		t.Fatalf("expected ERROR, got %s", finalState)
+	}
+	if finalState, err = client.Transitioner.Commit("RECOVER", ERROR, STANDBY, nil); err != nil || finalState != STANDBY {
+		t.Fatalf("RECOVER failed: %s, %v", finalState, err)
+	}
+
+	// a stale source state is rejected outright
+	if _, err = client.Transitioner.Commit("START", CONFIGURED, RUNNING, nil); err == nil {
+		t.Fatal("START from a stale source state should have failed")
+	}
+}
+
+func TestStreams(t *testing.T) {
+	iterations := 0
+	server, client := newTestServer(t, &Callbacks{
+		OnIterateRunning: func() (bool, error) {
+			iterations++
+			return iterations >= 3, nil
+		},
+	}, WithIterationInterval(time.Millisecond))
+
+	cxt, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	states, err := client.StateStream(cxt, &pb.StateStreamRequest{})
+	if err != nil {
+		t.Fatalf("cannot open state stream: %v", err)
+	}
+	events, err := client.EventStream(cxt, &pb.EventStreamRequest{})
+	if err != nil {
+		t.Fatalf("cannot open event stream: %v", err)
+	}
+	// streams are registered server-side asynchronously
+	time.Sleep(100 * time.Millisecond)
+
+	for _, step := range []struct{ evt, src, dst string }{
+		{"CONFIGURE", STANDBY, CONFIGURED},
+		{"START", CONFIGURED, RUNNING},
+	} {
+		if _, err := client.Transitioner.Commit(step.evt, step.src, step.dst, nil); err != nil {
+			t.Fatalf("%s failed: %v", step.evt, err)
+		}
+	}
+
+	for _, expected := range []string{CONFIGURED, RUNNING} {
+		reply, err := states.Recv()
+		if err != nil {
+			t.Fatalf("state stream error: %v", err)
+		}
+		if reply.GetState() != expected || reply.GetType() != pb.StateType_STATE_STABLE {
+			t.Fatalf("expected stable %s, got %s %s", expected, reply.GetType(), reply.GetState())
+		}
+	}
+
+	reply, err := events.Recv()
+	if err != nil {
+		t.Fatalf("event stream error: %v", err)
+	}
+	if reply.GetEvent().GetType() != pb.DeviceEventType_END_OF_STREAM {
+		t.Fatalf("expected END_OF_STREAM, got %s", reply.GetEvent().GetType())
+	}
+	if server.State() != RUNNING {
+		t.Fatalf("end of stream should not change state, got %s", server.State())
+	}
+}
+
+func TestControlPort(t *testing.T) {
+	old, wasSet := os.LookupEnv(ControlPortEnv)
+	defer func() {
+		if wasSet {
+			_ = os.Setenv(ControlPortEnv, old)
+		} else {
+			_ = os.Unsetenv(ControlPortEnv)
+		}
+	}()
+
+	_ = os.Setenv(ControlPortEnv, "")
+	if port := ControlPort(nil); port != DefaultControlPort {
+		t.Errorf("expected default port, got %d", port)
+	}
+	_ = os.Setenv(ControlPortEnv, "47200")
+	if port := ControlPort([]string{"task"}); port != 47200 {
+		t.Errorf("expected port from environment, got %d", port)
+	}
+	if port := ControlPort([]string{"task", "--control-port", "47300"}); port != 47300 {
+		t.Errorf("expected port from arguments, got %d", port)
+	}
+	if port := ControlPort([]string{"task", "--control-port=47400"}); port != 47400 {
+		t.Errorf("expected port from arguments, got %d", port)
+	}
+}
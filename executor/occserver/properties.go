@@ -0,0 +1,135 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2021 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+
+package occserver
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	pb "github.com/AliceO2Group/Control/executor/protos"
+)
+
+const ptreePrefix = "__ptree__:"
+
+// Payload is a structured configuration payload pushed by the executor
+// under a `__ptree__:<syntax>:<key>` key, i.e. a configuration file as
+// resolved by the configuration system.
+type Payload struct {
+	Syntax string
+	Data   string
+}
+
+// Decode unmarshals a JSON or XML payload into v.
+func (p Payload) Decode(v interface{}) error {
+	switch p.Syntax {
+	case "json":
+		return json.Unmarshal([]byte(p.Data), v)
+	case "xml":
+		return xml.Unmarshal([]byte(p.Data), v)
+	}
+	return fmt.Errorf("cannot decode payload with syntax %s", p.Syntax)
+}
+
+// Properties is the property map pushed by the executor along with a
+// transition, typically CONFIGURE and START.
+type Properties struct {
+	values   map[string]string
+	payloads map[string]Payload
+}
+
+func NewProperties(entries []*pb.ConfigEntry) Properties {
+	p := Properties{
+		values:   make(map[string]string),
+		payloads: make(map[string]Payload),
+	}
+	for _, entry := range entries {
+		key := entry.GetKey()
+		if strings.HasPrefix(key, ptreePrefix) {
+			split := strings.SplitN(key, ":", 3)
+			if len(split) == 3 {
+				p.payloads[split[2]] = Payload{
+					Syntax: strings.ToLower(split[1]),
+					Data:   entry.GetValue(),
+				}
+				continue
+			}
+			log.WithField("key", key).
+				Warning("malformed configuration payload key, treating it as a plain value")
+		}
+		p.values[key] = entry.GetValue()
+	}
+	return p
+}
+
+func (p Properties) Get(key string) (value string, ok bool) {
+	value, ok = p.values[key]
+	return
+}
+
+func (p Properties) GetOrDefault(key string, defaultValue string) string {
+	if value, ok := p.values[key]; ok {
+		return value
+	}
+	return defaultValue
+}
+
+// Payload returns the structured payload pushed under key, if any.
+func (p Properties) Payload(key string) (payload Payload, ok bool) {
+	payload, ok = p.payloads[key]
+	return
+}
+
+// RunNumber returns the run number pushed with START, or 0 if none.
+func (p Properties) RunNumber() uint32 {
+	rn, err := strconv.ParseUint(p.GetOrDefault("runNumber", "0"), 10, 32)
+	if err != nil {
+		return 0
+	}
+	return uint32(rn)
+}
+
+// Keys returns the sorted keys of all plain values.
+func (p Properties) Keys() []string {
+	keys := make([]string, 0, len(p.values))
+	for k := range p.values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Map returns a copy of all plain values.
+func (p Properties) Map() map[string]string {
+	m := make(map[string]string, len(p.values))
+	for k, v := range p.values {
+		m[k] = v
+	}
+	return m
+}
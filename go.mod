@@ -48,6 +48,7 @@ require (
 	github.com/envoyproxy/protoc-gen-validate v0.4.1 // indirect
 	github.com/fatih/color v1.10.0
 	github.com/flosch/pongo2/v4 v4.0.2
+	github.com/fsnotify/fsnotify v1.4.9
 	github.com/gdamore/tcell/v2 v2.1.0
 	github.com/gliderlabs/ssh v0.3.0 // indirect
 	github.com/go-git/go-git/v5 v5.2.0
@@ -28,6 +28,7 @@
 
 #include "util/Defer.h"
 #include "util/Common.h"
+#include "OccVersion.h"
 
 #include <cstdlib>
 #include <cstdint>
@@ -150,6 +151,32 @@ grpc::Status OccServer::GetState(grpc::ServerContext* context,
     return grpc::Status::OK;
 }
 
+/**
+ * GetCapabilities lets the executor pick a transport and find out which OCC features we support.
+ *
+ * @param context server context
+ * @param request the request, as generated and wrapped by Protobuf
+ * @param response the response, as generated and wrapped by Protobuf
+ * @return the status, always grpc::Status::OK
+ */
+grpc::Status OccServer::GetCapabilities(grpc::ServerContext* context,
+                                        const pb::GetCapabilitiesRequest* request,
+                                        pb::GetCapabilitiesReply* response)
+{
+    (void) context;
+    (void) request;
+
+    response->add_transports("protobuf");
+    response->set_statestream(true);
+    response->set_ptree(true);
+    for (auto syntax : {"ini", "json", "xml"}) { // see propMapEntryToPtree
+        response->add_propertyformats(syntax);
+    }
+    response->set_version(OCC_VERSION);
+
+    return grpc::Status::OK;
+}
+
 /**
  * Transition requests a state transition from the controllable object, and blocks until success or failure.
  *
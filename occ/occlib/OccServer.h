@@ -97,6 +97,10 @@ public:
                             const pb::TransitionRequest* request,
                             pb::TransitionReply* response) override;
 
+    grpc::Status GetCapabilities(grpc::ServerContext* context,
+                                 const pb::GetCapabilitiesRequest* request,
+                                 pb::GetCapabilitiesReply* response) override;
+
     bool checkMachineDone();
 
 private:
@@ -23,10 +23,12 @@
  */
 
 #include "OccLiteServer.h"
+#include "OccVersion.h"
 
 #include "litestructs/GetState.h"
 #include "litestructs/Transition.h"
 #include "litestructs/EventStream.h"
+#include "litestructs/GetCapabilities.h"
 
 #include "util/Defer.h"
 #include "util/Logger.h"
@@ -68,6 +70,13 @@ OccLite::Service::Service(fair::mq::PluginServices* pluginServices)
         ::grpc::internal::RpcMethod::SERVER_STREAMING,
         eventStreamHandler);
     AddMethod(eventStream);
+
+    auto getCapabilitiesHandler = new ::grpc::internal::RpcMethodHandler<OccLite::Service, OccLite::nopb::GetCapabilitiesRequest, OccLite::nopb::GetCapabilitiesResponse>(&OccLite::Service::GetCapabilities, this);
+    auto getCapabilities = new ::grpc::internal::RpcServiceMethod(
+        "GetCapabilities",
+        ::grpc::internal::RpcMethod::NORMAL_RPC,
+        getCapabilitiesHandler);
+    AddMethod(getCapabilities);
 }
 
 ::grpc::Status OccLite::Service::GetState(::grpc::ServerContext* context,
@@ -89,6 +98,24 @@ OccLite::Service::Service(fair::mq::PluginServices* pluginServices)
     return grpc::Status::OK;
 }
 
+::grpc::Status OccLite::Service::GetCapabilities(::grpc::ServerContext* context,
+                                                 const OccLite::nopb::GetCapabilitiesRequest* request,
+                                                 OccLite::nopb::GetCapabilitiesResponse* response)
+{
+    (void) context;
+    (void) request;
+
+    // OCClite has no StateStream, but like the legacy plugin it builds ptrees
+    // through the common transition code
+    response->transports = {"json"};
+    response->stateStream = false;
+    response->ptree = true;
+    response->propertyFormats = {"ini", "json", "xml"};
+    response->version = OCC_VERSION;
+
+    return grpc::Status::OK;
+}
+
 ::grpc::Status OccLite::Service::Transition(::grpc::ServerContext* context,
                                             const OccLite::nopb::TransitionRequest* request,
                                             OccLite::nopb::TransitionResponse* response)
@@ -50,6 +50,8 @@ class TransitionRequest;
 class TransitionResponse;
 class EventStreamRequest;
 class EventStreamResponse;
+class GetCapabilitiesRequest;
+class GetCapabilitiesResponse;
 }
 
 class Service : public ::grpc::Service
@@ -72,6 +74,10 @@ public:
                               const nopb::TransitionRequest* request,
                               nopb::TransitionResponse* response);
 
+    ::grpc::Status GetCapabilities(::grpc::ServerContext* context,
+                                   const nopb::GetCapabilitiesRequest* request,
+                                   nopb::GetCapabilitiesResponse* response);
+
 private:
     fair::mq::PluginServices* m_pluginServices;
     std::mutex m_mu;
@@ -66,6 +66,10 @@ public:
                             const pb::TransitionRequest* request,
                             pb::TransitionReply* response) override;
 
+    grpc::Status GetCapabilities(grpc::ServerContext* context,
+                                 const pb::GetCapabilitiesRequest* request,
+                                 pb::GetCapabilitiesReply* response) override;
+
 private:
     fair::mq::PluginServices* m_pluginServices;
     std::mutex m_mu;
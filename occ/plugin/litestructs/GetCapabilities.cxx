@@ -0,0 +1,84 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2021 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+#include "GetCapabilities.h"
+
+bool OccLite::nopb::GetCapabilitiesResponse::Serialize(rapidjson::Writer<rapidjson::StringBuffer>* writer) const
+{
+    writer->StartObject();
+
+    writer->String("transports");
+    writer->StartArray();
+    for (const auto& transport : transports) {
+        writer->String(transport);
+    }
+    writer->EndArray();
+
+    writer->String("stateStream");
+    writer->Bool(stateStream);
+
+    writer->String("ptree");
+    writer->Bool(ptree);
+
+    writer->String("propertyFormats");
+    writer->StartArray();
+    for (const auto& format : propertyFormats) {
+        writer->String(format);
+    }
+    writer->EndArray();
+
+    writer->String("version");
+    writer->String(version);
+
+    writer->EndObject();
+    return true;
+}
+
+bool OccLite::nopb::GetCapabilitiesResponse::Deserialize(const rapidjson::Value& obj)
+{
+    transports.clear();
+    for (const auto& transport : obj["transports"].GetArray()) {
+        transports.emplace_back(transport.GetString());
+    }
+    stateStream = obj["stateStream"].GetBool();
+    ptree = obj["ptree"].GetBool();
+    propertyFormats.clear();
+    for (const auto& format : obj["propertyFormats"].GetArray()) {
+        propertyFormats.emplace_back(format.GetString());
+    }
+    version = obj["version"].GetString();
+    return true;
+}
+
+bool OccLite::nopb::GetCapabilitiesRequest::Serialize(rapidjson::Writer<rapidjson::StringBuffer>* writer) const
+{
+    writer->StartObject();
+    writer->EndObject();
+    return true;
+}
+
+bool OccLite::nopb::GetCapabilitiesRequest::Deserialize(const rapidjson::Value& obj)
+{
+    return true;
+}
@@ -0,0 +1,157 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2021 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package occserver
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/AliceO2Group/Control/executor/executorcmd/transitioner/fairmq"
+	odc "github.com/AliceO2Group/Control/odcshim/odcprotos"
+	pb "github.com/AliceO2Group/Control/odcshim/protos"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+)
+
+const streamBufferSize = 64
+
+// ODC offers no subscription mechanism, so we poll the aggregate state of
+// the partition and the state of each device, and push whatever changed
+// to the StateStream and EventStream subscribers.
+func (s *OccServerImpl) monitorPartition() {
+	interval := viper.GetDuration("odcPollInterval")
+	if interval <= 0 {
+		log.WithField("odcPollInterval", interval.String()).
+			Warning("ODC state polling disabled")
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		s.mu.RLock()
+		odcClient, envId := s.odcClient, s.environmentId
+		s.mu.RUnlock()
+
+		if odcClient == nil || odcClient.GetConnState() != connectivity.Ready || len(envId) == 0 {
+			continue
+		}
+		s.pollPartition(odcClient, envId)
+	}
+}
+
+func (s *OccServerImpl) pollPartition(odcClient odc.ODCClient, envId string) {
+	cxt, cancel := context.WithTimeout(context.Background(), CALL_TIMEOUT)
+	defer cancel()
+
+	rep, err := odcClient.GetState(cxt, &odc.StateRequest{
+		Partitionid: envId,
+		Path:        "",
+		Detailed:    true,
+	}, grpc.EmptyCallOption{})
+	if err != nil {
+		log.WithError(err).
+			WithField("partition", envId).
+			Debug("cannot poll ODC partition state")
+		return
+	}
+	if rep == nil || rep.Reply == nil {
+		return
+	}
+
+	// a partition with some devices in ERROR may well not be reported as
+	// SUCCESS, so we look at the devices whatever the reply status
+	devices := rep.GetDevices()
+	errorCount := 0
+	for _, device := range devices {
+		if device.GetState() == fairmq.ERROR {
+			errorCount++
+		}
+	}
+
+	s.mu.Lock()
+	// a RESET or a new CONFIGURE may have happened in the meantime
+	if s.environmentId != envId {
+		s.mu.Unlock()
+		return
+	}
+	state, stable := occStateForAggregate(rep.Reply.GetState())
+	s.publishState(state, stable)
+	if len(devices) > 0 && errorCount != s.errorCount {
+		s.errorCount = errorCount
+		s.publishEvent(&pb.DeviceEvent{
+			Type:        pb.DeviceEventType_PARTIAL_FAILURE,
+			ErrorCount:  int32(errorCount),
+			DeviceCount: int32(len(devices)),
+			Message:     fmt.Sprintf("%d of %d devices in ERROR", errorCount, len(devices)),
+		})
+	}
+	s.mu.Unlock()
+}
+
+// publishState must be called with s.mu held
+func (s *OccServerImpl) publishState(state string, stable bool) {
+	if state == s.lastState {
+		return
+	}
+	s.lastState = state
+
+	stateType := pb.StateType_STATE_STABLE
+	if !stable {
+		stateType = pb.StateType_STATE_INTERMEDIATE
+	}
+	log.WithFields(logrus.Fields{
+			"state": state,
+			"type":  stateType.String(),
+		}).
+		Debug("partition state changed")
+
+	for ch := range s.stateSubs {
+		select {
+		case ch <- &pb.StateStreamReply{Type: stateType, State: state}:
+		default:
+			log.WithField("state", state).Warning("state stream subscriber too slow, state change dropped")
+		}
+	}
+}
+
+// publishEvent must be called with s.mu held
+func (s *OccServerImpl) publishEvent(ev *pb.DeviceEvent) {
+	log.WithFields(logrus.Fields{
+			"type":    ev.GetType().String(),
+			"message": ev.GetMessage(),
+		}).
+		Debug("pushing device event")
+
+	for ch := range s.eventSubs {
+		select {
+		case ch <- ev:
+		default:
+			log.WithField("type", ev.GetType().String()).Warning("event stream subscriber too slow, event dropped")
+		}
+	}
+}
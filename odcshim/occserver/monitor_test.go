@@ -0,0 +1,104 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2021 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package occserver
+
+import (
+	"context"
+	"testing"
+
+	odc "github.com/AliceO2Group/Control/odcshim/odcprotos"
+	pb "github.com/AliceO2Group/Control/odcshim/protos"
+	"google.golang.org/grpc"
+)
+
+// fakeODC answers GetState with a fixed set of device states, all other
+// calls panic through the nil embedded interface.
+type fakeODC struct {
+	odc.ODCClient
+	aggregate string
+	devices   []string
+}
+
+func (f *fakeODC) GetState(_ context.Context, _ *odc.StateRequest, _ ...grpc.CallOption) (*odc.StateReply, error) {
+	rep := &odc.StateReply{Reply: &odc.GeneralReply{State: f.aggregate, Status: odc.ReplyStatus_SUCCESS}}
+	for i, state := range f.devices {
+		rep.Devices = append(rep.Devices, &odc.Device{Id: uint64(i), State: state})
+	}
+	return rep, nil
+}
+
+func TestPollPartition(t *testing.T) {
+	s := &OccServerImpl{
+		environmentId: "env1",
+		lastState:     "CONFIGURED",
+		stateSubs:     make(map[chan *pb.StateStreamReply]struct{}),
+		eventSubs:     make(map[chan *pb.DeviceEvent]struct{}),
+	}
+	stateCh := make(chan *pb.StateStreamReply, streamBufferSize)
+	eventCh := make(chan *pb.DeviceEvent, streamBufferSize)
+	s.stateSubs[stateCh] = struct{}{}
+	s.eventSubs[eventCh] = struct{}{}
+
+	fake := &fakeODC{aggregate: "RUNNING", devices: []string{"RUNNING", "RUNNING", "RUNNING"}}
+	s.pollPartition(fake, "env1")
+	if reply := <-stateCh; reply.GetState() != "RUNNING" || reply.GetType() != pb.StateType_STATE_STABLE {
+		t.Fatalf("expected stable RUNNING, got %s %s", reply.GetType(), reply.GetState())
+	}
+
+	// one device fails: the partition is MIXED, and we report how many are in ERROR
+	fake.aggregate, fake.devices[1] = "MIXED", "ERROR"
+	s.pollPartition(fake, "env1")
+	if reply := <-stateCh; reply.GetState() != "MIXED" || reply.GetType() != pb.StateType_STATE_INTERMEDIATE {
+		t.Fatalf("expected intermediate MIXED, got %s %s", reply.GetType(), reply.GetState())
+	}
+	ev := <-eventCh
+	if ev.GetType() != pb.DeviceEventType_PARTIAL_FAILURE || ev.GetErrorCount() != 1 || ev.GetDeviceCount() != 3 {
+		t.Fatalf("unexpected device event: %v", ev)
+	}
+
+	// nothing changed, nothing to push
+	s.pollPartition(fake, "env1")
+	if len(stateCh) != 0 || len(eventCh) != 0 {
+		t.Fatal("unexpected updates for an unchanged partition")
+	}
+
+	// all devices fail
+	fake.aggregate, fake.devices = "ERROR", []string{"ERROR", "ERROR", "ERROR"}
+	s.pollPartition(fake, "env1")
+	if reply := <-stateCh; reply.GetState() != "ERROR" || reply.GetType() != pb.StateType_STATE_STABLE {
+		t.Fatalf("expected stable ERROR, got %s %s", reply.GetType(), reply.GetState())
+	}
+	if ev = <-eventCh; ev.GetErrorCount() != 3 {
+		t.Fatalf("expected 3 devices in ERROR, got %d", ev.GetErrorCount())
+	}
+
+	// stale poll for a partition which has since been reset
+	s.environmentId = ""
+	fake.aggregate = "IDLE"
+	s.pollPartition(fake, "env1")
+	if len(stateCh) != 0 {
+		t.Fatal("unexpected update from a stale poll")
+	}
+}
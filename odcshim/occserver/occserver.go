@@ -30,9 +30,11 @@ import (
 	"os"
 	"runtime"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/AliceO2Group/Control/common/logger"
+	"github.com/AliceO2Group/Control/common/product"
 	"github.com/AliceO2Group/Control/odcshim/odcclient"
 	pb "github.com/AliceO2Group/Control/odcshim/protos"
 	"github.com/sirupsen/logrus"
@@ -49,16 +51,24 @@ var log = logger.New(logrus.StandardLogger(), "o2-aliecs-odc-shim")
 const CALL_TIMEOUT = 30*time.Second
 
 type OccServerImpl struct {
+	mu             sync.RWMutex
 	odcHost        string
 	odcPort        int
 	topology       string
 	environmentId  string
 	odcClient      *odcclient.RpcClient
+
+	lastState      string
+	errorCount     int
+	stateSubs      map[chan *pb.StateStreamReply]struct{}
+	eventSubs      map[chan *pb.DeviceEvent]struct{}
 }
 
 func (s *OccServerImpl) disconnectAndTerminate() {
+	s.mu.Lock()
 	_ = s.odcClient.Close()
 	s.odcClient = nil
+	s.mu.Unlock()
 
 	// We sleep half a second so gRPC can send the EXIT response, and then quit
 	time.Sleep(500*time.Millisecond)
@@ -81,10 +91,13 @@ func (s *OccServerImpl) ensureClientConnected() error {
 
 	cxt, cancel := context.WithTimeout(context.Background(), CALL_TIMEOUT)
 
-	s.odcClient = odcclient.NewClient(cxt, cancel, endpoint)
-	if s.odcClient == nil {
+	odcClient := odcclient.NewClient(cxt, cancel, endpoint)
+	if odcClient == nil {
 		return fmt.Errorf("cannot dial ODC endpoint: %s", endpoint)
 	}
+	s.mu.Lock()
+	s.odcClient = odcClient
+	s.mu.Unlock()
 
 	return nil
 }
@@ -95,6 +108,9 @@ func NewServer(host string, port int, topology string) *grpc.Server {
 		odcHost: host,
 		odcPort: port,
 		topology: topology,
+		lastState: "STANDBY",
+		stateSubs: make(map[chan *pb.StateStreamReply]struct{}),
+		eventSubs: make(map[chan *pb.DeviceEvent]struct{}),
 	}
 	pb.RegisterOccServer(grpcServer, srvImpl)
 	// Register reflection service on gRPC server.
@@ -108,6 +124,7 @@ func NewServer(host string, port int, topology string) *grpc.Server {
 		}
 		log.Info("ODC client connected")
 	}()
+	go srvImpl.monitorPartition()
 
 	return grpcServer
 }
@@ -128,34 +145,72 @@ func (s *OccServerImpl) logMethod() {
 		Debug("handling RPC request")
 }
 
-// FIXME: this is a dummy implementation
 func (s *OccServerImpl) EventStream(req *pb.EventStreamRequest, srv pb.Occ_EventStreamServer) error {
 	s.logMethod()
+	ch := make(chan *pb.DeviceEvent, streamBufferSize)
+	s.mu.Lock()
+	s.eventSubs[ch] = struct{}{}
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		delete(s.eventSubs, ch)
+		s.mu.Unlock()
+	}()
+
 	ctx := srv.Context()
 	for {
 		select {
-		// FIXME: make a channel s.eventCh and receive events from all over the place
-		//case event := <- s.eventCh:
-			//err := srv.Send(event)
-			//if err != nil {
-			//	return err
-			//}
+		case ev := <-ch:
+			err := srv.Send(&pb.EventStreamReply{Event: ev})
+			if err != nil {
+				return err
+			}
 		case <-ctx.Done():
 			return ctx.Err()
 		}
 	}
 }
+
 func (s *OccServerImpl) StateStream(req *pb.StateStreamRequest, srv pb.Occ_StateStreamServer) error {
 	s.logMethod()
-	return status.Errorf(codes.Unimplemented, "method StateStream not implemented")
+	ch := make(chan *pb.StateStreamReply, streamBufferSize)
+	s.mu.Lock()
+	s.stateSubs[ch] = struct{}{}
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		delete(s.stateSubs, ch)
+		s.mu.Unlock()
+	}()
+
+	ctx := srv.Context()
+	for {
+		select {
+		case reply := <-ch:
+			err := srv.Send(reply)
+			if err != nil {
+				return err
+			}
+			if reply.GetState() == "DONE" {
+				return nil
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
 }
+
 func (s *OccServerImpl) GetState(ctx context.Context, req *pb.GetStateRequest) (*pb.GetStateReply, error) {
 	s.logMethod()
 	if req == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "bad incoming request")
 	}
 
-	if s.odcClient == nil || s.odcClient.GetConnState() != connectivity.Ready {
+	s.mu.RLock()
+	odcClient, envId := s.odcClient, s.environmentId
+	s.mu.RUnlock()
+
+	if odcClient == nil || odcClient.GetConnState() != connectivity.Ready {
 		return nil, status.Errorf(codes.Internal, "ODC not connected")
 	}
 
@@ -165,8 +220,8 @@ func (s *OccServerImpl) GetState(ctx context.Context, req *pb.GetStateRequest) (
 		State: "STANDBY",
 	}
 
-	if len(s.environmentId) > 0 {
-		newState, err := handleGetState(ctx, s.odcClient, s.environmentId)
+	if len(envId) > 0 {
+		newState, err := handleGetState(ctx, odcClient, envId)
 		if err == nil {
 			rep.State = newState
 		}
@@ -175,6 +230,18 @@ func (s *OccServerImpl) GetState(ctx context.Context, req *pb.GetStateRequest) (
 	return rep, nil
 }
 
+// GetCapabilities tells the executor that the shim speaks protobuf only and
+// passes properties on to ODC as they are, without building ptrees.
+func (s *OccServerImpl) GetCapabilities(ctx context.Context, req *pb.GetCapabilitiesRequest) (*pb.GetCapabilitiesReply, error) {
+	s.logMethod()
+	return &pb.GetCapabilitiesReply{
+		Transports:  []string{"protobuf"},
+		StateStream: true,
+		Ptree:       false,
+		Version:     product.VERSION,
+	}, nil
+}
+
 func (s *OccServerImpl) Transition(ctx context.Context, req *pb.TransitionRequest) (*pb.TransitionReply, error) {
 	s.logMethod()
 	if req == nil {
@@ -189,7 +256,10 @@ func (s *OccServerImpl) Transition(ctx context.Context, req *pb.TransitionReques
 		Ok:              false,
 	}
 
-	if s.odcClient == nil || s.odcClient.GetConnState() != connectivity.Ready {
+	s.mu.RLock()
+	odcClient := s.odcClient
+	s.mu.RUnlock()
+	if odcClient == nil || odcClient.GetConnState() != connectivity.Ready {
 		err := s.ensureClientConnected()
 		if err != nil {
 			log.WithError(err).
@@ -202,42 +272,52 @@ func (s *OccServerImpl) Transition(ctx context.Context, req *pb.TransitionReques
 		}
 	}
 
+	s.mu.RLock()
+	odcClient, envId := s.odcClient, s.environmentId
+	s.mu.RUnlock()
+
 	var err error = nil
 	switch event := strings.ToUpper(req.TransitionEvent); event {
 	case "CONFIGURE":
 		// Extract environment ID from Arguments payload
 		for _, entry := range req.Arguments {
 			if entry.Key == "environment_id" {
-				s.environmentId = entry.Value
+				envId = entry.Value
 			}
 		}
+		s.mu.Lock()
+		s.environmentId = envId
+		s.errorCount = 0
+		s.mu.Unlock()
 
-		err = handleConfigure(ctx, s.odcClient, req.Arguments, s.topology, s.environmentId)
+		err = handleConfigure(ctx, odcClient, req.Arguments, s.topology, envId)
 		if err == nil {
 			rep.Ok = true
 			rep.State = "CONFIGURED"
 		}
 	case "START":
-		err = handleStart(ctx, s.odcClient, req.Arguments, s.environmentId)
+		err = handleStart(ctx, odcClient, req.Arguments, envId)
 		if err == nil {
 			rep.Ok = true
 			rep.State = "RUNNING"
 		}
 	case "STOP":
-		err = handleStop(ctx, s.odcClient, req.Arguments, s.environmentId)
+		err = handleStop(ctx, odcClient, req.Arguments, envId)
 		if err == nil {
 			rep.Ok = true
 			rep.State = "CONFIGURED"
 		}
 	case "RESET":
-		err = handleReset(ctx, s.odcClient, req.Arguments, s.environmentId)
+		err = handleReset(ctx, odcClient, req.Arguments, envId)
 		if err == nil {
+			s.mu.Lock()
 			s.environmentId = ""
+			s.mu.Unlock()
 			rep.Ok = true
 			rep.State = "STANDBY"
 		}
 	case "EXIT":
-		err = handleExit(ctx, s.odcClient, req.Arguments)
+		err = handleExit(ctx, odcClient, req.Arguments)
 		if err == nil {
 			rep.Ok = true
 			rep.State = "DONE"
@@ -246,5 +326,12 @@ func (s *OccServerImpl) Transition(ctx context.Context, req *pb.TransitionReques
 	default:
 		rep.State = "UNDEFINED"
 	}
+
+	if rep.Ok {
+		// don't wait for the next poll to let subscribers know
+		s.mu.Lock()
+		s.publishState(rep.State, true)
+		s.mu.Unlock()
+	}
 	return rep, err
 }
@@ -58,3 +58,15 @@ func stateForOdcState(fmqStateName string) string {
 	}
 	return state
 }
+
+// occStateForAggregate translates the aggregate FairMQ state of a partition,
+// as reported by ODC, into an OCC state. FairMQ states with no OCC
+// equivalent, as well as MIXED (devices in different states), are
+// intermediate and passed through as is.
+func occStateForAggregate(fmqStateName string) (state string, stable bool) {
+	state = stateForOdcState(fmqStateName)
+	if len(state) == 0 {
+		return fmqStateName, false
+	}
+	return state, true
+}
@@ -45,6 +45,7 @@ var log = logger.New(logrus.StandardLogger(), "o2-aliecs-odc-shim")
 func setDefaults() error {
 	viper.SetDefault("odcPort", 50051)
 	viper.SetDefault("odcHost", "127.0.0.1")
+	viper.SetDefault("odcPollInterval", "2s")
 	viper.SetDefault("verbose", false)
 	return nil
 }
@@ -53,6 +54,7 @@ func setFlags() error {
 	pflag.String("control-port", viper.GetString("control-port"), "OCC control port")
 	pflag.Int("odcPort", viper.GetInt("odcPort"), "Remote ODC server port")
 	pflag.String("odcHost", viper.GetString("odcHost"), "Remote ODC server hostname")
+	pflag.Duration("odcPollInterval", viper.GetDuration("odcPollInterval"), "How often the ODC partition state is polled for the OCC state and event streams (0 to disable)")
 	pflag.BoolP("verbose", "v", viper.GetBool("verbose"), "Verbose logging")
 
 	pflag.Parse()
@@ -23,6 +23,10 @@ type OccClient interface {
 	StateStream(ctx context.Context, in *StateStreamRequest, opts ...grpc.CallOption) (Occ_StateStreamClient, error)
 	GetState(ctx context.Context, in *GetStateRequest, opts ...grpc.CallOption) (*GetStateReply, error)
 	Transition(ctx context.Context, in *TransitionRequest, opts ...grpc.CallOption) (*TransitionReply, error)
+	// Called by the executor right after connecting, to pick a transport and
+	// find out which features the OCC server supports. Servers which predate
+	// it are treated as legacy peers.
+	GetCapabilities(ctx context.Context, in *GetCapabilitiesRequest, opts ...grpc.CallOption) (*GetCapabilitiesReply, error)
 }
 
 type occClient struct {
@@ -115,6 +119,15 @@ func (c *occClient) Transition(ctx context.Context, in *TransitionRequest, opts
 	return out, nil
 }
 
+func (c *occClient) GetCapabilities(ctx context.Context, in *GetCapabilitiesRequest, opts ...grpc.CallOption) (*GetCapabilitiesReply, error) {
+	out := new(GetCapabilitiesReply)
+	err := c.cc.Invoke(ctx, "/occ_pb.Occ/GetCapabilities", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OccServer is the server API for Occ service.
 // All implementations should embed UnimplementedOccServer
 // for forward compatibility
@@ -125,6 +138,10 @@ type OccServer interface {
 	StateStream(*StateStreamRequest, Occ_StateStreamServer) error
 	GetState(context.Context, *GetStateRequest) (*GetStateReply, error)
 	Transition(context.Context, *TransitionRequest) (*TransitionReply, error)
+	// Called by the executor right after connecting, to pick a transport and
+	// find out which features the OCC server supports. Servers which predate
+	// it are treated as legacy peers.
+	GetCapabilities(context.Context, *GetCapabilitiesRequest) (*GetCapabilitiesReply, error)
 }
 
 // UnimplementedOccServer should be embedded to have forward compatible implementations.
@@ -143,6 +160,9 @@ func (UnimplementedOccServer) GetState(context.Context, *GetStateRequest) (*GetS
 func (UnimplementedOccServer) Transition(context.Context, *TransitionRequest) (*TransitionReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Transition not implemented")
 }
+func (UnimplementedOccServer) GetCapabilities(context.Context, *GetCapabilitiesRequest) (*GetCapabilitiesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCapabilities not implemented")
+}
 
 // UnsafeOccServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to OccServer will
@@ -233,6 +253,24 @@ func _Occ_Transition_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Occ_GetCapabilities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCapabilitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OccServer).GetCapabilities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/occ_pb.Occ/GetCapabilities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OccServer).GetCapabilities(ctx, req.(*GetCapabilitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Occ_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "occ_pb.Occ",
 	HandlerType: (*OccServer)(nil),
@@ -245,6 +283,10 @@ var _Occ_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Transition",
 			Handler:    _Occ_Transition_Handler,
 		},
+		{
+			MethodName: "GetCapabilities",
+			Handler:    _Occ_GetCapabilities_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
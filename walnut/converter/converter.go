@@ -78,6 +78,9 @@ func ExtractTaskClasses(dplDump Dump, taskNamePrefix string, envModules []string
 			},
 			Control: struct {
 				Mode controlmode.ControlMode "yaml:\"mode\""
+				Startup common.StartupProbe "yaml:\"startup\""
+				Kill common.KillSequence    "yaml:\"kill\""
+				Health common.HealthChecks  "yaml:\"health\""
 			}{Mode: controlmode.FAIRMQ},
 			Command: &common.CommandInfo{
 				Env:       []string{}, // -> Default to empty array
@@ -28,11 +28,82 @@ Task = `
                         "basic",
                         "direct"
                     ]
+                },
+                "startup": {
+                    "description": "How the executor decides that the task has started up",
+                    "type": "object",
+                    "properties": {
+                        "timeout": {
+                            "description": "Time allowed for the task to become ready, e.g. 60s",
+                            "type": "string"
+                        },
+                        "readiness": {
+                            "description": "Shell command which must exit with status 0 before the task is considered ready",
+                            "type": "string"
+                        }
+                    }
+                },
+                "kill": {
+                    "description": "Sequence used by the executor to bring down the task",
+                    "type": "object",
+                    "properties": {
+                        "shutdownTransition": {
+                            "description": "Drive the task through its shutdown transitions before signalling it",
+                            "type": "string",
+                            "enum": ["true", "false"]
+                        },
+                        "transitionTimeout": {
+                            "description": "Time allowed for the shutdown transitions, e.g. 5s",
+                            "type": "string"
+                        },
+                        "sigtermTimeout": {
+                            "description": "Grace period between SIGTERM and SIGINT, e.g. 1s, SIGKILL follows 3s after SIGINT",
+                            "type": "string"
+                        }
+                    }
+                },
+                "health": {
+                    "description": "Checks run periodically by the executor while the task is RUNNING",
+                    "type": "array",
+                    "items": {
+                        "type": "object",
+                        "properties": {
+                            "type": {
+                                "type": "string",
+                                "enum": ["heartbeat", "http", "command"]
+                            },
+                            "interval": {
+                                "description": "Time between checks, e.g. 10s",
+                                "type": "string"
+                            },
+                            "timeout": {
+                                "description": "Time allowed for a single check, e.g. 5s",
+                                "type": "string"
+                            },
+                            "threshold": {
+                                "description": "Consecutive failures before the policy applies",
+                                "type": ["integer", "string"]
+                            },
+                            "url": {
+                                "description": "URL to GET, for http checks",
+                                "type": "string"
+                            },
+                            "command": {
+                                "description": "Shell command to run on the host, for command checks",
+                                "type": "string"
+                            },
+                            "policy": {
+                                "type": "string",
+                                "enum": ["warn", "unhealthy", "error"]
+                            }
+                        },
+                        "required": ["type"]
+                    }
                 }
             }
         },
         "wants": {
-            "description": "CPU, memory and ports required for task execution",
+            "description": "CPU, memory, ports and other resources required for task execution",
             "type": "object",
             "properties": {
                 "cpu": {
@@ -46,6 +117,13 @@ Task = `
                 "ports": {
                     "description": "Range of ports to use",
                     "type": "string"
+                },
+                "resources": {
+                    "description": "Other named resources to use: amount for scalar resources (e.g. gpus), item count for set resources (e.g. cru_endpoints)",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "number"
+                    }
                 }
             }
         },
@@ -185,6 +263,69 @@ Task = `
                     "type": "string"
                 }
             }
+        },
+        "isolation": {
+            "description": "How the executor isolates the task from the host",
+            "type": "object",
+            "properties": {
+                "mode": {
+                    "type": "string",
+                    "enum": [
+                        "none",
+                        "systemd",
+                        "oci",
+                        "podman"
+                    ]
+                },
+                "cpus": {
+                    "description": "CPU limit, in cores",
+                    "type": "string"
+                },
+                "memory": {
+                    "description": "Memory limit, in MB",
+                    "type": "string"
+                },
+                "runtime": {
+                    "description": "OCI runtime for the oci mode, runc (default) or crun",
+                    "type": "string"
+                },
+                "bundle": {
+                    "description": "Path to the OCI bundle for the oci mode",
+                    "type": "string"
+                },
+                "image": {
+                    "description": "Container image for the podman mode",
+                    "type": "string"
+                },
+                "mounts": {
+                    "type": "array",
+                    "items": {
+                        "type": "object",
+                        "required": [
+                            "source",
+                            "target"
+                        ],
+                        "properties": {
+                            "source": {
+                                "type": "string"
+                            },
+                            "target": {
+                                "type": "string"
+                            },
+                            "readOnly": {
+                                "type": "boolean"
+                            }
+                        }
+                    }
+                },
+                "devices": {
+                    "description": "Host device nodes made available in the container",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
         }
     }
 }